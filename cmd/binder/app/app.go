@@ -122,9 +122,12 @@ func New(options *Options, config *rest.Config) (*App, error) {
 		podResources)
 
 	reconcilerParams := &controllers.ReconcilerParams{
-		MaxConcurrentReconciles:     options.MaxConcurrentReconciles,
-		RateLimiterBaseDelaySeconds: options.RateLimiterBaseDelaySeconds,
-		RateLimiterMaxDelaySeconds:  options.RateLimiterMaxDelaySeconds,
+		MaxConcurrentReconciles:       options.MaxConcurrentReconciles,
+		RateLimiterBaseDelaySeconds:   options.RateLimiterBaseDelaySeconds,
+		RateLimiterMaxDelaySeconds:    options.RateLimiterMaxDelaySeconds,
+		GangBindingTimeoutSeconds:     options.GangBindingTimeoutSeconds,
+		TransientBindRetries:          options.TransientBindRetries,
+		ReservationTimeoutBindRetries: options.ReservationTimeoutBindRetries,
 	}
 
 	app := &App{
@@ -174,7 +177,8 @@ func (app *App) Run(ctx context.Context) error {
 	app.InformerFactory.WaitForCacheSync(ctx.Done())
 
 	reconciler := controllers.NewBindRequestReconciler(
-		app.manager.GetClient(), app.manager.GetScheme(), app.manager.GetEventRecorderFor("binder"), app.reconcilerParams,
+		app.manager.GetClient(), app.manager.GetAPIReader(), app.manager.GetScheme(),
+		app.manager.GetEventRecorderFor("binder"), app.reconcilerParams,
 		binder, app.rrs)
 	if err = reconciler.SetupWithManager(app.manager); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "BindRequest")
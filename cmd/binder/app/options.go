@@ -30,6 +30,10 @@ type Options struct {
 	FakeGPUNodes                         bool
 	GpuCdiEnabled                        bool
 	VolumeBindingTimeoutSeconds          int
+	GangBindingTimeoutSeconds            int
+	TransientBindRetries                 int
+	ReservationTimeoutBindRetries        int
+	ExternalPluginsJSON                  string
 	RuntimeClassName                     string
 }
 
@@ -98,6 +102,18 @@ func InitOptions(fs *pflag.FlagSet) *Options {
 	fs.IntVar(&options.VolumeBindingTimeoutSeconds,
 		"volume-binding-timeout-seconds", 120,
 		"Volume binding timeout in seconds")
+	fs.IntVar(&options.GangBindingTimeoutSeconds,
+		"gang-binding-timeout-seconds", 60,
+		"Time in seconds to wait for all the bind requests of a gang before failing them")
+	fs.IntVar(&options.TransientBindRetries,
+		"transient-bind-retries", 3,
+		"Number of times to retry a binding that failed on a transient error, when the bind request sets no backoff limit")
+	fs.IntVar(&options.ReservationTimeoutBindRetries,
+		"reservation-timeout-bind-retries", 1,
+		"Number of times to retry a binding that timed out waiting for a GPU reservation pod")
+	fs.StringVar(&options.ExternalPluginsJSON,
+		"external-plugins", "",
+		"JSON list of external binder plugins, that PreBind, PostBind and Rollback are forwarded to")
 	fs.StringVar(&options.RuntimeClassName,
 		"runtime-class-name", "",
 		"Runtime class for reservation pods")
@@ -15,6 +15,7 @@ import (
 
 	"github.com/NVIDIA/KAI-scheduler/cmd/binder/app"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/external"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/gpusharing"
 	k8s_plugins "github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/k8s-plugins"
 )
@@ -67,6 +68,18 @@ func registerPlugins(app *app.App) error {
 	bindingGpuSharingPlugin := gpusharing.New(app.Client, app.Options.GpuCdiEnabled)
 
 	binderPlugins.RegisterPlugin(bindingGpuSharingPlugin)
+
+	externalPluginConfigs, err := external.ParseConfigs(app.Options.ExternalPluginsJSON)
+	if err != nil {
+		return err
+	}
+	for _, config := range externalPluginConfigs {
+		externalPlugin, err := external.New(config)
+		if err != nil {
+			return err
+		}
+		binderPlugins.RegisterPlugin(externalPlugin)
+	}
 	app.RegisterPlugins(binderPlugins)
 	return nil
 }
@@ -6,8 +6,10 @@ package app
 import (
 	"context"
 
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/metrics"
 
 	v1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
@@ -43,6 +45,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(v2alpha2.AddToScheme(scheme))
+	utilruntime.Must(kaiv1alpha1.AddToScheme(scheme))
 
 	// +kubebuilder:scaffold:scheme
 }
@@ -103,6 +106,16 @@ func Run(options *Options, config *rest.Config, ctx context.Context) error {
 		setupLog.Error(err, "unable to create controller", "controller", "Pod")
 		return err
 	}
+	if options.EnableTopologyStatus {
+		metrics.InitMetrics(options.MetricsNamespace)
+		if err = (&controllers.TopologyReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr, options.SkipControllerNameValidation); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Topology")
+			return err
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err = mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
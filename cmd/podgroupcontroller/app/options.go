@@ -20,6 +20,8 @@ type Options struct {
 	LogLevel                     int
 	SchedulerName                string
 	EnablePodGroupWebhook        bool
+	EnableTopologyStatus         bool
+	MetricsNamespace             string
 }
 
 func InitOptions(fs *flag.FlagSet) *Options {
@@ -49,6 +51,10 @@ func InitOptions(fs *flag.FlagSet) *Options {
 		"The name of the scheduler used to schedule pod groups")
 	fs.BoolVar(&options.EnablePodGroupWebhook, "enable-podgroup-webhook", true,
 		"Enable podgroup webhook")
+	fs.BoolVar(&options.EnableTopologyStatus, "enable-topology-status", true,
+		"Maintain the status and metrics of the topology domains")
+	fs.StringVar(&options.MetricsNamespace, "metrics-namespace", constants.DefaultMetricsNamespace,
+		"Metrics namespace")
 
 	return options
 }
@@ -4,7 +4,9 @@
 package app
 
 import (
+	"context"
 	"flag"
+	"fmt"
 
 	"go.uber.org/zap/zapcore"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +30,7 @@ import (
 	kubeAiSchedulerV2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	controllers "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper"
 	pluginshub "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/hub"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/declarative"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -117,6 +120,10 @@ func NewWithScheme(mgrScheme *runtime.Scheme) (*App, error) {
 	defaultPluginsHub := pluginshub.NewDefaultPluginsHub(mgr.GetClient(), configs.SearchForLegacyPodGroups,
 		configs.KnativeGangSchedule, configs.SchedulingQueueLabelKey, configs.NodePoolLabelKey,
 		configs.DefaultConfigPerTypeConfigMapName, configs.DefaultConfigPerTypeConfigMapNamespace)
+	defaultPluginsHub.SetKueueQueueNameMapping(configs.KueueQueueNameMapping)
+	if err = addDeclarativeGroupers(mgr.GetAPIReader(), defaultPluginsHub, configs); err != nil {
+		return nil, err
+	}
 
 	app := &App{
 		Mgr:               mgr,
@@ -159,6 +166,31 @@ func (app *App) Run() error {
 	return app.Mgr.Start(ctrl.SetupSignalHandler())
 }
 
+// addDeclarativeGroupers reads the declarative grouper configurations once, when the pod-grouper starts.
+// The manager cache is not running yet, so the configmap is read directly from the API server.
+func addDeclarativeGroupers(reader client.Reader, hub *pluginshub.DefaultPluginsHub,
+	configs controllers.Configs) error {
+	if configs.DeclarativeGroupersConfigMapName == "" || configs.DeclarativeGroupersConfigMapNamespace == "" {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err := reader.Get(context.Background(), client.ObjectKey{
+		Name:      configs.DeclarativeGroupersConfigMapName,
+		Namespace: configs.DeclarativeGroupersConfigMapNamespace,
+	}, configMap)
+	if err != nil {
+		return fmt.Errorf("failed to get declarative groupers configmap: %w", err)
+	}
+
+	grouperConfigs, err := declarative.ParseConfigs(configMap.Data[declarative.ConfigMapGroupersKey])
+	if err != nil {
+		return err
+	}
+	setupLog.Info("Adding declarative groupers", "count", len(grouperConfigs))
+	return hub.AddDeclarativeGroupers(grouperConfigs)
+}
+
 func initLogger() {
 	logOptions := zap.Options{
 		Development: true,
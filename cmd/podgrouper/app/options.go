@@ -23,10 +23,13 @@ type Options struct {
 	KnativeGangSchedule                    bool
 	SchedulerName                          string
 	SchedulingQueueLabelKey                string
+	KueueQueueNameMapping                  bool
 	PodLabelSelectorStr                    string
 	NamespaceLabelSelectorStr              string
 	DefaultConfigPerTypeConfigMapName      string
 	DefaultConfigPerTypeConfigMapNamespace string
+	DeclarativeGroupersConfigMapName       string
+	DeclarativeGroupersConfigMapNamespace  string
 }
 
 func (o *Options) AddFlags(fs *flag.FlagSet) {
@@ -41,8 +44,11 @@ func (o *Options) AddFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&o.KnativeGangSchedule, "knative-gang-schedule", true, "Schedule knative revision as a gang. Defaults to true")
 	fs.StringVar(&o.SchedulerName, "scheduler-name", constants.DefaultSchedulerName, "The name of the scheduler used to schedule pod groups")
 	fs.StringVar(&o.SchedulingQueueLabelKey, "queue-label-key", constants.DefaultQueueLabel, "Scheduling queue label key name")
+	fs.BoolVar(&o.KueueQueueNameMapping, "kueue-queue-name-mapping", false, "Assign workloads with Kueue's queue-name label and no queue label to the KAI queue of the same name")
 	fs.StringVar(&o.DefaultConfigPerTypeConfigMapName, "default-priorities-configmap-name", "", "The name of the configmap that contains default configs (priorities and preemptibility) for pod groups")
 	fs.StringVar(&o.DefaultConfigPerTypeConfigMapNamespace, "default-priorities-configmap-namespace", "", "The namespace of the configmap that contains default configs (priorities and preemptibility) for pod groups")
+	fs.StringVar(&o.DeclarativeGroupersConfigMapName, "declarative-groupers-configmap-name", "", "The name of the configmap that contains declarative grouper configurations for workload types without a dedicated grouper")
+	fs.StringVar(&o.DeclarativeGroupersConfigMapNamespace, "declarative-groupers-configmap-namespace", "", "The namespace of the configmap that contains declarative grouper configurations")
 	flag.StringVar(&o.PodLabelSelectorStr, "pod-label-selector", "", "Pod label selector in key=value comma-separated format")
 	flag.StringVar(&o.NamespaceLabelSelectorStr, "namespace-label-selector", "", "Namespace label selector in key=value comma-separated format")
 }
@@ -55,10 +61,13 @@ func (o *Options) Configs() controllers.Configs {
 		KnativeGangSchedule:                    o.KnativeGangSchedule,
 		SchedulerName:                          o.SchedulerName,
 		SchedulingQueueLabelKey:                o.SchedulingQueueLabelKey,
+		KueueQueueNameMapping:                  o.KueueQueueNameMapping,
 		PodLabelSelector:                       parseLabelSelector(o.PodLabelSelectorStr),
 		NamespaceLabelSelector:                 parseLabelSelector(o.NamespaceLabelSelectorStr),
 		DefaultConfigPerTypeConfigMapName:      o.DefaultConfigPerTypeConfigMapName,
 		DefaultConfigPerTypeConfigMapNamespace: o.DefaultConfigPerTypeConfigMapNamespace,
+		DeclarativeGroupersConfigMapName:       o.DeclarativeGroupersConfigMapName,
+		DeclarativeGroupersConfigMapNamespace:  o.DeclarativeGroupersConfigMapNamespace,
 	}
 }
 
@@ -30,52 +30,57 @@ import (
 )
 
 const (
-	defaultSchedulerPeriod             = time.Second
-	defaultStalenessGracePeriod        = 60 * time.Second
-	defaultListenAddress               = ":8080"
-	defaultProfilerApiPort             = "8182"
-	defaultVerbosityLevel              = 3
-	defaultMaxConsolidationPreemptees  = 16
-	defaultDetailedFitError            = false
-	DefaultPyroscopeMutexProfilerRate  = 5
-	DefaultPyroscopeBlockProfilerRate  = 5
-	defaultNumOfStatusRecordingWorkers = 5
+	defaultSchedulerPeriod                       = time.Second
+	defaultStalenessGracePeriod                  = 60 * time.Second
+	defaultListenAddress                         = ":8080"
+	defaultProfilerApiPort                       = "8182"
+	defaultVerbosityLevel                        = 3
+	defaultMaxConsolidationPreemptees            = 16
+	defaultDetailedFitError                      = false
+	DefaultPyroscopeMutexProfilerRate            = 5
+	DefaultPyroscopeBlockProfilerRate            = 5
+	defaultNumOfStatusRecordingWorkers           = 5
+	defaultTopologyDefragmentationEvictionBudget = 4
 )
 
 // ServerOption is the main context object for the controller manager.
 type ServerOption struct {
-	SchedulerName                     string
-	ResourceReservationAppLabel       string
-	SchedulerConf                     string
-	SchedulePeriod                    time.Duration
-	EnableLeaderElection              bool
-	PrintVersion                      bool
-	MetricsNamespace                  string
-	RestrictSchedulingNodes           bool
-	NodePoolLabelKey                  string
-	NodePoolLabelValue                string
-	ListenAddress                     string
-	EnableProfiler                    bool
-	ProfilerApiPort                   string
-	PyroscopeAddress                  string
-	PyroscopeMutexProfilerRate        int
-	PyroscopeBlockProfilerRate        int
-	Verbosity                         int
-	MaxNumberConsolidationPreemptees  int
-	DetailedFitErrors                 bool
-	UpdatePodEvictionCondition        bool
-	ScheduleCSIStorage                bool
-	UseSchedulingSignatures           bool
-	FullHierarchyFairness             bool
-	AllowConsolidatingReclaim         bool
-	NumOfStatusRecordingWorkers       int
-	GlobalDefaultStalenessGracePeriod time.Duration
-	PluginServerPort                  int
-	CPUWorkerNodeLabelKey             string
-	GPUWorkerNodeLabelKey             string
-	MIGWorkerNodeLabelKey             string
-	QueueLabelKey                     string
-	Namspace                          string
+	SchedulerName                         string
+	ResourceReservationAppLabel           string
+	SchedulerConf                         string
+	SchedulePeriod                        time.Duration
+	EnableLeaderElection                  bool
+	PrintVersion                          bool
+	MetricsNamespace                      string
+	RestrictSchedulingNodes               bool
+	NodePoolLabelKey                      string
+	NodePoolLabelValue                    string
+	ListenAddress                         string
+	EnableProfiler                        bool
+	ProfilerApiPort                       string
+	PyroscopeAddress                      string
+	PyroscopeMutexProfilerRate            int
+	PyroscopeBlockProfilerRate            int
+	Verbosity                             int
+	MaxNumberConsolidationPreemptees      int
+	DetailedFitErrors                     bool
+	UpdatePodEvictionCondition            bool
+	ScheduleCSIStorage                    bool
+	UseSchedulingSignatures               bool
+	FullHierarchyFairness                 bool
+	AllowConsolidatingReclaim             bool
+	Backfill                              bool
+	StarvationThreshold                   time.Duration
+	TopologyDefragmentationInterval       time.Duration
+	TopologyDefragmentationEvictionBudget int
+	NumOfStatusRecordingWorkers           int
+	GlobalDefaultStalenessGracePeriod     time.Duration
+	PluginServerPort                      int
+	CPUWorkerNodeLabelKey                 string
+	GPUWorkerNodeLabelKey                 string
+	MIGWorkerNodeLabelKey                 string
+	QueueLabelKey                         string
+	Namspace                              string
 
 	QPS   int
 	Burst int
@@ -120,6 +125,10 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.UseSchedulingSignatures, "use-scheduling-signatures", true, "Use scheduling signatures to avoid duplicate scheduling attempts for identical jobs")
 	fs.BoolVar(&s.FullHierarchyFairness, "full-hierarchy-fairness", true, "Fairness across project and department levels")
 	fs.BoolVar(&s.AllowConsolidatingReclaim, "allow-consolidating-reclaim", true, "Do not count pipelined pods towards 'reclaimed' resources")
+	fs.BoolVar(&s.Backfill, "backfill", false, "Reserve nodes for the blocked job at the head of the allocation order, and only allow jobs with an expected runtime that ends before the reservation to run on them")
+	fs.DurationVar(&s.StarvationThreshold, "starvation-threshold", 0, "Hold the best fitting nodes for pod groups that have been pending for longer than this duration. Zero disables the starvation guard")
+	fs.DurationVar(&s.TopologyDefragmentationInterval, "topology-defragmentation-interval", 0, "The minimal period between consolidation runs that migrate preemptible workloads to compact partially used topology domains. Zero disables topology defragmentation")
+	fs.IntVar(&s.TopologyDefragmentationEvictionBudget, "topology-defragmentation-eviction-budget", defaultTopologyDefragmentationEvictionBudget, "Maximum number of pods evicted by a single topology defragmentation run. Defaults to 4")
 	fs.IntVar(&s.NumOfStatusRecordingWorkers, "num-of-status-recording-workers", defaultNumOfStatusRecordingWorkers, "specifies the max number of go routines spawned to update pod and podgroups conditions and events. Defaults to 5")
 	fs.DurationVar(&s.GlobalDefaultStalenessGracePeriod, "default-staleness-grace-period", defaultStalenessGracePeriod, "Global default staleness grace period duration. Negative values means infinite. Defaults to 60s")
 	fs.IntVar(&s.PluginServerPort, "plugin-server-port", 8081, "The port to bind for plugin server requests")
@@ -45,33 +45,34 @@ func TestAddFlags(t *testing.T) {
 
 	// This is a snapshot of expected options parsed by args.
 	expected := &ServerOption{
-		SchedulerName:                     constants.DefaultSchedulerName,
-		Namspace:                          constants.DefaultKAINamespace,
-		MetricsNamespace:                  constants.DefaultMetricsNamespace,
-		ResourceReservationAppLabel:       constants.DefaultResourceReservationName,
-		SchedulePeriod:                    5 * time.Minute,
-		PrintVersion:                      true,
-		ListenAddress:                     defaultListenAddress,
-		ProfilerApiPort:                   defaultProfilerApiPort,
-		Verbosity:                         defaultVerbosityLevel,
-		MaxNumberConsolidationPreemptees:  defaultMaxConsolidationPreemptees,
-		FullHierarchyFairness:             true,
-		QPS:                               50,
-		Burst:                             300,
-		DetailedFitErrors:                 false,
-		UpdatePodEvictionCondition:        false,
-		UseSchedulingSignatures:           true,
-		AllowConsolidatingReclaim:         true,
-		PyroscopeBlockProfilerRate:        DefaultPyroscopeBlockProfilerRate,
-		PyroscopeMutexProfilerRate:        DefaultPyroscopeMutexProfilerRate,
-		GlobalDefaultStalenessGracePeriod: defaultStalenessGracePeriod,
-		NumOfStatusRecordingWorkers:       defaultNumOfStatusRecordingWorkers,
-		NodePoolLabelKey:                  constants.DefaultNodePoolLabelKey,
-		QueueLabelKey:                     constants.DefaultQueueLabel,
-		PluginServerPort:                  8081,
-		CPUWorkerNodeLabelKey:             constants.DefaultCPUWorkerNodeLabelKey,
-		GPUWorkerNodeLabelKey:             constants.DefaultGPUWorkerNodeLabelKey,
-		MIGWorkerNodeLabelKey:             constants.DefaultMIGWorkerNodeLabelKey,
+		SchedulerName:                         constants.DefaultSchedulerName,
+		Namspace:                              constants.DefaultKAINamespace,
+		MetricsNamespace:                      constants.DefaultMetricsNamespace,
+		ResourceReservationAppLabel:           constants.DefaultResourceReservationName,
+		SchedulePeriod:                        5 * time.Minute,
+		PrintVersion:                          true,
+		ListenAddress:                         defaultListenAddress,
+		ProfilerApiPort:                       defaultProfilerApiPort,
+		Verbosity:                             defaultVerbosityLevel,
+		MaxNumberConsolidationPreemptees:      defaultMaxConsolidationPreemptees,
+		FullHierarchyFairness:                 true,
+		QPS:                                   50,
+		Burst:                                 300,
+		DetailedFitErrors:                     false,
+		UpdatePodEvictionCondition:            false,
+		UseSchedulingSignatures:               true,
+		AllowConsolidatingReclaim:             true,
+		PyroscopeBlockProfilerRate:            DefaultPyroscopeBlockProfilerRate,
+		PyroscopeMutexProfilerRate:            DefaultPyroscopeMutexProfilerRate,
+		GlobalDefaultStalenessGracePeriod:     defaultStalenessGracePeriod,
+		NumOfStatusRecordingWorkers:           defaultNumOfStatusRecordingWorkers,
+		TopologyDefragmentationEvictionBudget: defaultTopologyDefragmentationEvictionBudget,
+		NodePoolLabelKey:                      constants.DefaultNodePoolLabelKey,
+		QueueLabelKey:                         constants.DefaultQueueLabel,
+		PluginServerPort:                      8081,
+		CPUWorkerNodeLabelKey:                 constants.DefaultCPUWorkerNodeLabelKey,
+		GPUWorkerNodeLabelKey:                 constants.DefaultGPUWorkerNodeLabelKey,
+		MIGWorkerNodeLabelKey:                 constants.DefaultMIGWorkerNodeLabelKey,
 	}
 
 	if !reflect.DeepEqual(expected, s) {
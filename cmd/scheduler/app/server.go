@@ -79,20 +79,24 @@ func BuildSchedulerParams(opt *options.ServerOption) *conf.SchedulerParams {
 	}
 
 	return &conf.SchedulerParams{
-		SchedulerName:                     opt.SchedulerName,
-		RestrictSchedulingNodes:           opt.RestrictSchedulingNodes,
-		PartitionParams:                   schedulingPartitionParams,
-		MaxNumberConsolidationPreemptees:  opt.MaxNumberConsolidationPreemptees,
-		ScheduleCSIStorage:                opt.ScheduleCSIStorage,
-		UseSchedulingSignatures:           opt.UseSchedulingSignatures,
-		FullHierarchyFairness:             opt.FullHierarchyFairness,
-		AllowConsolidatingReclaim:         opt.AllowConsolidatingReclaim,
-		NumOfStatusRecordingWorkers:       opt.NumOfStatusRecordingWorkers,
-		GlobalDefaultStalenessGracePeriod: opt.GlobalDefaultStalenessGracePeriod,
-		SchedulePeriod:                    opt.SchedulePeriod,
-		DetailedFitErrors:                 opt.DetailedFitErrors,
-		UpdatePodEvictionCondition:        opt.UpdatePodEvictionCondition,
-		QueueLabelKey:                     opt.QueueLabelKey,
+		SchedulerName:                         opt.SchedulerName,
+		RestrictSchedulingNodes:               opt.RestrictSchedulingNodes,
+		PartitionParams:                       schedulingPartitionParams,
+		MaxNumberConsolidationPreemptees:      opt.MaxNumberConsolidationPreemptees,
+		ScheduleCSIStorage:                    opt.ScheduleCSIStorage,
+		UseSchedulingSignatures:               opt.UseSchedulingSignatures,
+		FullHierarchyFairness:                 opt.FullHierarchyFairness,
+		AllowConsolidatingReclaim:             opt.AllowConsolidatingReclaim,
+		Backfill:                              opt.Backfill,
+		StarvationThreshold:                   opt.StarvationThreshold,
+		TopologyDefragmentationInterval:       opt.TopologyDefragmentationInterval,
+		TopologyDefragmentationEvictionBudget: opt.TopologyDefragmentationEvictionBudget,
+		NumOfStatusRecordingWorkers:           opt.NumOfStatusRecordingWorkers,
+		GlobalDefaultStalenessGracePeriod:     opt.GlobalDefaultStalenessGracePeriod,
+		SchedulePeriod:                        opt.SchedulePeriod,
+		DetailedFitErrors:                     opt.DetailedFitErrors,
+		UpdatePodEvictionCondition:            opt.UpdatePodEvictionCondition,
+		QueueLabelKey:                         opt.QueueLabelKey,
 	}
 }
 
@@ -43,6 +43,8 @@ type TopologiesGetter interface {
 type TopologyInterface interface {
 	Create(ctx context.Context, topology *kaiv1alpha1.Topology, opts v1.CreateOptions) (*kaiv1alpha1.Topology, error)
 	Update(ctx context.Context, topology *kaiv1alpha1.Topology, opts v1.UpdateOptions) (*kaiv1alpha1.Topology, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, topology *kaiv1alpha1.Topology, opts v1.UpdateOptions) (*kaiv1alpha1.Topology, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*kaiv1alpha1.Topology, error)
@@ -47,6 +47,11 @@ type Binder struct {
 	// leave empty if unsure to let the operator auto detect using ClusterPolicy (nvidia gpu-operator only)
 	// +kubebuilder:validation:Optional
 	CDIEnabled *bool `json:"cdiEnabled,omitempty"`
+
+	// ExternalPlugins are binder plugins that run outside of the binder. PreBind, PostBind and Rollback are forwarded
+	// to their endpoints
+	// +kubebuilder:validation:Optional
+	ExternalPlugins []ExternalPlugin `json:"externalPlugins,omitempty"`
 }
 
 func (b *Binder) SetDefaultsWhereNeeded(replicaCount *int32) {
@@ -83,6 +88,46 @@ func (b *Binder) SetDefaultsWhereNeeded(replicaCount *int32) {
 	b.MetricsPort = common.SetDefault(b.MetricsPort, ptr.To(8080))
 }
 
+type ExternalPlugin struct {
+	// Name is the name of the plugin
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Protocol is the protocol the endpoint is called with
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=HTTP;GRPC
+	Protocol string `json:"protocol"`
+
+	// Endpoint is the URL of an HTTP endpoint, or the target of a gRPC endpoint
+	// +kubebuilder:validation:Required
+	Endpoint string `json:"endpoint"`
+
+	// TimeoutSeconds is the timeout of every call to the endpoint, 10 seconds by default
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+
+	// FailurePolicy is whether a failed call to the endpoint fails the binding, or is ignored. Fail by default
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Fail;Ignore
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+
+	// TLS configures TLS connections to the endpoint. gRPC endpoints are called in plaintext unless it is set
+	// +kubebuilder:validation:Optional
+	TLS *ExternalPluginTLS `json:"tls,omitempty"`
+}
+
+type ExternalPluginTLS struct {
+	// SecretName is the name of a secret in the KAI namespace, whose ca.crt key holds the certificate authorities
+	// that verify the endpoint
+	// +kubebuilder:validation:Required
+	SecretName string `json:"secretName"`
+
+	// MutualTLS presents the tls.crt and tls.key client certificate of the secret to the endpoint
+	// +kubebuilder:validation:Optional
+	MutualTLS *bool `json:"mutualTLS,omitempty"`
+}
+
 type ResourceReservation struct {
 	// Image is the image used by the resource reservation pods
 	// +kubebuilder:validation:Optional
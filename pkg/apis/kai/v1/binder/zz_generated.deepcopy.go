@@ -56,6 +56,13 @@ func (in *Binder) DeepCopyInto(out *Binder) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ExternalPlugins != nil {
+		in, out := &in.ExternalPlugins, &out.ExternalPlugins
+		*out = make([]ExternalPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Binder.
@@ -68,6 +75,51 @@ func (in *Binder) DeepCopy() *Binder {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalPlugin) DeepCopyInto(out *ExternalPlugin) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ExternalPluginTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalPlugin.
+func (in *ExternalPlugin) DeepCopy() *ExternalPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalPluginTLS) DeepCopyInto(out *ExternalPluginTLS) {
+	*out = *in
+	if in.MutualTLS != nil {
+		in, out := &in.MutualTLS, &out.MutualTLS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalPluginTLS.
+func (in *ExternalPluginTLS) DeepCopy() *ExternalPluginTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalPluginTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceReservation) DeepCopyInto(out *ResourceReservation) {
 	*out = *in
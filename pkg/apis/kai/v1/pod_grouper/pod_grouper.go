@@ -41,6 +41,10 @@ type Args struct {
 	// +kubebuilder:validation:Optional
 	GangScheduleKnative *bool `json:"gangScheduleKnative,omitempty"`
 
+	// KueueQueueNameMapping assigns workloads with Kueue's queue-name label and no queue label to the KAI queue of the same name. Default is false.
+	// +kubebuilder:validation:Optional
+	KueueQueueNameMapping *bool `json:"kueueQueueNameMapping,omitempty"`
+
 	// DefaultPrioritiesConfigMapName The name of the configmap that contains default priorities for pod groups
 	// +kubebuilder:validation:Optional
 	DefaultPrioritiesConfigMapName *string `json:"defaultPrioritiesConfigMapName,omitempty"`
@@ -48,6 +52,14 @@ type Args struct {
 	// DefaultPrioritiesConfigMapNamespace The namespace of the configmap that contains default priorities for pod groups
 	// +kubebuilder:validation:Optional
 	DefaultPrioritiesConfigMapNamespace *string `json:"defaultPrioritiesConfigMapNamespace,omitempty"`
+
+	// DeclarativeGroupersConfigMapName The name of the configmap that contains declarative grouper configurations
+	// +kubebuilder:validation:Optional
+	DeclarativeGroupersConfigMapName *string `json:"declarativeGroupersConfigMapName,omitempty"`
+
+	// DeclarativeGroupersConfigMapNamespace The namespace of the configmap that contains declarative grouper configurations
+	// +kubebuilder:validation:Optional
+	DeclarativeGroupersConfigMapNamespace *string `json:"declarativeGroupersConfigMapNamespace,omitempty"`
 }
 
 func (pg *PodGrouper) SetDefaultsWhereNeeded(replicaCount *int32) {
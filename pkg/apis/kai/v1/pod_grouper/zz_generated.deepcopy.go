@@ -21,6 +21,11 @@ func (in *Args) DeepCopyInto(out *Args) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KueueQueueNameMapping != nil {
+		in, out := &in.KueueQueueNameMapping, &out.KueueQueueNameMapping
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DefaultPrioritiesConfigMapName != nil {
 		in, out := &in.DefaultPrioritiesConfigMapName, &out.DefaultPrioritiesConfigMapName
 		*out = new(string)
@@ -31,6 +36,16 @@ func (in *Args) DeepCopyInto(out *Args) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeclarativeGroupersConfigMapName != nil {
+		in, out := &in.DeclarativeGroupersConfigMapName, &out.DeclarativeGroupersConfigMapName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeclarativeGroupersConfigMapNamespace != nil {
+		in, out := &in.DeclarativeGroupersConfigMapNamespace, &out.DeclarativeGroupersConfigMapNamespace
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Args.
@@ -20,6 +20,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -30,6 +31,7 @@ import (
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
 
 // Topology is the Schema for the topology API
 type Topology struct {
@@ -38,6 +40,9 @@ type Topology struct {
 
 	// +kubebuilder:validation:Required
 	Spec TopologySpec `json:"spec,omitempty"`
+
+	// +optional
+	Status TopologyStatus `json:"status,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -58,7 +63,7 @@ type TopologySpec struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="field is immutable"
-	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, size(self.filter(j, j == i)) > 1)) == 0",message="must be unique"
+	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, size(self.filter(j, j.nodeLabel == i.nodeLabel)) > 1)) == 0",message="must be unique"
 	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, i.nodeLabel == 'kubernetes.io/hostname')) == 0 || self[size(self) - 1].nodeLabel == 'kubernetes.io/hostname'",message="the kubernetes.io/hostname label can only be used at the lowest level of topology"
 	Levels []TopologyLevel `json:"levels,omitempty"`
 }
@@ -78,8 +83,70 @@ type TopologyLevel struct {
 	// +kubebuilder:validation:MaxLength=316
 	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$`
 	NodeLabel string `json:"nodeLabel"`
+
+	// cost is the relative cost of communication between pods that are placed in different domains of this level,
+	// within the same domain of the level above (e.g. the cost of a hop through a spine switch between two leaf
+	// switches). When any level has a cost, the scheduler places gangs to minimize their total communication cost.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Cost int32 `json:"cost,omitempty"`
+}
+
+// TopologyStatus defines the observed state of Topology
+type TopologyStatus struct {
+	// levels report the domains of every level of the topology, in the order of spec.levels.
+	//
+	// +optional
+	// +listType=atomic
+	Levels []TopologyLevelStatus `json:"levels,omitempty"`
+}
+
+// TopologyLevelStatus defines the observed state of the domains of a single topology level
+type TopologyLevelStatus struct {
+	// nodeLabel is the node label of the level, as set in spec.levels.
+	NodeLabel string `json:"nodeLabel"`
+
+	// domains are the domains of the level, sorted by their ID.
+	//
+	// +optional
+	// +listType=atomic
+	Domains []TopologyDomainStatus `json:"domains,omitempty"`
+}
+
+// TopologyDomainStatus defines the observed state of a single topology domain
+type TopologyDomainStatus struct {
+	// id identifies the domain within its level. It joins the node label values of the domain's nodes for all the
+	// levels from the top level down to the domain's level with a ".", the same way the scheduler identifies domains.
+	ID string `json:"id"`
+
+	// nodes is the number of nodes in the domain.
+	Nodes int32 `json:"nodes"`
+
+	// totalGPUs is the number of allocatable GPUs of the domain's nodes.
+	TotalGPUs resource.Quantity `json:"totalGPUs"`
+
+	// allocatedGPUs is the number of GPUs allocated to pods running in the domain, including GPU fractions.
+	AllocatedGPUs resource.Quantity `json:"allocatedGPUs"`
+
+	// idleGPUs is the number of GPUs of the domain that are not allocated to any pod.
+	IdleGPUs resource.Quantity `json:"idleGPUs"`
+
+	// podGroupCount is the number of pod groups with pods allocated in the domain.
+	PodGroupCount int32 `json:"podGroupCount"`
+
+	// podGroups are the namespaced names of the pod groups with pods allocated in the domain, sorted. Only the first
+	// 20 pod groups are listed, podGroupCount counts all of them.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=20
+	PodGroups []string `json:"podGroups,omitempty"`
 }
 
+// MaxDomainPodGroups is the maximal number of pod groups listed in the status of a topology domain
+const MaxDomainPodGroups = 20
+
 func init() {
 	SchemeBuilder.Register(&Topology{}, &TopologyList{})
 }
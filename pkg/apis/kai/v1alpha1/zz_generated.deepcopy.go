@@ -19,6 +19,7 @@ func (in *Topology) DeepCopyInto(out *Topology) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Topology.
@@ -39,6 +40,29 @@ func (in *Topology) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDomainStatus) DeepCopyInto(out *TopologyDomainStatus) {
+	*out = *in
+	out.TotalGPUs = in.TotalGPUs.DeepCopy()
+	out.AllocatedGPUs = in.AllocatedGPUs.DeepCopy()
+	out.IdleGPUs = in.IdleGPUs.DeepCopy()
+	if in.PodGroups != nil {
+		in, out := &in.PodGroups, &out.PodGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDomainStatus.
+func (in *TopologyDomainStatus) DeepCopy() *TopologyDomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyLevel) DeepCopyInto(out *TopologyLevel) {
 	*out = *in
@@ -54,6 +78,28 @@ func (in *TopologyLevel) DeepCopy() *TopologyLevel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyLevelStatus) DeepCopyInto(out *TopologyLevelStatus) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]TopologyDomainStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyLevelStatus.
+func (in *TopologyLevelStatus) DeepCopy() *TopologyLevelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyLevelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyList) DeepCopyInto(out *TopologyList) {
 	*out = *in
@@ -105,3 +151,25 @@ func (in *TopologySpec) DeepCopy() *TopologySpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyStatus) DeepCopyInto(out *TopologyStatus) {
+	*out = *in
+	if in.Levels != nil {
+		in, out := &in.Levels, &out.Levels
+		*out = make([]TopologyLevelStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyStatus.
+func (in *TopologyStatus) DeepCopy() *TopologyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -31,6 +31,22 @@ type BindRequestSpec struct {
 
 	// BackoffLimit is the number of retries before giving up
 	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// Gang groups the BindRequests of a PodGroup that are bound all together or not at all
+	// +optional
+	Gang *GangBinding `json:"gang,omitempty"`
+}
+
+// GangBinding identifies the BindRequests that the scheduler created together for the minimum gang of a PodGroup
+type GangBinding struct {
+	// PodGroupName is the name of the PodGroup of the pod
+	PodGroupName string `json:"podGroupName"`
+
+	// ID is shared by the BindRequests that were created together for the PodGroup
+	ID string `json:"id"`
+
+	// MinMember is the number of BindRequests of the gang that have to pass PreBind before any of them is bound
+	MinMember int32 `json:"minMember"`
 }
 
 type ReceivedGPU struct {
@@ -56,6 +72,20 @@ const (
 	BindRequestPhaseFailed    = "Failed"
 )
 
+const (
+	BindFailureClassTransient          = "Transient"
+	BindFailureClassNodeGone           = "NodeGone"
+	BindFailureClassReservationTimeout = "ReservationTimeout"
+	BindFailureClassPermanent          = "Permanent"
+)
+
+const (
+	GangBindingPhaseWaiting    = "Waiting"
+	GangBindingPhaseBound      = "Bound"
+	GangBindingPhaseRolledBack = "RolledBack"
+	GangBindingPhaseFailed     = "Failed"
+)
+
 // BindRequestStatus defines the observed state of BindRequest
 type BindRequestStatus struct {
 	// Phase is the current phase of the bindrequest. [Pending/Succeeded/Failed]
@@ -64,8 +94,33 @@ type BindRequestStatus struct {
 	// Reason is the reason for the current phase
 	Reason string `json:"reason,omitempty"`
 
-	// FailedAttempts is the number of failed attempts
+	// FailedAttempts is the number of failed attempts. It restarts when the FailureClass of a failure changes
 	FailedAttempts int32 `json:"failedAttempts,omitempty"`
+
+	// FailureClass is the class of the last binding failure. [Transient/NodeGone/ReservationTimeout/Permanent]
+	// +optional
+	FailureClass string `json:"failureClass,omitempty"`
+
+	// NextRetryTime is the time the binder retries a failed binding at. It is unset once the binder gave up on the
+	// BindRequest, for the pod to be scheduled again.
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+
+	// Gang is the status of the gang binding, for BindRequests that are bound together with their gang
+	// +optional
+	Gang *GangBindingStatus `json:"gang,omitempty"`
+}
+
+// GangBindingStatus defines the observed state of the gang a BindRequest is bound with
+type GangBindingStatus struct {
+	// Phase is the phase of the gang binding. [Waiting/Bound/RolledBack/Failed]
+	Phase string `json:"phase,omitempty"`
+
+	// Members is the number of BindRequests of the gang that were found
+	Members int32 `json:"members,omitempty"`
+
+	// ReadyMembers is the number of members of the gang that are bound or passed PreBind
+	ReadyMembers int32 `json:"readyMembers,omitempty"`
 }
 
 // +genclient
@@ -20,7 +20,7 @@ func (in *BindRequest) DeepCopyInto(out *BindRequest) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindRequest.
@@ -98,6 +98,11 @@ func (in *BindRequestSpec) DeepCopyInto(out *BindRequestSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Gang != nil {
+		in, out := &in.Gang, &out.Gang
+		*out = new(GangBinding)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindRequestSpec.
@@ -113,6 +118,15 @@ func (in *BindRequestSpec) DeepCopy() *BindRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BindRequestStatus) DeepCopyInto(out *BindRequestStatus) {
 	*out = *in
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Gang != nil {
+		in, out := &in.Gang, &out.Gang
+		*out = new(GangBindingStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindRequestStatus.
@@ -125,6 +139,36 @@ func (in *BindRequestStatus) DeepCopy() *BindRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GangBinding) DeepCopyInto(out *GangBinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GangBinding.
+func (in *GangBinding) DeepCopy() *GangBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(GangBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GangBindingStatus) DeepCopyInto(out *GangBindingStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GangBindingStatus.
+func (in *GangBindingStatus) DeepCopy() *GangBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GangBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReceivedGPU) DeepCopyInto(out *ReceivedGPU) {
 	*out = *in
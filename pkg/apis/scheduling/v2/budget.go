@@ -0,0 +1,153 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aptible/supercronic/cronexpr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultBudgetResetCron starts a new budget period at the beginning of every month.
+	DefaultBudgetResetCron = "0 0 1 * *"
+)
+
+// +kubebuilder:validation:Enum=NoOverQuota;NonPreemptibleOnly
+type BudgetEnforcement string
+
+const (
+	// BudgetEnforcementNoOverQuota limits a queue that exhausted its budget to its deserved GPU quota.
+	BudgetEnforcementNoOverQuota BudgetEnforcement = "NoOverQuota"
+
+	// BudgetEnforcementNonPreemptibleOnly allows a queue that exhausted its budget to start only non-preemptible jobs,
+	// within its deserved GPU quota.
+	BudgetEnforcementNonPreemptibleOnly BudgetEnforcement = "NonPreemptibleOnly"
+)
+
+// QueueBudget limits the GPU-hours that the queue and its child queues may consume in each budget period.
+type QueueBudget struct {
+	// GPU-hours that the queue may consume in each budget period.
+	// +kubebuilder:validation:Minimum=0
+	GPUHours float64 `json:"gpuHours"`
+
+	// Cron expression (minute hour day-of-month month day-of-week) at which budget periods start.
+	// Defaults to the beginning of every month, "0 0 1 * *".
+	// +optional
+	ResetCron string `json:"resetCron,omitempty"`
+
+	// IANA time zone in which ResetCron is evaluated, e.g. "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// How the scheduler restricts the queue once it exhausted its budget. Defaults to NoOverQuota.
+	// +optional
+	Enforcement BudgetEnforcement `json:"enforcement,omitempty"`
+}
+
+// QueueBudgetStatus is the consumption of the queue's budget in the current budget period.
+type QueueBudgetStatus struct {
+	// Start of the current budget period
+	PeriodStart metav1.Time `json:"periodStart"`
+
+	// GPU-hours consumed by the queue and its child queues in the current budget period
+	ConsumedGPUHours float64 `json:"consumedGPUHours"`
+
+	// GPU-hours left in the current budget period
+	RemainingGPUHours float64 `json:"remainingGPUHours"`
+
+	// Time up to which the consumption was accounted
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// Validate checks that the budget can be evaluated.
+func (b *QueueBudget) Validate() error {
+	if b.GPUHours < 0 {
+		return fmt.Errorf("budget GPU-hours must not be negative, got %v", b.GPUHours)
+	}
+	if _, err := cronexpr.Parse(b.GetResetCron()); err != nil {
+		return fmt.Errorf("budget has an invalid reset cron expression %s: %w", b.ResetCron, err)
+	}
+	if _, err := time.LoadLocation(b.TimeZone); err != nil {
+		return fmt.Errorf("budget has an invalid time zone %s: %w", b.TimeZone, err)
+	}
+	switch b.GetEnforcement() {
+	case BudgetEnforcementNoOverQuota, BudgetEnforcementNonPreemptibleOnly:
+	default:
+		return fmt.Errorf("budget has an invalid enforcement %s", b.Enforcement)
+	}
+	return nil
+}
+
+func (b *QueueBudget) GetResetCron() string {
+	if len(b.ResetCron) == 0 {
+		return DefaultBudgetResetCron
+	}
+	return b.ResetCron
+}
+
+func (b *QueueBudget) GetEnforcement() BudgetEnforcement {
+	if len(b.Enforcement) == 0 {
+		return BudgetEnforcementNoOverQuota
+	}
+	return b.Enforcement
+}
+
+// PeriodStart returns the start of the budget period that contains now.
+func (b *QueueBudget) PeriodStart(now time.Time) (time.Time, error) {
+	expression, location, err := b.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	now = now.In(location)
+
+	// Go back more than a single interval of the cron expression, then walk forward to the latest occurrence
+	next := expression.NextN(now, 3)
+	if len(next) < 3 {
+		return time.Time{}, fmt.Errorf("budget reset cron expression %s has no upcoming occurrences", b.ResetCron)
+	}
+	previous := now.Add(-next[2].Sub(now))
+	for occurrence := expression.Next(previous); !occurrence.IsZero() && !occurrence.After(now); {
+		previous = occurrence
+		occurrence = expression.Next(occurrence)
+	}
+	return previous, nil
+}
+
+// NextPeriodStart returns the start of the budget period that follows the one that contains now.
+func (b *QueueBudget) NextPeriodStart(now time.Time) (time.Time, error) {
+	expression, location, err := b.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	next := expression.Next(now.In(location))
+	if next.IsZero() {
+		return next, fmt.Errorf("budget reset cron expression %s has no upcoming occurrences", b.ResetCron)
+	}
+	return next, nil
+}
+
+func (b *QueueBudget) parse() (*cronexpr.Expression, *time.Location, error) {
+	if err := b.Validate(); err != nil {
+		return nil, nil, err
+	}
+	expression, _ := cronexpr.Parse(b.GetResetCron())
+	location, _ := time.LoadLocation(b.TimeZone)
+	return expression, location, nil
+}
+
+// BudgetExhausted returns true if the queue consumed its whole budget in the budget period that contains now.
+// A status from an earlier period is not considered, since the budget was renewed since.
+func (q *Queue) BudgetExhausted(now time.Time) bool {
+	if q.Spec.Budget == nil || q.Status.Budget == nil {
+		return false
+	}
+	periodStart, err := q.Spec.Budget.PeriodStart(now)
+	if err != nil || !q.Status.Budget.PeriodStart.Time.Equal(periodStart) {
+		return false
+	}
+	return q.Status.Budget.RemainingGPUHours <= 0
+}
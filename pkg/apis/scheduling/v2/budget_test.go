@@ -0,0 +1,101 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueBudgetPeriods(t *testing.T) {
+	tests := []struct {
+		name                    string
+		budget                  QueueBudget
+		now                     time.Time
+		expectedPeriodStart     time.Time
+		expectedNextPeriodStart time.Time
+	}{
+		{
+			name:                    "monthly by default",
+			budget:                  QueueBudget{GPUHours: 100},
+			now:                     time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC),
+			expectedPeriodStart:     time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectedNextPeriodStart: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:                    "at the start of a period",
+			budget:                  QueueBudget{GPUHours: 100},
+			now:                     time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectedPeriodStart:     time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectedNextPeriodStart: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:                    "weekly on mondays",
+			budget:                  QueueBudget{GPUHours: 100, ResetCron: "0 0 * * 1"},
+			now:                     time.Date(2025, 6, 4, 12, 0, 0, 0, time.UTC),
+			expectedPeriodStart:     time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+			expectedNextPeriodStart: time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:                    "monthly in time zone",
+			budget:                  QueueBudget{GPUHours: 100, TimeZone: "Asia/Tokyo"},
+			now:                     time.Date(2025, 6, 30, 20, 0, 0, 0, time.UTC),
+			expectedPeriodStart:     time.Date(2025, 6, 30, 15, 0, 0, 0, time.UTC),
+			expectedNextPeriodStart: time.Date(2025, 7, 31, 15, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periodStart, err := tt.budget.PeriodStart(tt.now)
+			assert.NoError(t, err)
+			assert.True(t, tt.expectedPeriodStart.Equal(periodStart), "expected %v, got %v",
+				tt.expectedPeriodStart, periodStart)
+
+			nextPeriodStart, err := tt.budget.NextPeriodStart(tt.now)
+			assert.NoError(t, err)
+			assert.True(t, tt.expectedNextPeriodStart.Equal(nextPeriodStart), "expected %v, got %v",
+				tt.expectedNextPeriodStart, nextPeriodStart)
+		})
+	}
+}
+
+func TestQueueBudgetValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		budget QueueBudget
+		valid  bool
+	}{
+		{
+			name:   "negative gpu hours",
+			budget: QueueBudget{GPUHours: -1},
+		},
+		{
+			name:   "invalid cron",
+			budget: QueueBudget{GPUHours: 1, ResetCron: "monthly"},
+		},
+		{
+			name:   "invalid time zone",
+			budget: QueueBudget{GPUHours: 1, TimeZone: "Mars/Olympus"},
+		},
+		{
+			name:   "invalid enforcement",
+			budget: QueueBudget{GPUHours: 1, Enforcement: "Strict"},
+		},
+		{
+			name:   "valid budget",
+			budget: QueueBudget{GPUHours: 1, Enforcement: BudgetEnforcementNonPreemptibleOnly},
+			valid:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.budget.Validate()
+			assert.Equal(t, tt.valid, err == nil, "unexpected validation result: %v", err)
+		})
+	}
+}
@@ -44,6 +44,35 @@ type QueueSpec struct {
 	// Minimum runtime of a job in queue before it can be reclaimed.
 	// +optional
 	ReclaimMinRuntime *metav1.Duration `json:"reclaimMinRuntime,omitempty"`
+
+	// Time-based overrides of the queue's resources. While one of the schedules is active, its resources replace
+	// Resources. When several schedules are active, the first one in the list is in effect.
+	// +optional
+	ResourceSchedules []QueueResourceSchedule `json:"resourceSchedules,omitempty"`
+
+	// GPU-hours budget of the queue and its child queues. Once the budget of the current period is exhausted, the
+	// queue gets no over-quota GPUs until the next period starts.
+	// +optional
+	Budget *QueueBudget `json:"budget,omitempty"`
+
+	// PlacementStrategy overrides the placement strategy of the scheduling shard for the pods of the queue and of its
+	// child queues. PodGroups can override it in turn.
+	// +optional
+	PlacementStrategy *PlacementStrategy `json:"placementStrategy,omitempty"`
+}
+
+// PlacementStrategy selects how pods are placed on nodes and on GPU devices, per resource type. Empty fields keep the
+// strategy inherited from the parent queue or from the scheduling shard.
+type PlacementStrategy struct {
+	// GPU is the placement strategy of pods that request GPUs, binpack or spread.
+	// +kubebuilder:validation:Enum=binpack;spread
+	// +optional
+	GPU string `json:"gpu,omitempty"`
+
+	// CPU is the placement strategy of pods that only request CPU, binpack or spread.
+	// +kubebuilder:validation:Enum=binpack;spread
+	// +optional
+	CPU string `json:"cpu,omitempty"`
 }
 
 // QueueStatus defines the observed state of Queue
@@ -70,6 +99,18 @@ type QueueStatus struct {
 	// Current requested GPU (in fractions), CPU (in millicpus) and Memory in megabytes
 	// by all running and pending jobs in queue and child queues
 	Requested v1.ResourceList `json:"requested,omitempty"`
+
+	// Resources of the queue that are currently in effect, after applying its active resource schedule
+	// +optional
+	EffectiveResources *QueueResources `json:"effectiveResources,omitempty"`
+
+	// Name of the resource schedule that is currently in effect, empty when the queue's resources are in effect
+	// +optional
+	ActiveResourceSchedule string `json:"activeResourceSchedule,omitempty"`
+
+	// Consumption of the queue's budget in the current budget period
+	// +optional
+	Budget *QueueBudgetStatus `json:"budget,omitempty"`
 }
 
 // +genclient
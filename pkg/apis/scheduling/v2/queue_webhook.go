@@ -32,10 +32,7 @@ func (_ *Queue) ValidateCreate(_ context.Context, obj runtime.Object) (admission
 	}
 	queuelog.Info("validate create", "name", queue.Name)
 
-	if queue.Spec.Resources == nil {
-		return []string{missingResourcesError}, fmt.Errorf(missingResourcesError)
-	}
-	return nil, nil
+	return validateQueue(queue)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -46,10 +43,7 @@ func (_ *Queue) ValidateUpdate(_ context.Context, _ runtime.Object, newObj runti
 	}
 	queuelog.Info("validate update", "name", queue.Name)
 
-	if queue.Spec.Resources == nil {
-		return []string{missingResourcesError}, fmt.Errorf(missingResourcesError)
-	}
-	return nil, nil
+	return validateQueue(queue)
 }
 
 func (_ *Queue) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
@@ -60,3 +54,26 @@ func (_ *Queue) ValidateDelete(_ context.Context, obj runtime.Object) (admission
 	queuelog.Info("validate delete", "name", queue.Name)
 	return nil, nil
 }
+
+func validateQueue(queue *Queue) (admission.Warnings, error) {
+	if queue.Spec.Resources == nil {
+		return []string{missingResourcesError}, fmt.Errorf(missingResourcesError)
+	}
+	if err := queue.Spec.Resources.Validate(); err != nil {
+		return nil, err
+	}
+	for i := range queue.Spec.ResourceSchedules {
+		if err := queue.Spec.ResourceSchedules[i].Validate(); err != nil {
+			return nil, err
+		}
+		if err := queue.Spec.ResourceSchedules[i].Resources.Validate(); err != nil {
+			return nil, fmt.Errorf("resource schedule %s: %w", queue.Spec.ResourceSchedules[i].Name, err)
+		}
+	}
+	if queue.Spec.Budget != nil {
+		if err := queue.Spec.Budget.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,261 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aptible/supercronic/cronexpr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+type Weekday string
+
+// QueueResourceSchedule replaces the queue's resources while one of its time windows is active.
+// The windows are given either by a cron expression and a duration, or by weekly windows.
+type QueueResourceSchedule struct {
+	// Name of the schedule, reported in the queue status while the schedule is active.
+	Name string `json:"name"`
+
+	// Cron expression (minute hour day-of-month month day-of-week) at which a window of the schedule starts.
+	// Requires Duration.
+	// +optional
+	Cron string `json:"cron,omitempty"`
+
+	// Duration of the windows that start at Cron.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Weekly windows of the schedule. Ignored when Cron is set.
+	// +optional
+	Windows []WeeklyWindow `json:"windows,omitempty"`
+
+	// IANA time zone in which the windows are evaluated, e.g. "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Resources that replace the queue's resources while the schedule is active.
+	Resources QueueResources `json:"resources"`
+}
+
+// WeeklyWindow is a window of hours that starts on the given days of the week. A window whose EndHour is not after
+// its StartHour ends on the following day.
+type WeeklyWindow struct {
+	// Days on which the window starts. Every day when empty.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	StartHour int `json:"startHour"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=24
+	EndHour int `json:"endHour"`
+}
+
+// ParsedResourceSchedule is a resource schedule whose time zone, cron expression and weekdays were parsed when it was
+// loaded, so that it can be evaluated repeatedly.
+// +kubebuilder:object:generate=false
+type ParsedResourceSchedule struct {
+	QueueResourceSchedule
+
+	location   *time.Location
+	expression *cronexpr.Expression
+	windows    []parsedWeeklyWindow
+}
+
+// +kubebuilder:object:generate=false
+type parsedWeeklyWindow struct {
+	days      []time.Weekday
+	startHour int
+	endHour   int
+}
+
+// ResourceSchedules are the parsed resource schedules of a queue, in the order of the queue's spec.
+// +kubebuilder:object:generate=false
+type ResourceSchedules []*ParsedResourceSchedule
+
+// ParseResourceSchedules parses the resource schedules of the queue. Invalid schedules are left out, as they are never
+// active.
+func (qs *QueueSpec) ParseResourceSchedules() ResourceSchedules {
+	var schedules ResourceSchedules
+	for i := range qs.ResourceSchedules {
+		schedule, err := qs.ResourceSchedules[i].Parse()
+		if err != nil {
+			continue
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+// Active returns the first resource schedule that is active at the given time, or nil if the queue's resources are in
+// effect.
+func (rs ResourceSchedules) Active(now time.Time) *ParsedResourceSchedule {
+	for _, schedule := range rs {
+		if schedule.IsActive(now) {
+			return schedule
+		}
+	}
+	return nil
+}
+
+// NextTransition returns the earliest time after now at which one of the resource schedules starts or ends. The
+// second return value is false if none of the schedules has an upcoming window.
+func (rs ResourceSchedules) NextTransition(now time.Time) (time.Time, bool) {
+	var next time.Time
+	found := false
+	for _, schedule := range rs {
+		transition, scheduleFound := schedule.nextTransition(now)
+		if !scheduleFound {
+			continue
+		}
+		if !found || transition.Before(next) {
+			next = transition
+			found = true
+		}
+	}
+	return next, found
+}
+
+// Validate checks that the schedule can be evaluated.
+func (s *QueueResourceSchedule) Validate() error {
+	_, err := s.Parse()
+	return err
+}
+
+// Parse validates the schedule and parses it for evaluation.
+func (s *QueueResourceSchedule) Parse() (*ParsedResourceSchedule, error) {
+	if len(s.Name) == 0 {
+		return nil, fmt.Errorf("resource schedule name must be specified")
+	}
+	location, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("resource schedule %s has an invalid time zone %s: %w", s.Name, s.TimeZone, err)
+	}
+	parsed := &ParsedResourceSchedule{QueueResourceSchedule: *s, location: location}
+
+	if len(s.Cron) > 0 {
+		if parsed.expression, err = cronexpr.Parse(s.Cron); err != nil {
+			return nil, fmt.Errorf("resource schedule %s has an invalid cron expression %s: %w", s.Name, s.Cron, err)
+		}
+		if s.Duration == nil || s.Duration.Duration <= 0 {
+			return nil, fmt.Errorf("resource schedule %s must specify a positive duration for its cron expression",
+				s.Name)
+		}
+		return parsed, nil
+	}
+
+	if len(s.Windows) == 0 {
+		return nil, fmt.Errorf("resource schedule %s must specify either a cron expression or weekly windows", s.Name)
+	}
+	for _, window := range s.Windows {
+		if window.StartHour < 0 || window.StartHour > 23 || window.EndHour < 0 || window.EndHour > 24 {
+			return nil, fmt.Errorf("resource schedule %s has a window with invalid hours %d-%d",
+				s.Name, window.StartHour, window.EndHour)
+		}
+		parsedWindow := parsedWeeklyWindow{startHour: window.StartHour, endHour: window.EndHour}
+		for _, day := range window.Days {
+			weekday, err := parseWeekday(day)
+			if err != nil {
+				return nil, fmt.Errorf("resource schedule %s: %w", s.Name, err)
+			}
+			parsedWindow.days = append(parsedWindow.days, weekday)
+		}
+		parsed.windows = append(parsed.windows, parsedWindow)
+	}
+	return parsed, nil
+}
+
+// IsActive returns true if one of the schedule's windows contains the given time.
+func (s *ParsedResourceSchedule) IsActive(now time.Time) bool {
+	for _, occurrence := range s.occurrencesAround(now) {
+		if !now.Before(occurrence.start) && now.Before(occurrence.end) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ParsedResourceSchedule) nextTransition(now time.Time) (time.Time, bool) {
+	var next time.Time
+	for _, occurrence := range s.occurrencesAround(now) {
+		for _, transition := range []time.Time{occurrence.start, occurrence.end} {
+			if transition.After(now) && (next.IsZero() || transition.Before(next)) {
+				next = transition
+			}
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// +kubebuilder:object:generate=false
+type window struct {
+	start time.Time
+	end   time.Time
+}
+
+// occurrencesAround returns the windows of the schedule that contain the given time, and the first window that
+// starts after it.
+func (s *ParsedResourceSchedule) occurrencesAround(now time.Time) []window {
+	now = now.In(s.location)
+	if s.expression != nil {
+		return s.cronOccurrences(now)
+	}
+	return s.weeklyOccurrences(now)
+}
+
+func (s *ParsedResourceSchedule) cronOccurrences(now time.Time) []window {
+	duration := s.Duration.Duration
+
+	var occurrences []window
+	// The earliest window that started at most one duration ago is the one that ends first
+	start := s.expression.Next(now.Add(-duration - time.Second))
+	if !start.IsZero() && !start.After(now) {
+		occurrences = append(occurrences, window{start: start, end: start.Add(duration)})
+	}
+	if start = s.expression.Next(now); !start.IsZero() {
+		occurrences = append(occurrences, window{start: start, end: start.Add(duration)})
+	}
+	return occurrences
+}
+
+func (s *ParsedResourceSchedule) weeklyOccurrences(now time.Time) []window {
+	var occurrences []window
+	year, month, day := now.Date()
+	// Windows last at most a day, so the windows around now start between yesterday and a week from today
+	for dayOffset := -1; dayOffset <= 7; dayOffset++ {
+		date := time.Date(year, month, day+dayOffset, 0, 0, 0, 0, now.Location())
+		for _, weeklyWindow := range s.windows {
+			if !weeklyWindow.startsOn(date.Weekday()) {
+				continue
+			}
+			start := time.Date(date.Year(), date.Month(), date.Day(), weeklyWindow.startHour, 0, 0, 0, now.Location())
+			endDay := date.Day()
+			if weeklyWindow.endHour <= weeklyWindow.startHour {
+				endDay++
+			}
+			end := time.Date(date.Year(), date.Month(), endDay, weeklyWindow.endHour, 0, 0, 0, now.Location())
+			occurrences = append(occurrences, window{start: start, end: end})
+		}
+	}
+	return occurrences
+}
+
+func (w *parsedWeeklyWindow) startsOn(weekday time.Weekday) bool {
+	return len(w.days) == 0 || slices.Contains(w.days, weekday)
+}
+
+func parseWeekday(day Weekday) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if string(day) == weekday.String() {
+			return weekday, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("invalid weekday %s", day)
+}
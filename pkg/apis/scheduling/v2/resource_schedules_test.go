@@ -0,0 +1,170 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	baseResources  = QueueResources{GPU: QueueResource{Quota: 2, Limit: 4, OverQuotaWeight: 1}}
+	nightResources = QueueResources{GPU: QueueResource{Quota: 8, Limit: 16, OverQuotaWeight: 2}}
+)
+
+func TestResourceSchedules(t *testing.T) {
+	tests := []struct {
+		name             string
+		schedule         QueueResourceSchedule
+		now              time.Time
+		expectedActive   bool
+		expectedNextTime time.Time
+	}{
+		{
+			name: "weekly overnight window after midnight",
+			schedule: QueueResourceSchedule{
+				Name:    "nights",
+				Windows: []WeeklyWindow{{StartHour: 20, EndHour: 6}},
+			},
+			now:              time.Date(2025, 6, 4, 3, 30, 0, 0, time.UTC),
+			expectedActive:   true,
+			expectedNextTime: time.Date(2025, 6, 4, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekly window on another day",
+			schedule: QueueResourceSchedule{
+				Name:    "weekends",
+				Windows: []WeeklyWindow{{Days: []Weekday{"Saturday", "Sunday"}, StartHour: 0, EndHour: 24}},
+			},
+			// Wednesday
+			now:              time.Date(2025, 6, 4, 12, 0, 0, 0, time.UTC),
+			expectedActive:   false,
+			expectedNextTime: time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekly window in time zone",
+			schedule: QueueResourceSchedule{
+				Name:     "nights",
+				Windows:  []WeeklyWindow{{StartHour: 20, EndHour: 6}},
+				TimeZone: "America/New_York",
+			},
+			// 23:00 in New York
+			now:              time.Date(2025, 6, 5, 3, 0, 0, 0, time.UTC),
+			expectedActive:   true,
+			expectedNextTime: time.Date(2025, 6, 5, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "cron window is active",
+			schedule: QueueResourceSchedule{
+				Name:     "nightly",
+				Cron:     "0 22 * * *",
+				Duration: &metav1.Duration{Duration: 8 * time.Hour},
+			},
+			now:              time.Date(2025, 6, 5, 1, 0, 0, 0, time.UTC),
+			expectedActive:   true,
+			expectedNextTime: time.Date(2025, 6, 5, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "cron window has ended",
+			schedule: QueueResourceSchedule{
+				Name:     "nightly",
+				Cron:     "0 22 * * *",
+				Duration: &metav1.Duration{Duration: 8 * time.Hour},
+			},
+			now:              time.Date(2025, 6, 5, 6, 0, 0, 0, time.UTC),
+			expectedActive:   false,
+			expectedNextTime: time.Date(2025, 6, 5, 22, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.schedule.Resources = nightResources
+			spec := QueueSpec{
+				Resources:         &baseResources,
+				ResourceSchedules: []QueueResourceSchedule{tt.schedule},
+			}
+
+			assert.NoError(t, tt.schedule.Validate())
+			schedules := spec.ParseResourceSchedules()
+			assert.Len(t, schedules, 1)
+			if tt.expectedActive {
+				assert.Equal(t, tt.schedule.Name, schedules.Active(tt.now).Name)
+				assert.Equal(t, nightResources, schedules.Active(tt.now).Resources)
+			} else {
+				assert.Nil(t, schedules.Active(tt.now))
+			}
+
+			next, found := schedules.NextTransition(tt.now)
+			assert.True(t, found)
+			assert.True(t, tt.expectedNextTime.Equal(next), "expected %v, got %v", tt.expectedNextTime, next)
+		})
+	}
+}
+
+func TestQueueResourceScheduleValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule QueueResourceSchedule
+		valid    bool
+	}{
+		{
+			name:     "missing windows",
+			schedule: QueueResourceSchedule{Name: "empty"},
+		},
+		{
+			name:     "cron without duration",
+			schedule: QueueResourceSchedule{Name: "nightly", Cron: "0 22 * * *"},
+		},
+		{
+			name: "invalid cron",
+			schedule: QueueResourceSchedule{
+				Name: "nightly", Cron: "not a cron", Duration: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		{
+			name: "invalid time zone",
+			schedule: QueueResourceSchedule{
+				Name: "nights", Windows: []WeeklyWindow{{StartHour: 20, EndHour: 6}}, TimeZone: "Mars/Olympus",
+			},
+		},
+		{
+			name: "invalid weekday",
+			schedule: QueueResourceSchedule{
+				Name: "nights", Windows: []WeeklyWindow{{Days: []Weekday{"Funday"}, StartHour: 20, EndHour: 6}},
+			},
+		},
+		{
+			name: "valid weekly schedule",
+			schedule: QueueResourceSchedule{
+				Name: "nights", Windows: []WeeklyWindow{{Days: []Weekday{"Friday"}, StartHour: 20, EndHour: 6}},
+			},
+			valid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			assert.Equal(t, tt.valid, err == nil, "unexpected validation result: %v", err)
+		})
+	}
+}
+
+func TestParseResourceSchedulesLeavesOutInvalidSchedules(t *testing.T) {
+	spec := QueueSpec{
+		ResourceSchedules: []QueueResourceSchedule{
+			{Name: "nightly", Cron: "not a cron", Duration: &metav1.Duration{Duration: time.Hour}},
+			{Name: "nights", Windows: []WeeklyWindow{{StartHour: 20, EndHour: 6}}, Resources: nightResources},
+		},
+	}
+
+	schedules := spec.ParseResourceSchedules()
+	assert.Len(t, schedules, 1)
+	assert.Equal(t, "nights", schedules[0].Name)
+	assert.True(t, schedules.Active(time.Date(2025, 6, 4, 23, 0, 0, 0, time.UTC)) == schedules[0])
+}
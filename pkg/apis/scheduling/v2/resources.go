@@ -3,6 +3,15 @@
 
 package v2
 
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// resourcesWithDedicatedFields are set by the GPU, CPU and Memory fields of QueueResources
+var resourcesWithDedicatedFields = []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory, "nvidia.com/gpu", "amd.com/gpu"}
+
 type QueueResources struct {
 	// GPU resources in fractions. 0.7 = 70% of a gpu
 	GPU QueueResource `json:"gpu,omitempty"`
@@ -12,6 +21,11 @@ type QueueResources struct {
 
 	// Memory resources in megabytes. 1 = 10^6  (1000*1000) bytes
 	Memory QueueResource `json:"memory,omitempty"`
+
+	// Extended resources by name, e.g. rdma/hca or nvidia.com/mig-1g.10gb, in the units of the resource's
+	// requests. 2 = 2 devices
+	// +optional
+	ExtendedResources map[v1.ResourceName]QueueResource `json:"extendedResources,omitempty"`
 }
 
 type QueueResource struct {
@@ -22,3 +36,13 @@ type QueueResource struct {
 	// +optional
 	Limit float64 `json:"limit"`
 }
+
+// Validate checks that the extended resources don't repeat the resources that have dedicated fields.
+func (r *QueueResources) Validate() error {
+	for _, resourceName := range resourcesWithDedicatedFields {
+		if _, found := r.ExtendedResources[resourceName]; found {
+			return fmt.Errorf("%s can't be set as an extended resource, use its dedicated field", resourceName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestQueueResourcesValidate(t *testing.T) {
+	tests := []struct {
+		name              string
+		extendedResources map[v1.ResourceName]QueueResource
+		valid             bool
+	}{
+		{
+			name:  "no extended resources",
+			valid: true,
+		},
+		{
+			name: "extended resources",
+			extendedResources: map[v1.ResourceName]QueueResource{
+				"rdma/hca":               {Quota: 4, Limit: 8, OverQuotaWeight: 1},
+				"nvidia.com/mig-1g.10gb": {Quota: 2, Limit: -1, OverQuotaWeight: 1},
+			},
+			valid: true,
+		},
+		{
+			name: "gpu as extended resource",
+			extendedResources: map[v1.ResourceName]QueueResource{
+				"nvidia.com/gpu": {Quota: 4, Limit: 8, OverQuotaWeight: 1},
+			},
+		},
+		{
+			name: "cpu as extended resource",
+			extendedResources: map[v1.ResourceName]QueueResource{
+				v1.ResourceCPU: {Quota: 4, Limit: 8, OverQuotaWeight: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := QueueResources{ExtendedResources: tt.extendedResources}
+			err := resources.Validate()
+			assert.Equal(t, tt.valid, err == nil, "unexpected validation result: %v", err)
+		})
+	}
+}
@@ -15,6 +15,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementStrategy) DeepCopyInto(out *PlacementStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementStrategy.
+func (in *PlacementStrategy) DeepCopy() *PlacementStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Queue) DeepCopyInto(out *Queue) {
 	*out = *in
@@ -42,6 +57,38 @@ func (in *Queue) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueBudget) DeepCopyInto(out *QueueBudget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueBudget.
+func (in *QueueBudget) DeepCopy() *QueueBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueBudgetStatus) DeepCopyInto(out *QueueBudgetStatus) {
+	*out = *in
+	in.PeriodStart.DeepCopyInto(&out.PeriodStart)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueBudgetStatus.
+func (in *QueueBudgetStatus) DeepCopy() *QueueBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueCondition) DeepCopyInto(out *QueueCondition) {
 	*out = *in
@@ -106,12 +153,47 @@ func (in *QueueResource) DeepCopy() *QueueResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueResourceSchedule) DeepCopyInto(out *QueueResourceSchedule) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]WeeklyWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueResourceSchedule.
+func (in *QueueResourceSchedule) DeepCopy() *QueueResourceSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueResourceSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueResources) DeepCopyInto(out *QueueResources) {
 	*out = *in
 	out.GPU = in.GPU
 	out.CPU = in.CPU
 	out.Memory = in.Memory
+	if in.ExtendedResources != nil {
+		in, out := &in.ExtendedResources, &out.ExtendedResources
+		*out = make(map[corev1.ResourceName]QueueResource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueResources.
@@ -130,7 +212,7 @@ func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(QueueResources)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Priority != nil {
 		in, out := &in.Priority, &out.Priority
@@ -147,6 +229,23 @@ func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.ResourceSchedules != nil {
+		in, out := &in.ResourceSchedules, &out.ResourceSchedules
+		*out = make([]QueueResourceSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(QueueBudget)
+		**out = **in
+	}
+	if in.PlacementStrategy != nil {
+		in, out := &in.PlacementStrategy, &out.PlacementStrategy
+		*out = new(PlacementStrategy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueSpec.
@@ -195,6 +294,16 @@ func (in *QueueStatus) DeepCopyInto(out *QueueStatus) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.EffectiveResources != nil {
+		in, out := &in.EffectiveResources, &out.EffectiveResources
+		*out = new(QueueResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(QueueBudgetStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueStatus.
@@ -206,3 +315,23 @@ func (in *QueueStatus) DeepCopy() *QueueStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeeklyWindow) DeepCopyInto(out *WeeklyWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeeklyWindow.
+func (in *WeeklyWindow) DeepCopy() *WeeklyWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(WeeklyWindow)
+	in.DeepCopyInto(out)
+	return out
+}
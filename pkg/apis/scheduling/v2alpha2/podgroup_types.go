@@ -37,6 +37,13 @@ type PodGroupSpec struct {
 	// +kubebuilder:validation:Minimum=1
 	MinMember int32 `json:"minMember,omitempty" protobuf:"bytes,1,opt,name=minMember"`
 
+	// MaxMember defines the maximal number of members of an elastic PodGroup. When set, the scheduler computes a
+	// target number of members between MinMember and MaxMember from the fair share of the queue, and reports it in
+	// the status for the workload framework to scale to.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxMember *int32 `json:"maxMember,omitempty"`
+
 	// Queue defines the queue to allocate resource for PodGroup; if queue does not exist,
 	// the PodGroup will not be scheduled.
 	Queue string `json:"queue,omitempty" protobuf:"bytes,2,opt,name=queue"`
@@ -74,6 +81,25 @@ type PodGroupSpec struct {
 
 	// SubGroups defines finer-grained subsets of pods within the PodGroup with individual scheduling constraints
 	SubGroups []SubGroup `json:"subGroups,omitempty"`
+
+	// PlacementStrategy overrides the placement strategy of the queue and of the scheduling shard for the pods of
+	// this PodGroup.
+	// +optional
+	PlacementStrategy *PlacementStrategy `json:"placementStrategy,omitempty"`
+}
+
+// PlacementStrategy selects how pods are placed on nodes and on GPU devices, per resource type. Empty fields keep the
+// strategy inherited from the queue or from the scheduling shard.
+type PlacementStrategy struct {
+	// GPU is the placement strategy of pods that request GPUs, binpack or spread.
+	// +kubebuilder:validation:Enum=binpack;spread
+	// +optional
+	GPU string `json:"gpu,omitempty"`
+
+	// CPU is the placement strategy of pods that only request CPU, binpack or spread.
+	// +kubebuilder:validation:Enum=binpack;spread
+	// +optional
+	CPU string `json:"cpu,omitempty"`
 }
 
 // Preemptibility defines whether this PodGroup can be preempted
@@ -157,6 +183,22 @@ type PodGroupStatus struct {
 	// The scheduling conditions of PodGroup.
 	// +optional
 	SchedulingConditions []SchedulingCondition `json:"schedulingConditions,omitempty" protobuf:"bytes,7,opt,name=schedulingConditions"`
+
+	// The scaling target of an elastic PodGroup, one that sets MaxMember.
+	// +optional
+	Elastic *ElasticStatus `json:"elastic,omitempty"`
+}
+
+// ElasticStatus contains the scaling target that the scheduler computed for an elastic PodGroup.
+type ElasticStatus struct {
+	// TargetMember is the number of members, between MinMember and MaxMember, that fit the fair share of the queue.
+	// Workload frameworks are expected to scale their workers to it, instead of having the scheduler evict pods
+	// above it.
+	TargetMember int32 `json:"targetMember"`
+
+	// LastTransitionTime is the last time TargetMember changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // PodGroupPhase is the phase of a pod group at the current time.
@@ -270,6 +312,10 @@ type SchedulingConditionType string
 const (
 	// UnschedulableOnNodePool means the pod group is Unschedulable on the current node pool
 	UnschedulableOnNodePool SchedulingConditionType = "UnschedulableOnNodePool"
+
+	// ScheduledOnTopologyLevel means the pod group, that has a required topology level fallback ladder, was scheduled
+	// on the current node pool. The message states the topology level it was placed within.
+	ScheduledOnTopologyLevel SchedulingConditionType = "ScheduledOnTopologyLevel"
 )
 
 // These are reasons for a pod group's transition to a condition.
@@ -277,6 +323,9 @@ const (
 	// PodGroupReasonUnschedulable reason in SchedulingCondition means that the scheduler
 	// can't schedule the pod group right now, for example due to insufficient resources in the cluster.
 	PodGroupReasonUnschedulable = "Unschedulable"
+
+	// PodGroupReasonScheduled reason in SchedulingCondition means that the scheduler scheduled the pod group.
+	PodGroupReasonScheduled = "Scheduled"
 )
 
 type UnschedulableReason string
@@ -344,6 +393,13 @@ const (
 	// OverLimit means that the pod group is not schedulable because scheduling it would exceed the queue's limits.
 	OverLimit UnschedulableReason = "OverLimit"
 
+	// OverBudget means that the pod group is not schedulable because the queue has exhausted its GPU-hours budget.
+	OverBudget UnschedulableReason = "OverBudget"
+
+	// NodesReserved means that the pod group has been pending for longer than the starvation threshold, and the
+	// scheduler holds nodes for it.
+	NodesReserved UnschedulableReason = "NodesReserved"
+
 	// QueueDoesNotExist means the pod group references a queue that doesn't exist or has no parent queue.
 	QueueDoesNotExist UnschedulableReason = "QueueDoesNotExist"
 )
@@ -374,8 +430,24 @@ type TopologyConstraint struct {
 	// If set, all pods of the job must be scheduled within a single domain at this level.
 	RequiredTopologyLevel string `json:"requiredTopologyLevel,omitempty"`
 
+	// RequiredTopologyLevelFallbacks is an ordered ladder of wider levels to fall back to when the pods cannot be
+	// scheduled within RequiredTopologyLevel. Each fallback level is allowed once the pod group waited for its
+	// WaitTimeout within the previous level, and the narrower levels are still tried first.
+	// +optional
+	RequiredTopologyLevelFallbacks []TopologyLevelFallback `json:"requiredTopologyLevelFallbacks,omitempty"`
+
 	// Topology specifies the name of the topology CRD that defines the
 	// physical layout to use for this constraint. This allows for supporting
 	// multiple different topology configurations in the same cluster.
 	Topology string `json:"topology,omitempty"`
 }
+
+type TopologyLevelFallback struct {
+	// TopologyLevel is the level in the topology hierarchy that all pods must be scheduled within after falling back.
+	TopologyLevel string `json:"topologyLevel"`
+
+	// WaitTimeout is how long the pod group waits to be scheduled within the previous level of the ladder before
+	// falling back to this level.
+	// +optional
+	WaitTimeout metav1.Duration `json:"waitTimeout,omitempty"`
+}
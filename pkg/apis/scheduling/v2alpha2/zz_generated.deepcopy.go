@@ -14,6 +14,37 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticStatus) DeepCopyInto(out *ElasticStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticStatus.
+func (in *ElasticStatus) DeepCopy() *ElasticStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementStrategy) DeepCopyInto(out *PlacementStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementStrategy.
+func (in *PlacementStrategy) DeepCopy() *PlacementStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodGroup) DeepCopyInto(out *PodGroup) {
 	*out = *in
@@ -128,6 +159,11 @@ func (in *PodGroupResourcesStatus) DeepCopy() *PodGroupResourcesStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodGroupSpec) DeepCopyInto(out *PodGroupSpec) {
 	*out = *in
+	if in.MaxMember != nil {
+		in, out := &in.MaxMember, &out.MaxMember
+		*out = new(int32)
+		**out = **in
+	}
 	if in.MarkUnschedulable != nil {
 		in, out := &in.MarkUnschedulable, &out.MarkUnschedulable
 		*out = new(bool)
@@ -138,7 +174,7 @@ func (in *PodGroupSpec) DeepCopyInto(out *PodGroupSpec) {
 		*out = new(int32)
 		**out = **in
 	}
-	out.TopologyConstraint = in.TopologyConstraint
+	in.TopologyConstraint.DeepCopyInto(&out.TopologyConstraint)
 	if in.SubGroups != nil {
 		in, out := &in.SubGroups, &out.SubGroups
 		*out = make([]SubGroup, len(*in))
@@ -146,6 +182,11 @@ func (in *PodGroupSpec) DeepCopyInto(out *PodGroupSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PlacementStrategy != nil {
+		in, out := &in.PlacementStrategy, &out.PlacementStrategy
+		*out = new(PlacementStrategy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGroupSpec.
@@ -176,6 +217,11 @@ func (in *PodGroupStatus) DeepCopyInto(out *PodGroupStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Elastic != nil {
+		in, out := &in.Elastic, &out.Elastic
+		*out = new(ElasticStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGroupStatus.
@@ -286,7 +332,7 @@ func (in *SubGroup) DeepCopyInto(out *SubGroup) {
 	if in.TopologyConstraint != nil {
 		in, out := &in.TopologyConstraint, &out.TopologyConstraint
 		*out = new(TopologyConstraint)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -303,6 +349,11 @@ func (in *SubGroup) DeepCopy() *SubGroup {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyConstraint) DeepCopyInto(out *TopologyConstraint) {
 	*out = *in
+	if in.RequiredTopologyLevelFallbacks != nil {
+		in, out := &in.RequiredTopologyLevelFallbacks, &out.RequiredTopologyLevelFallbacks
+		*out = make([]TopologyLevelFallback, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyConstraint.
@@ -315,6 +366,22 @@ func (in *TopologyConstraint) DeepCopy() *TopologyConstraint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyLevelFallback) DeepCopyInto(out *TopologyLevelFallback) {
+	*out = *in
+	out.WaitTimeout = in.WaitTimeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyLevelFallback.
+func (in *TopologyLevelFallback) DeepCopy() *TopologyLevelFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyLevelFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnschedulableExplanation) DeepCopyInto(out *UnschedulableExplanation) {
 	*out = *in
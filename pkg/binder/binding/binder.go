@@ -40,29 +40,44 @@ func NewBinder(kubeClient client.Client, rrs resourcereservation.Interface, plug
 }
 
 func (b *Binder) Bind(ctx context.Context, pod *v1.Pod, node *v1.Node, bindRequest *v1alpha2.BindRequest) error {
-	logger := log.FromContext(ctx)
+	bindingState, err := b.PreBind(ctx, pod, node, bindRequest)
+	if err != nil {
+		return err
+	}
+	return b.CompleteBind(ctx, pod, node, bindRequest, bindingState)
+}
+
+func (b *Binder) PreBind(
+	ctx context.Context, pod *v1.Pod, node *v1.Node, bindRequest *v1alpha2.BindRequest,
+) (*state.BindingState, error) {
 	err := b.resourceReservationService.SyncForNode(ctx, bindRequest.Spec.SelectedNode)
 	if err != nil {
-		return fmt.Errorf("failed to sync reservation for pod <%s/%s> on node <%s>: %w", pod.Namespace, pod.Name, bindRequest.Spec.SelectedNode, err)
+		return nil, fmt.Errorf("failed to sync reservation for pod <%s/%s> on node <%s>: %w", pod.Namespace, pod.Name, bindRequest.Spec.SelectedNode, err)
 	}
 
 	var reservedGPUIds []string
 	if common.IsSharedGPUAllocation(bindRequest) {
 		reservedGPUIds, err = b.reserveGPUs(ctx, pod, bindRequest)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	bindingState := &state.BindingState{
 		ReservedGPUIds: reservedGPUIds,
 	}
 
-	err = b.plugins.PreBind(ctx, pod, node, bindRequest, bindingState)
-	if err != nil {
-		return err
+	if err = b.plugins.PreBind(ctx, pod, node, bindRequest, bindingState); err != nil {
+		return nil, err
 	}
+	return bindingState, nil
+}
 
-	err = b.patchResourceReceivedTypeAnnotation(ctx, pod, bindRequest)
+func (b *Binder) CompleteBind(
+	ctx context.Context, pod *v1.Pod, node *v1.Node, bindRequest *v1alpha2.BindRequest,
+	bindingState *state.BindingState,
+) error {
+	logger := log.FromContext(ctx)
+	err := b.patchResourceReceivedTypeAnnotation(ctx, pod, bindRequest)
 	if err != nil {
 		return fmt.Errorf("failed to patch pod <%s/%s> with resource receive type annotation: %w", pod.Namespace, pod.Name, err)
 	}
@@ -113,6 +128,9 @@ func (b *Binder) reserveGPUs(ctx context.Context, pod *v1.Pod, bindRequest *v1al
 		// Old bindingRequest bad conversion. delete the binding request.
 		return nil, fmt.Errorf("no SelectedGPUGroups for fractional pod: %w", InvalidCrdWarning)
 	}
+	if err := validateSelectedGPUGroups(bindRequest); err != nil {
+		return nil, err
+	}
 
 	var gpuIndexes []string
 	for _, gpuGroup := range bindRequest.Spec.SelectedGPUGroups {
@@ -127,6 +145,26 @@ func (b *Binder) reserveGPUs(ctx context.Context, pod *v1.Pod, bindRequest *v1al
 	return gpuIndexes, nil
 }
 
+// validateSelectedGPUGroups makes sure that a fractional pod receives its portion from each of the requested number
+// of devices - every selected GPU group is a different device, all on the selected node.
+func validateSelectedGPUGroups(bindRequest *v1alpha2.BindRequest) error {
+	receivedGPU := bindRequest.Spec.ReceivedGPU
+	if receivedGPU != nil && receivedGPU.Count > 0 && len(bindRequest.Spec.SelectedGPUGroups) != receivedGPU.Count {
+		return fmt.Errorf("%d SelectedGPUGroups for fractional pod that received %d GPU devices: %w",
+			len(bindRequest.Spec.SelectedGPUGroups), receivedGPU.Count, InvalidCrdWarning)
+	}
+
+	selectedGPUGroups := map[string]bool{}
+	for _, gpuGroup := range bindRequest.Spec.SelectedGPUGroups {
+		if selectedGPUGroups[gpuGroup] {
+			return fmt.Errorf("gpu group <%s> was selected more than once for fractional pod: %w",
+				gpuGroup, InvalidCrdWarning)
+		}
+		selectedGPUGroups[gpuGroup] = true
+	}
+	return nil
+}
+
 func (b *Binder) patchResourceReceivedTypeAnnotation(ctx context.Context, pod *v1.Pod, bindRequest *v1alpha2.BindRequest) error {
 	patchBytes, err := json.Marshal(map[string]interface{}{
 		"metadata": map[string]interface{}{
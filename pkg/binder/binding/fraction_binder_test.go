@@ -265,4 +265,88 @@ var _ = Describe("FractionBinder", func() {
 		Expect(result).NotTo(BeNil())
 		Expect(errors.Is(result, InvalidCrdWarning)).To(BeTrue())
 	})
+	It("Bind - multiple fractional GPU devices", func() {
+		controller := gomock.NewController(GinkgoT())
+		rrs := rrmock.NewMockInterface(controller)
+		rrs.EXPECT().SyncForNode(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+		rrs.EXPECT().ReserveGpuDevice(gomock.Any(), gomock.Any(), "my-node", "group-a").Times(1).Return("0", nil)
+		rrs.EXPECT().ReserveGpuDevice(gomock.Any(), gomock.Any(), "my-node", "group-b").Times(1).Return("3", nil)
+
+		var clientInterceptFuncs interceptor.Funcs
+		clientInterceptFuncs.SubResource = func(c client.WithWatch, subResource string) client.SubResourceClient {
+			return &test_utils.FakeSubResourceClient{}
+		}
+		fakeClient := fake.NewClientBuilder().WithRuntimeObjects(
+			happyFlowObjectsBc...).WithInterceptorFuncs(clientInterceptFuncs).Build()
+
+		binderPlugins := plugins.New()
+		binderPlugins.RegisterPlugin(bindinggpusharing.New(fakeClient, false))
+		testedBinder := NewBinder(fakeClient, rrs, binderPlugins)
+
+		pod := happyFlowObjectsBc[0].(*v1.Pod)
+		bindRequest := &v1alpha2.BindRequest{
+			Spec: v1alpha2.BindRequestSpec{
+				SelectedNode:         "my-node",
+				ReceivedResourceType: common.ReceivedTypeFraction,
+				ReceivedGPU: &v1alpha2.ReceivedGPU{
+					Count:   2,
+					Portion: "0.5",
+				},
+				SelectedGPUGroups: []string{"group-a", "group-b"},
+			},
+		}
+
+		result := testedBinder.Bind(
+			context.TODO(), pod, &v1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name: "my-node",
+			}}, bindRequest)
+		Expect(result).To(BeNil())
+
+		configMap := &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-configmap-shared-gpu-0",
+				Namespace: "my-ns",
+			},
+		}
+		Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		Expect(configMap.Data[constants.NvidiaVisibleDevices]).To(Equal("0,3"))
+		Expect(configMap.Data[common.GPUPortion]).To(Equal("0.5"))
+	})
+
+	for testName, selectedGPUGroups := range map[string][]string{
+		"Bind - fewer selected GPU groups than received devices": {"group-a"},
+		"Bind - same GPU group selected for multiple devices":    {"group-a", "group-a"},
+	} {
+		selectedGPUGroups := selectedGPUGroups
+		It(testName, func() {
+			controller := gomock.NewController(GinkgoT())
+			rrs := rrmock.NewMockInterface(controller)
+			rrs.EXPECT().SyncForNode(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+			fakeClient := fake.NewClientBuilder().WithRuntimeObjects(happyFlowObjects...).Build()
+			binderPlugins := plugins.New()
+			binderPlugins.RegisterPlugin(bindinggpusharing.New(fakeClient, false))
+			testedBinder := NewBinder(fakeClient, rrs, binderPlugins)
+
+			pod := happyFlowObjects[0].(*v1.Pod)
+			bindRequest := &v1alpha2.BindRequest{
+				Spec: v1alpha2.BindRequestSpec{
+					SelectedNode:         "my-node",
+					ReceivedResourceType: common.ReceivedTypeFraction,
+					ReceivedGPU: &v1alpha2.ReceivedGPU{
+						Count:   2,
+						Portion: "0.5",
+					},
+					SelectedGPUGroups: selectedGPUGroups,
+				},
+			}
+
+			result := testedBinder.Bind(
+				context.TODO(), pod, &v1.Node{ObjectMeta: metav1.ObjectMeta{
+					Name: "my-node",
+				}}, bindRequest)
+			Expect(result).NotTo(BeNil())
+			Expect(errors.Is(result, InvalidCrdWarning)).To(BeTrue())
+		})
+	}
 })
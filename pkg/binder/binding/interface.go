@@ -9,9 +9,15 @@ import (
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
 )
 
 type Interface interface {
 	Bind(ctx context.Context, task *v1.Pod, host *v1.Node, bindRequest *v1alpha2.BindRequest) error
+	// PreBind reserves the resources of the pod and runs the PreBind plugins, without binding the pod
+	PreBind(ctx context.Context, task *v1.Pod, host *v1.Node, bindRequest *v1alpha2.BindRequest) (*state.BindingState, error)
+	// CompleteBind binds a pod that passed PreBind
+	CompleteBind(ctx context.Context, task *v1.Pod, host *v1.Node, bindRequest *v1alpha2.BindRequest,
+		bindingState *state.BindingState) error
 	Rollback(ctx context.Context, task *v1.Pod, host *v1.Node, bindRequest *v1alpha2.BindRequest) error
 }
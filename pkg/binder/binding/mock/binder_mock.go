@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	v1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	state "github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
 	gomock "go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 )
@@ -56,6 +57,35 @@ func (mr *MockInterfaceMockRecorder) Bind(ctx, task, host, bindRequest any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bind", reflect.TypeOf((*MockInterface)(nil).Bind), ctx, task, host, bindRequest)
 }
 
+// CompleteBind mocks base method.
+func (m *MockInterface) CompleteBind(ctx context.Context, task *v1.Pod, host *v1.Node, bindRequest *v1alpha2.BindRequest, bindingState *state.BindingState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteBind", ctx, task, host, bindRequest, bindingState)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteBind indicates an expected call of CompleteBind.
+func (mr *MockInterfaceMockRecorder) CompleteBind(ctx, task, host, bindRequest, bindingState any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteBind", reflect.TypeOf((*MockInterface)(nil).CompleteBind), ctx, task, host, bindRequest, bindingState)
+}
+
+// PreBind mocks base method.
+func (m *MockInterface) PreBind(ctx context.Context, task *v1.Pod, host *v1.Node, bindRequest *v1alpha2.BindRequest) (*state.BindingState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreBind", ctx, task, host, bindRequest)
+	ret0, _ := ret[0].(*state.BindingState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreBind indicates an expected call of PreBind.
+func (mr *MockInterfaceMockRecorder) PreBind(ctx, task, host, bindRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreBind", reflect.TypeOf((*MockInterface)(nil).PreBind), ctx, task, host, bindRequest)
+}
+
 // Rollback mocks base method.
 func (m *MockInterface) Rollback(ctx context.Context, task *v1.Pod, host *v1.Node, bindRequest *v1alpha2.BindRequest) error {
 	m.ctrl.T.Helper()
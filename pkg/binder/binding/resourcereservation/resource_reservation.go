@@ -6,6 +6,7 @@ package resourcereservation
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"strings"
@@ -45,6 +46,9 @@ const (
 	unknownGpuIndicator            = "-1"
 )
 
+// ErrReservationPodNotAllocated is returned when the GPU reservation pod was not allocated a GPU in time
+var ErrReservationPodNotAllocated = errors.New("failed waiting for GPU reservation pod to allocate")
+
 type service struct {
 	fakeGPuNodes        bool
 	kubeClient          client.WithWatch
@@ -342,8 +346,7 @@ func (rsc *service) createGPUReservationPodAndGetIndex(ctx context.Context, node
 		if deleteErr != nil {
 			logger.Error(deleteErr, "failed to delete reservation pod", "name", pod.Name)
 		}
-		return unknownGpuIndicator, fmt.Errorf(
-			"failed waiting for GPU reservation pod to allocate: %v/%v", rsc.namespace, pod.Name)
+		return unknownGpuIndicator, fmt.Errorf("%w: %v/%v", ErrReservationPodNotAllocated, rsc.namespace, pod.Name)
 	}
 
 	return gpuIndex, err
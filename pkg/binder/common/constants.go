@@ -5,6 +5,7 @@ package common
 
 const (
 	GPUPortion           = "GPU_PORTION"
+	GPUIsolationMode     = "GPU_ISOLATION_MODE"
 	ReceivedTypeFraction = "Fraction"
 	ReceivedTypeRegular  = "Regular"
 )
@@ -0,0 +1,81 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"errors"
+	"time"
+
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
+)
+
+var (
+	errNodeNotFound = errors.New("selected node was not found")
+	errPodNotFound  = errors.New("pod was not found")
+)
+
+// bindRetryPolicy is how the binder retries a failed binding of a class of failures. The delay before the retry
+// doubles with every failed attempt, from baseDelay and up to maxDelay.
+type bindRetryPolicy struct {
+	retries   int32
+	baseDelay time.Duration
+	maxDelay  time.Duration
+}
+
+func (p bindRetryPolicy) delay(failedAttempts int32) time.Duration {
+	delay := p.baseDelay
+	for i := int32(0); i < failedAttempts && delay < p.maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.maxDelay)
+}
+
+func newBindRetryPolicies(params *ReconcilerParams) map[string]bindRetryPolicy {
+	return map[string]bindRetryPolicy{
+		schedulingv1alpha2.BindFailureClassTransient: {
+			retries:   int32(params.TransientBindRetries),
+			baseDelay: time.Second,
+			maxDelay:  time.Minute,
+		},
+		schedulingv1alpha2.BindFailureClassReservationTimeout: {
+			retries:   int32(params.ReservationTimeoutBindRetries),
+			baseDelay: 10 * time.Second,
+			maxDelay:  2 * time.Minute,
+		},
+		// The scheduler should place the pod elsewhere, retrying the same node is pointless
+		schedulingv1alpha2.BindFailureClassNodeGone:  {},
+		schedulingv1alpha2.BindFailureClassPermanent: {},
+	}
+}
+
+// classifyBindError returns the class of a binding failure, by which the binder decides whether to retry it.
+func classifyBindError(err error) string {
+	switch {
+	case errors.Is(err, errNodeNotFound):
+		return schedulingv1alpha2.BindFailureClassNodeGone
+	case errors.Is(err, resourcereservation.ErrReservationPodNotAllocated):
+		return schedulingv1alpha2.BindFailureClassReservationTimeout
+	case errors.Is(err, errPodNotFound), errors.Is(err, plugins.ErrPermanent),
+		errors.Is(err, binding.InvalidCrdWarning):
+		return schedulingv1alpha2.BindFailureClassPermanent
+	default:
+		return schedulingv1alpha2.BindFailureClassTransient
+	}
+}
+
+// bindRetries returns the number of times the binder retries a BindRequest that failed with the given class. The
+// BackoffLimit of the BindRequest, when set, replaces the retries of transient failures and caps the other classes.
+func (r *BindRequestReconciler) bindRetries(bindRequest *schedulingv1alpha2.BindRequest, failureClass string) int32 {
+	retries := r.retryPolicies[failureClass].retries
+	if bindRequest.Spec.BackoffLimit == nil {
+		return retries
+	}
+	if failureClass == schedulingv1alpha2.BindFailureClassTransient {
+		return *bindRequest.Spec.BackoffLimit
+	}
+	return min(retries, *bindRequest.Spec.BackoffLimit)
+}
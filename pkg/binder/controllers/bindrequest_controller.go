@@ -12,6 +12,7 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -19,6 +20,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -29,6 +31,7 @@ import (
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation/group_mutex"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
 
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
@@ -41,15 +44,19 @@ const (
 // BindRequestReconciler reconciles a BindRequest object
 type BindRequestReconciler struct {
 	Client              client.Client
+	apiReader           client.Reader
 	Scheme              *runtime.Scheme
 	binder              binding.Interface
 	resourceReservation resourcereservation.Interface
 	eventRecorder       record.EventRecorder
 	params              *ReconcilerParams
+	retryPolicies       map[string]bindRetryPolicy
+	gangMutex           *group_mutex.GroupMutex
 }
 
 func NewBindRequestReconciler(
 	client client.Client,
+	apiReader client.Reader,
 	scheme *runtime.Scheme,
 	eventRecorder record.EventRecorder,
 	params *ReconcilerParams,
@@ -58,11 +65,14 @@ func NewBindRequestReconciler(
 ) *BindRequestReconciler {
 	return &BindRequestReconciler{
 		Client:              client,
+		apiReader:           apiReader,
 		Scheme:              scheme,
 		binder:              binder,
 		resourceReservation: resourceReservation,
 		eventRecorder:       eventRecorder,
 		params:              params,
+		retryPolicies:       newBindRetryPolicies(params),
+		gangMutex:           group_mutex.NewGroupMutex(),
 	}
 }
 
@@ -109,6 +119,23 @@ func (r *BindRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return result, nil
 	}
 
+	if bindRequest.Status.Phase == schedulingv1alpha2.BindRequestPhaseFailed && bindRequest.Status.FailureClass != "" {
+		if bindRequest.Status.NextRetryTime == nil {
+			// The binder gave up on the BindRequest, the scheduler will schedule the pod again
+			return result, nil
+		}
+		if untilRetry := time.Until(bindRequest.Status.NextRetryTime.Time); untilRetry > 0 {
+			return ctrl.Result{RequeueAfter: untilRetry}, nil
+		}
+	}
+
+	if isGangBindRequest(bindRequest) {
+		gangReconciled, gangResult, gangErr := r.reconcileGang(ctx, bindRequest)
+		if gangReconciled {
+			return gangResult, gangErr
+		}
+	}
+
 	defer func() {
 		var finalError error
 		if r := recover(); r != nil {
@@ -120,6 +147,10 @@ func (r *BindRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		if pod != nil {
 			r.updatePodCondition(ctx, bindRequest, pod, result, err)
 		}
+		if result.RequeueAfter != 0 {
+			// Retried after the backoff of the failure class, rather than by the rate limiter
+			err = nil
+		}
 
 		if finalError != nil {
 			err = finalError
@@ -133,6 +164,9 @@ func (r *BindRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		},
 	}
 	if err = r.Client.Get(ctx, client.ObjectKeyFromObject(pod), pod); err != nil {
+		if kerrors.IsNotFound(err) {
+			err = fmt.Errorf("%w: %w", errPodNotFound, err)
+		}
 		return result, err
 	}
 	if pod.Spec.NodeName != "" {
@@ -147,6 +181,9 @@ func (r *BindRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		},
 	}
 	if err = r.Client.Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+		if kerrors.IsNotFound(err) {
+			err = fmt.Errorf("%w: %w", errNodeNotFound, err)
+		}
 		return result, err
 	}
 
@@ -231,17 +268,27 @@ func (r *BindRequestReconciler) UpdateStatus(
 	bindRequest.DeepCopyInto(originalBindRequest)
 
 	if err != nil {
-		if bindRequest.Spec.BackoffLimit != nil && *bindRequest.Spec.BackoffLimit > bindRequest.Status.FailedAttempts {
-			result.RequeueAfter = (1 << bindRequest.Status.FailedAttempts) * time.Second
-			bindRequest.Status.FailedAttempts++
+		failureClass := classifyBindError(err)
+		// Each class of failures has its own retries, the attempts of an earlier class don't count against them
+		if bindRequest.Status.FailureClass != "" && bindRequest.Status.FailureClass != failureClass {
+			bindRequest.Status.FailedAttempts = 0
 		}
 		bindRequest.Status.Phase = schedulingv1alpha2.BindRequestPhaseFailed
 		bindRequest.Status.Reason = err.Error()
+		bindRequest.Status.FailureClass = failureClass
+		bindRequest.Status.NextRetryTime = nil
+		if bindRequest.Status.FailedAttempts < r.bindRetries(bindRequest, failureClass) {
+			result.RequeueAfter = r.retryPolicies[failureClass].delay(bindRequest.Status.FailedAttempts)
+			bindRequest.Status.NextRetryTime = ptr.To(metav1.NewTime(time.Now().Add(result.RequeueAfter)))
+			bindRequest.Status.FailedAttempts++
+		}
 	} else {
 		bindRequest.Status.Phase = schedulingv1alpha2.BindRequestPhaseSucceeded
+		bindRequest.Status.FailureClass = ""
+		bindRequest.Status.NextRetryTime = nil
 	}
 
-	if originalBindRequest.Status.Phase == bindRequest.Status.Phase {
+	if equality.Semantic.DeepEqual(originalBindRequest.Status, bindRequest.Status) {
 		return result, nil
 	}
 
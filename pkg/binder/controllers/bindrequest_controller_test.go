@@ -15,6 +15,7 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -32,6 +33,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
 	mockplugins "github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/mock"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
 )
 
 const (
@@ -92,7 +94,7 @@ var _ = Describe("BindRequest Controller", func() {
 			resourceReservationNameSpace, resourceReservationServiceAccount, resourceReservationAppLabelValue, scalingPodsNamespace, constants.DefaultRuntimeClassName,
 			nil) // nil podResources to use defaults
 		binder := binding.NewBinder(fakeClient, rrs, binderPlugins)
-		reconciler = NewBindRequestReconciler(fakeClient, testScheme, fakeEventRecorder, params,
+		reconciler = NewBindRequestReconciler(fakeClient, fakeClient, testScheme, fakeEventRecorder, params,
 			binder, rrs)
 	})
 
@@ -211,7 +213,7 @@ var _ = Describe("BindRequest Controller", func() {
 				false, false, false,
 			),
 			Entry(
-				"missing node but backoff limit not reached",
+				"missing node is not retried below the backoff limit",
 				[]client.Object{
 					pod.DeepCopy(),
 				},
@@ -222,7 +224,34 @@ var _ = Describe("BindRequest Controller", func() {
 					bindRequest.Spec.BackoffLimit = ptr.To(int32(1))
 					return bindRequest
 				}(),
-				true, true, false,
+				true, false, false,
+			),
+			Entry(
+				"binder gave up on the bind request",
+				[]client.Object{pod.DeepCopy(), node.DeepCopy()},
+				func() *schedulingv1alpha2.BindRequest {
+					bindRequest := baseRequest.DeepCopy()
+					bindRequest.Spec.PodName = pod.Name
+					bindRequest.Spec.SelectedNode = node.Name
+					bindRequest.Status.Phase = schedulingv1alpha2.BindRequestPhaseFailed
+					bindRequest.Status.FailureClass = schedulingv1alpha2.BindFailureClassPermanent
+					return bindRequest
+				}(),
+				false, false, false,
+			),
+			Entry(
+				"binder retries the bind request later",
+				[]client.Object{pod.DeepCopy(), node.DeepCopy()},
+				func() *schedulingv1alpha2.BindRequest {
+					bindRequest := baseRequest.DeepCopy()
+					bindRequest.Spec.PodName = pod.Name
+					bindRequest.Spec.SelectedNode = node.Name
+					bindRequest.Status.Phase = schedulingv1alpha2.BindRequestPhaseFailed
+					bindRequest.Status.FailureClass = schedulingv1alpha2.BindFailureClassTransient
+					bindRequest.Status.NextRetryTime = ptr.To(metav1.NewTime(time.Now().Add(time.Minute)))
+					return bindRequest
+				}(),
+				false, true, false,
 			),
 			Entry(
 				"missing node but backoff limit already reached",
@@ -305,6 +334,216 @@ var _ = Describe("BindRequest Controller", func() {
 				}
 			})
 		})
+
+		Context("gang", func() {
+			var (
+				mockBinder   *mock_binder.MockInterface
+				bindRequests []*schedulingv1alpha2.BindRequest
+			)
+
+			createGang := func(members int, minMember int32) {
+				node := node.DeepCopy()
+				node.ResourceVersion = ""
+				Expect(fakeClient.Create(context.TODO(), node)).Should(Succeed())
+				bindRequests = nil
+				for i := 0; i < members; i++ {
+					pod := pod.DeepCopy()
+					pod.Name = fmt.Sprintf("pod-%d", i)
+					pod.ResourceVersion = ""
+					Expect(fakeClient.Create(context.TODO(), pod)).Should(Succeed())
+
+					bindRequest := baseRequest.DeepCopy()
+					bindRequest.Name = fmt.Sprintf("bind-request-%d", i)
+					bindRequest.Spec.PodName = pod.Name
+					bindRequest.Spec.SelectedNode = node.Name
+					bindRequest.Spec.Gang = &schedulingv1alpha2.GangBinding{
+						PodGroupName: "pg", ID: "gang-id", MinMember: minMember,
+					}
+					bindRequest.Labels = map[string]string{
+						constants.GangPodGroupLabelKey: "pg", constants.GangIDLabelKey: "gang-id",
+					}
+					bindRequest.CreationTimestamp = metav1.Now()
+					Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+					bindRequests = append(bindRequests, bindRequest)
+				}
+			}
+
+			reconcileGangMember := func(i int) ctrl.Result {
+				result, err := reconciler.Reconcile(context.TODO(), ctrl.Request{
+					NamespacedName: client.ObjectKeyFromObject(bindRequests[i]),
+				})
+				Expect(err).Should(BeNil())
+				return result
+			}
+
+			getGangMember := func(i int) *schedulingv1alpha2.BindRequest {
+				bindRequest := &schedulingv1alpha2.BindRequest{}
+				Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(bindRequests[i]),
+					bindRequest)).Should(Succeed())
+				return bindRequest
+			}
+
+			BeforeEach(func() {
+				mockBinder = mock_binder.NewMockInterface(gomock.NewController(GinkgoT()))
+				reconciler.binder = mockBinder
+				reconciler.params.GangBindingTimeoutSeconds = 60
+			})
+
+			It("binds all the members once they all passed PreBind", func() {
+				createGang(3, 3)
+				mockBinder.EXPECT().PreBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).Times(3)
+				mockBinder.EXPECT().CompleteBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).Times(3)
+
+				reconcileGangMember(0)
+				for i := range bindRequests {
+					bindRequest := getGangMember(i)
+					Expect(bindRequest.Status.Phase).To(Equal(schedulingv1alpha2.BindRequestPhaseSucceeded))
+					Expect(bindRequest.Status.Gang).To(Equal(&schedulingv1alpha2.GangBindingStatus{
+						Phase: schedulingv1alpha2.GangBindingPhaseBound, Members: 3, ReadyMembers: 3,
+					}))
+				}
+
+				// Reconciling the other members after the gang was bound does nothing
+				reconcileGangMember(1)
+			})
+
+			It("rolls back all the members when one of them fails PreBind", func() {
+				createGang(3, 3)
+				mockBinder.EXPECT().PreBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pod *v1.Pod, _ *v1.Node, _ *schedulingv1alpha2.BindRequest) (
+						*state.BindingState, error) {
+						if pod.Name == "pod-1" {
+							return nil, errors.New("pre-bind failed")
+						}
+						return nil, nil
+					}).Times(3)
+				mockBinder.EXPECT().Rollback(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).Times(3)
+
+				reconcileGangMember(0)
+				for i := range bindRequests {
+					bindRequest := getGangMember(i)
+					Expect(bindRequest.Status.Phase).To(Equal(schedulingv1alpha2.BindRequestPhaseFailed))
+					Expect(bindRequest.Status.Reason).To(ContainSubstring("2 of 3 members passed PreBind"))
+					Expect(bindRequest.Status.Gang.Phase).To(Equal(schedulingv1alpha2.GangBindingPhaseRolledBack))
+				}
+				Expect(getGangMember(1).Status.Reason).To(ContainSubstring("pre-bind failed"))
+
+				// Failed members are left for the scheduler to re-plan
+				reconcileGangMember(2)
+			})
+
+			It("evicts the members that were already bound when the gang is rolled back", func() {
+				createGang(3, 3)
+				boundPod := &v1.Pod{}
+				Expect(fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "pod-0"},
+					boundPod)).Should(Succeed())
+				boundPod.Spec.NodeName = node.Name
+				Expect(fakeClient.Update(context.TODO(), boundPod)).Should(Succeed())
+				mockBinder.EXPECT().PreBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pod *v1.Pod, _ *v1.Node, _ *schedulingv1alpha2.BindRequest) (
+						*state.BindingState, error) {
+						if pod.Name == "pod-1" {
+							return nil, errors.New("pre-bind failed")
+						}
+						return nil, nil
+					}).Times(2)
+				mockBinder.EXPECT().Rollback(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).Times(2)
+
+				reconcileGangMember(2)
+				for i := range bindRequests {
+					bindRequest := getGangMember(i)
+					Expect(bindRequest.Status.Phase).To(Equal(schedulingv1alpha2.BindRequestPhaseFailed))
+					Expect(bindRequest.Status.Reason).To(ContainSubstring("2 of 3 members passed PreBind"))
+					Expect(bindRequest.Status.Gang.Phase).To(Equal(schedulingv1alpha2.GangBindingPhaseRolledBack))
+				}
+
+				err := fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "pod-0"}, &v1.Pod{})
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+				for _, podName := range []string{"pod-1", "pod-2"} {
+					Expect(fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: podName},
+						&v1.Pod{})).Should(Succeed())
+				}
+			})
+
+			It("fails the whole gang when a member fails to bind after others were bound", func() {
+				createGang(3, 3)
+				mockBinder.EXPECT().PreBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).Times(3)
+				mockBinder.EXPECT().CompleteBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pod *v1.Pod, _ *v1.Node, _ *schedulingv1alpha2.BindRequest,
+						_ *state.BindingState) error {
+						if pod.Name == "pod-1" {
+							return errors.New("bind failed")
+						}
+						return nil
+					}).Times(2)
+				mockBinder.EXPECT().Rollback(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).Times(2)
+
+				reconcileGangMember(0)
+				for i := range bindRequests {
+					bindRequest := getGangMember(i)
+					Expect(bindRequest.Status.Phase).To(Equal(schedulingv1alpha2.BindRequestPhaseFailed))
+					Expect(bindRequest.Status.Reason).To(ContainSubstring("bind failed"))
+					Expect(bindRequest.Status.Gang.Phase).To(Equal(schedulingv1alpha2.GangBindingPhaseFailed))
+				}
+
+				// The member that was bound is evicted, the others are left for the scheduler to re-plan
+				err := fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "pod-0"}, &v1.Pod{})
+				Expect(kerrors.IsNotFound(err)).To(BeTrue())
+				for _, podName := range []string{"pod-1", "pod-2"} {
+					Expect(fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: podName},
+						&v1.Pod{})).Should(Succeed())
+				}
+			})
+
+			It("does not bind again the members whose pods are already bound", func() {
+				createGang(2, 2)
+				boundPod := &v1.Pod{}
+				Expect(fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "pod-0"},
+					boundPod)).Should(Succeed())
+				boundPod.Spec.NodeName = node.Name
+				Expect(fakeClient.Update(context.TODO(), boundPod)).Should(Succeed())
+				mockBinder.EXPECT().PreBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).Times(1)
+				mockBinder.EXPECT().CompleteBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).Times(1)
+
+				reconcileGangMember(1)
+				for i := range bindRequests {
+					Expect(getGangMember(i).Status.Phase).To(Equal(schedulingv1alpha2.BindRequestPhaseSucceeded))
+				}
+			})
+
+			It("waits for the missing members of the gang", func() {
+				createGang(2, 3)
+
+				result := reconcileGangMember(0)
+				Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+				bindRequest := getGangMember(0)
+				Expect(bindRequest.Status.Phase).To(Equal(schedulingv1alpha2.BindRequestPhasePending))
+				Expect(bindRequest.Status.Gang).To(Equal(&schedulingv1alpha2.GangBindingStatus{
+					Phase: schedulingv1alpha2.GangBindingPhaseWaiting, Members: 2,
+				}))
+			})
+
+			It("fails the gang when the missing members are not created in time", func() {
+				createGang(2, 3)
+				reconciler.params.GangBindingTimeoutSeconds = 0
+
+				result := reconcileGangMember(0)
+				Expect(result.RequeueAfter).To(BeZero())
+				for i := range bindRequests {
+					bindRequest := getGangMember(i)
+					Expect(bindRequest.Status.Phase).To(Equal(schedulingv1alpha2.BindRequestPhaseFailed))
+					Expect(bindRequest.Status.Gang.Phase).To(Equal(schedulingv1alpha2.GangBindingPhaseRolledBack))
+				}
+			})
+		})
 	})
 
 	Describe("UpdateStatus", func() {
@@ -336,6 +575,90 @@ var _ = Describe("BindRequest Controller", func() {
 				Expect(bindRequest.Status.Phase).To(Equal(schedulingv1alpha2.BindRequestPhaseFailed))
 			})
 
+			It("Classifies the failure", func() {
+				bindRequest := baseRequest.DeepCopy()
+
+				Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+				reconciler.UpdateStatus(context.TODO(), bindRequest, ctrl.Result{},
+					fmt.Errorf("failed to bind: %w", errNodeNotFound))
+				Expect(bindRequest.Status.FailureClass).To(Equal(schedulingv1alpha2.BindFailureClassNodeGone))
+				Expect(bindRequest.Status.NextRetryTime).To(BeNil())
+			})
+
+			Context("Retry policy", func() {
+				BeforeEach(func() {
+					reconciler.retryPolicies = newBindRetryPolicies(&ReconcilerParams{
+						TransientBindRetries:          2,
+						ReservationTimeoutBindRetries: 1,
+					})
+				})
+
+				It("Retries transient failures without backoff limit", func() {
+					bindRequest := baseRequest.DeepCopy()
+					bindRequest.Status.FailedAttempts = int32(1)
+
+					Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+					res, _ := reconciler.UpdateStatus(context.TODO(), bindRequest, ctrl.Result{}, errors.New("error"))
+
+					Expect(bindRequest.Status.FailureClass).To(Equal(schedulingv1alpha2.BindFailureClassTransient))
+					Expect(bindRequest.Status.FailedAttempts).To(Equal(int32(2)))
+					Expect(bindRequest.Status.NextRetryTime).NotTo(BeNil())
+					Expect(res.RequeueAfter).To(Equal(2 * time.Second))
+				})
+
+				It("Gives up on transient failures over the retries", func() {
+					bindRequest := baseRequest.DeepCopy()
+					bindRequest.Status.FailedAttempts = int32(2)
+
+					Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+					res, _ := reconciler.UpdateStatus(context.TODO(), bindRequest, ctrl.Result{}, errors.New("error"))
+
+					Expect(bindRequest.Status.NextRetryTime).To(BeNil())
+					Expect(res.RequeueAfter).To(BeZero())
+				})
+
+				It("Retries reservation timeouts with their own backoff", func() {
+					bindRequest := baseRequest.DeepCopy()
+
+					Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+					res, _ := reconciler.UpdateStatus(context.TODO(), bindRequest, ctrl.Result{},
+						fmt.Errorf("failed to reserve GPUs: %w", resourcereservation.ErrReservationPodNotAllocated))
+
+					Expect(bindRequest.Status.FailureClass).To(
+						Equal(schedulingv1alpha2.BindFailureClassReservationTimeout))
+					Expect(res.RequeueAfter).To(Equal(10 * time.Second))
+				})
+
+				It("Restarts the attempts when the failure class changes", func() {
+					bindRequest := baseRequest.DeepCopy()
+					bindRequest.Status.FailureClass = schedulingv1alpha2.BindFailureClassTransient
+					bindRequest.Status.FailedAttempts = int32(2)
+
+					Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+					res, _ := reconciler.UpdateStatus(context.TODO(), bindRequest, ctrl.Result{},
+						fmt.Errorf("failed to reserve GPUs: %w", resourcereservation.ErrReservationPodNotAllocated))
+
+					Expect(bindRequest.Status.FailureClass).To(
+						Equal(schedulingv1alpha2.BindFailureClassReservationTimeout))
+					Expect(bindRequest.Status.FailedAttempts).To(Equal(int32(1)))
+					Expect(bindRequest.Status.NextRetryTime).NotTo(BeNil())
+					Expect(res.RequeueAfter).To(Equal(10 * time.Second))
+				})
+
+				It("Doesn't retry permanent plugin failures", func() {
+					bindRequest := baseRequest.DeepCopy()
+					bindRequest.Spec.BackoffLimit = ptr.To(int32(5))
+
+					Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+					res, _ := reconciler.UpdateStatus(context.TODO(), bindRequest, ctrl.Result{},
+						fmt.Errorf("plugin failed in PreBind: %w", plugins.NewPermanentError(errors.New("error"))))
+
+					Expect(bindRequest.Status.FailureClass).To(Equal(schedulingv1alpha2.BindFailureClassPermanent))
+					Expect(bindRequest.Status.NextRetryTime).To(BeNil())
+					Expect(res.RequeueAfter).To(BeZero())
+				})
+			})
+
 			Context("Backoff Limit set", func() {
 
 				It("Increments FailedAttempts if less than BackoffLimit", func() {
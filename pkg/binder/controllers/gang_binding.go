@@ -0,0 +1,368 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// maxConcurrentGangPreBinds bounds the number of members of a gang that run PreBind at the same time
+const maxConcurrentGangPreBinds = 16
+
+type gangMember struct {
+	bindRequest  *schedulingv1alpha2.BindRequest
+	pod          *v1.Pod
+	node         *v1.Node
+	bindingState *state.BindingState
+	alreadyBound bool
+	err          error
+}
+
+func isGangBindRequest(bindRequest *schedulingv1alpha2.BindRequest) bool {
+	return bindRequest.Spec.Gang != nil && bindRequest.Spec.Gang.MinMember > 1
+}
+
+// reconcileGang binds the BindRequests of a gang together: only once every member that the gang needs passed
+// PreBind, and otherwise all the members are rolled back. It returns false when the gang is already bound, and the
+// BindRequest is left to be bound on its own.
+func (r *BindRequestReconciler) reconcileGang(
+	ctx context.Context, bindRequest *schedulingv1alpha2.BindRequest,
+) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	gang := bindRequest.Spec.Gang
+
+	// Failed members are not retried on their own, the scheduler re-plans the whole gang
+	if bindRequest.Status.Phase == schedulingv1alpha2.BindRequestPhaseFailed {
+		return true, ctrl.Result{}, nil
+	}
+
+	gangKey := fmt.Sprintf("%s/%s/%s", bindRequest.Namespace, gang.PodGroupName, gang.ID)
+	r.gangMutex.LockMutexForGroup(gangKey)
+	defer r.gangMutex.ReleaseMutex(gangKey)
+
+	members, err := r.listGangMembers(ctx, bindRequest)
+	if err != nil {
+		return true, ctrl.Result{}, err
+	}
+
+	boundCount := int32(0)
+	var pendingMembers []*gangMember
+	for _, member := range members {
+		switch member.Status.Phase {
+		case schedulingv1alpha2.BindRequestPhaseSucceeded:
+			if member.Name == bindRequest.Name {
+				// The BindRequest was read from the cache before an earlier reconcile of the gang bound it
+				return true, ctrl.Result{}, nil
+			}
+			boundCount++
+		case schedulingv1alpha2.BindRequestPhaseFailed:
+			if member.Name == bindRequest.Name {
+				return true, ctrl.Result{}, nil
+			}
+		default:
+			pendingMembers = append(pendingMembers, &gangMember{bindRequest: member})
+		}
+	}
+	if boundCount >= gang.MinMember {
+		return false, ctrl.Result{}, nil
+	}
+
+	gangStatus := &schedulingv1alpha2.GangBindingStatus{
+		Members:      int32(len(members)),
+		ReadyMembers: boundCount,
+	}
+	if boundCount+int32(len(pendingMembers)) < gang.MinMember {
+		return true, r.waitForGangMembers(ctx, bindRequest, pendingMembers, gangStatus), nil
+	}
+
+	logger.Info("Binding gang", "namespace", bindRequest.Namespace, "podGroup", gang.PodGroupName,
+		"members", len(pendingMembers), "minMember", gang.MinMember)
+	r.preBindGangMembers(ctx, pendingMembers)
+	for _, member := range pendingMembers {
+		if member.err == nil {
+			gangStatus.ReadyMembers++
+		}
+	}
+
+	if gangStatus.ReadyMembers < gang.MinMember {
+		gangStatus.Phase = schedulingv1alpha2.GangBindingPhaseRolledBack
+		reason := fmt.Sprintf("the gang of pod group %s was rolled back, %d of %d members passed PreBind",
+			gang.PodGroupName, gangStatus.ReadyMembers, gang.MinMember)
+		r.failGang(ctx, members, pendingMembers, gangStatus, reason, nil)
+		return true, ctrl.Result{}, nil
+	}
+
+	gangStatus.Phase = schedulingv1alpha2.GangBindingPhaseBound
+	var bindErr error
+	for _, member := range pendingMembers {
+		if member.err != nil || member.alreadyBound {
+			continue
+		}
+		if bindErr = r.binder.CompleteBind(ctx, member.pod, member.node, member.bindRequest,
+			member.bindingState); bindErr != nil {
+			logger.Error(bindErr, "Failed to bind gang member", "pod", member.pod.Name,
+				"namespace", member.pod.Namespace, "node", member.node.Name)
+			member.err = bindErr
+			break
+		}
+		member.alreadyBound = true
+	}
+	if bindErr != nil {
+		r.failBoundGang(ctx, bindRequest, members, pendingMembers, gangStatus, bindErr)
+		return true, ctrl.Result{}, nil
+	}
+
+	for _, member := range pendingMembers {
+		if member.err != nil {
+			r.rollbackGangMember(ctx, member)
+		}
+		r.updateGangMemberStatus(ctx, member, gangStatus, member.err)
+	}
+	return true, ctrl.Result{}, nil
+}
+
+// failBoundGang fails the gang after one of its members failed to bind.
+func (r *BindRequestReconciler) failBoundGang(
+	ctx context.Context, bindRequest *schedulingv1alpha2.BindRequest, members []*schedulingv1alpha2.BindRequest,
+	pendingMembers []*gangMember, gangStatus *schedulingv1alpha2.GangBindingStatus, bindErr error,
+) {
+	gang := bindRequest.Spec.Gang
+	gangStatus.Phase = schedulingv1alpha2.GangBindingPhaseFailed
+	reason := fmt.Sprintf("the gang of pod group %s failed, a member failed to bind: %v", gang.PodGroupName, bindErr)
+	r.failGang(ctx, members, pendingMembers, gangStatus, reason, bindErr)
+}
+
+// failGang fails every member of the gang with the reason: the members that were not bound are rolled back, and the
+// pods that were already bound, by this or an earlier reconcile of the gang, are evicted, so that no part of the gang
+// is left running. The error of a member is added to the reason unless it is the cause that the reason describes.
+func (r *BindRequestReconciler) failGang(
+	ctx context.Context, members []*schedulingv1alpha2.BindRequest, pendingMembers []*gangMember,
+	gangStatus *schedulingv1alpha2.GangBindingStatus, reason string, cause error,
+) {
+	pendingByName := map[string]*gangMember{}
+	for _, member := range pendingMembers {
+		pendingByName[member.bindRequest.Name] = member
+	}
+	for _, memberRequest := range members {
+		member, found := pendingByName[memberRequest.Name]
+		if !found {
+			if memberRequest.Status.Phase != schedulingv1alpha2.BindRequestPhaseSucceeded {
+				continue
+			}
+			// Bound by an earlier reconcile of the gang
+			member = &gangMember{bindRequest: memberRequest, alreadyBound: true}
+			member.pod, member.err = r.getGangMemberPod(ctx, memberRequest)
+		}
+
+		if member.alreadyBound {
+			r.evictGangMember(ctx, member)
+		} else {
+			r.rollbackGangMember(ctx, member)
+		}
+		memberErr := errors.New(reason)
+		if member.err != nil && (cause == nil || !errors.Is(member.err, cause)) {
+			memberErr = fmt.Errorf("%s: %w", reason, member.err)
+		}
+		r.updateGangMemberStatus(ctx, member, gangStatus, memberErr)
+	}
+}
+
+// waitForGangMembers waits for the BindRequests of the gang that were not created yet, and fails the gang when they
+// are not created in time.
+func (r *BindRequestReconciler) waitForGangMembers(
+	ctx context.Context, bindRequest *schedulingv1alpha2.BindRequest, pendingMembers []*gangMember,
+	gangStatus *schedulingv1alpha2.GangBindingStatus,
+) ctrl.Result {
+	gang := bindRequest.Spec.Gang
+	timeout := time.Duration(r.params.GangBindingTimeoutSeconds) * time.Second
+	gangCreation := bindRequest.CreationTimestamp
+	for _, member := range pendingMembers {
+		if member.bindRequest.CreationTimestamp.Before(&gangCreation) {
+			gangCreation = member.bindRequest.CreationTimestamp
+		}
+	}
+
+	if waited := time.Since(gangCreation.Time); waited < timeout {
+		gangStatus.Phase = schedulingv1alpha2.GangBindingPhaseWaiting
+		r.updateGangWaitingStatus(ctx, bindRequest, gangStatus)
+		return ctrl.Result{RequeueAfter: timeout - waited}
+	}
+
+	gangStatus.Phase = schedulingv1alpha2.GangBindingPhaseRolledBack
+	err := fmt.Errorf("timed out waiting for the gang of pod group %s, found %d of %d members",
+		gang.PodGroupName, gangStatus.Members, gang.MinMember)
+	for _, member := range pendingMembers {
+		member.pod, _ = r.getGangMemberPod(ctx, member.bindRequest)
+		r.updateGangMemberStatus(ctx, member, gangStatus, err)
+	}
+	return ctrl.Result{}
+}
+
+func (r *BindRequestReconciler) listGangMembers(
+	ctx context.Context, bindRequest *schedulingv1alpha2.BindRequest,
+) ([]*schedulingv1alpha2.BindRequest, error) {
+	// The members are read from the API server rather than the cache, which may not have seen yet that members were
+	// bound by an earlier reconcile of the gang. Only the BindRequests labeled with the gang's ID are listed.
+	bindRequests := &schedulingv1alpha2.BindRequestList{}
+	if err := r.apiReader.List(ctx, bindRequests, client.InNamespace(bindRequest.Namespace),
+		client.MatchingLabels{constants.GangIDLabelKey: bindRequest.Spec.Gang.ID}); err != nil {
+		return nil, err
+	}
+
+	var members []*schedulingv1alpha2.BindRequest
+	for i := range bindRequests.Items {
+		member := &bindRequests.Items[i]
+		if member.DeletionTimestamp != nil || member.Spec.Gang == nil {
+			continue
+		}
+		if member.Spec.Gang.PodGroupName == bindRequest.Spec.Gang.PodGroupName &&
+			member.Spec.Gang.ID == bindRequest.Spec.Gang.ID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+// preBindGangMembers runs PreBind for the members of the gang concurrently, as each of them may wait for its volumes
+// to be bound.
+func (r *BindRequestReconciler) preBindGangMembers(ctx context.Context, members []*gangMember) {
+	var group errgroup.Group
+	group.SetLimit(maxConcurrentGangPreBinds)
+	for _, member := range members {
+		group.Go(func() error {
+			r.preBindGangMember(ctx, member)
+			return nil
+		})
+	}
+	_ = group.Wait()
+}
+
+func (r *BindRequestReconciler) preBindGangMember(ctx context.Context, member *gangMember) {
+	logger := log.FromContext(ctx)
+
+	member.pod, member.err = r.getGangMemberPod(ctx, member.bindRequest)
+	if member.err != nil {
+		return
+	}
+	if member.pod.Spec.NodeName != "" {
+		member.alreadyBound = true
+		return
+	}
+
+	member.node = &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: member.bindRequest.Spec.SelectedNode,
+		},
+	}
+	if member.err = r.Client.Get(ctx, client.ObjectKeyFromObject(member.node), member.node); member.err != nil {
+		if kerrors.IsNotFound(member.err) {
+			member.err = fmt.Errorf("%w: %w", errNodeNotFound, member.err)
+		}
+		member.node = nil
+		return
+	}
+
+	member.bindingState, member.err = r.binder.PreBind(ctx, member.pod, member.node, member.bindRequest)
+	if member.err != nil {
+		logger.Error(member.err, "Failed to pre-bind gang member", "pod", member.pod.Name,
+			"namespace", member.pod.Namespace, "node", member.node.Name)
+	}
+}
+
+func (r *BindRequestReconciler) getGangMemberPod(
+	ctx context.Context, bindRequest *schedulingv1alpha2.BindRequest,
+) (*v1.Pod, error) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bindRequest.Spec.PodName,
+			Namespace: bindRequest.Namespace,
+		},
+	}
+	if err := r.apiReader.Get(ctx, client.ObjectKeyFromObject(pod), pod); err != nil {
+		return nil, err
+	}
+	return pod, nil
+}
+
+func (r *BindRequestReconciler) rollbackGangMember(ctx context.Context, member *gangMember) {
+	if member.pod == nil || member.node == nil {
+		return
+	}
+	if err := r.binder.Rollback(ctx, member.pod, member.node, member.bindRequest); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to rollback gang member", "pod", member.pod.Name,
+			"namespace", member.pod.Namespace)
+	}
+}
+
+func (r *BindRequestReconciler) evictGangMember(ctx context.Context, member *gangMember) {
+	if member.pod == nil {
+		return
+	}
+	if err := r.Client.Delete(ctx, member.pod); client.IgnoreNotFound(err) != nil {
+		log.FromContext(ctx).Error(err, "Failed to evict bound gang member", "pod", member.pod.Name,
+			"namespace", member.pod.Namespace)
+	}
+}
+
+func (r *BindRequestReconciler) updateGangMemberStatus(
+	ctx context.Context, member *gangMember, gangStatus *schedulingv1alpha2.GangBindingStatus, err error,
+) {
+	logger := log.FromContext(ctx)
+	bindRequest := member.bindRequest
+	originalBindRequest := bindRequest.DeepCopy()
+
+	bindRequest.Status.Gang = gangStatus.DeepCopy()
+	if err != nil {
+		bindRequest.Status.Phase = schedulingv1alpha2.BindRequestPhaseFailed
+		bindRequest.Status.Reason = err.Error()
+		// The members of a gang are not retried on their own
+		bindRequest.Status.FailureClass = classifyBindError(err)
+		bindRequest.Status.NextRetryTime = nil
+		if bindRequest.Spec.BackoffLimit != nil {
+			bindRequest.Status.FailedAttempts = *bindRequest.Spec.BackoffLimit
+		}
+	} else {
+		bindRequest.Status.Phase = schedulingv1alpha2.BindRequestPhaseSucceeded
+	}
+
+	if patchErr := r.Client.Status().Patch(ctx, bindRequest, client.MergeFrom(originalBindRequest)); patchErr != nil {
+		logger.Error(patchErr, "Failed to patch status for BindRequest",
+			"Namespace", bindRequest.Namespace, "Name", bindRequest.Name)
+	}
+	if member.pod != nil {
+		r.updatePodCondition(ctx, bindRequest, member.pod, ctrl.Result{}, err)
+	}
+}
+
+func (r *BindRequestReconciler) updateGangWaitingStatus(
+	ctx context.Context, bindRequest *schedulingv1alpha2.BindRequest, gangStatus *schedulingv1alpha2.GangBindingStatus,
+) {
+	if equality.Semantic.DeepEqual(bindRequest.Status.Gang, gangStatus) {
+		return
+	}
+
+	originalBindRequest := bindRequest.DeepCopy()
+	bindRequest.Status.Phase = schedulingv1alpha2.BindRequestPhasePending
+	bindRequest.Status.Gang = gangStatus.DeepCopy()
+	if err := r.Client.Status().Patch(ctx, bindRequest, client.MergeFrom(originalBindRequest)); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to patch status for BindRequest",
+			"Namespace", bindRequest.Namespace, "Name", bindRequest.Name)
+	}
+}
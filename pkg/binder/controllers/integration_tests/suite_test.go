@@ -122,7 +122,8 @@ var _ = BeforeSuite(func() {
 	podBinder := binding.NewBinder(k8sManager.GetClient(), rrs, binderPlugins)
 
 	err = controllers.NewBindRequestReconciler(
-		k8sManager.GetClient(), k8sManager.GetScheme(), k8sManager.GetEventRecorderFor("binder"), params,
+		k8sManager.GetClient(), k8sManager.GetAPIReader(), k8sManager.GetScheme(),
+		k8sManager.GetEventRecorderFor("binder"), params,
 		podBinder, rrs).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
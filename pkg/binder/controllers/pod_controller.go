@@ -32,9 +32,12 @@ type PodReconciler struct {
 }
 
 type ReconcilerParams struct {
-	MaxConcurrentReconciles     int
-	RateLimiterBaseDelaySeconds int
-	RateLimiterMaxDelaySeconds  int
+	MaxConcurrentReconciles       int
+	RateLimiterBaseDelaySeconds   int
+	RateLimiterMaxDelaySeconds    int
+	GangBindingTimeoutSeconds     int
+	TransientBindRetries          int
+	ReservationTimeoutBindRetries int
 }
 
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;update;patch;delete
@@ -0,0 +1,17 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package plugins
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPermanent marks plugin failures that retrying to bind the pod to the same node will not fix
+var ErrPermanent = errors.New("permanent failure")
+
+// NewPermanentError wraps a plugin failure that binding the pod to the same node again will not fix
+func NewPermanentError(err error) error {
+	return fmt.Errorf("%w: %w", ErrPermanent, err)
+}
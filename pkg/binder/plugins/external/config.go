@@ -0,0 +1,139 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package external
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	ProtocolHTTP = "HTTP"
+	ProtocolGRPC = "GRPC"
+
+	FailurePolicyFail   = "Fail"
+	FailurePolicyIgnore = "Ignore"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// Config is the configuration of an external binder plugin
+type Config struct {
+	// Name is the name of the plugin
+	Name string `json:"name"`
+
+	// Protocol is the protocol the endpoint is called with. [HTTP/GRPC]
+	Protocol string `json:"protocol"`
+
+	// Endpoint is the URL of an HTTP endpoint, or the target of a gRPC endpoint
+	Endpoint string `json:"endpoint"`
+
+	// TimeoutSeconds is the timeout of every call to the endpoint
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+
+	// FailurePolicy is what to do when a call to the endpoint fails. [Fail/Ignore]
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+
+	// TLS configures the certificates of TLS connections to the endpoint. gRPC endpoints are called in plaintext
+	// unless it is set. HTTP endpoints use TLS when their URL is https, verified by the system certificate
+	// authorities unless it is set.
+	TLS *TLSConfig `json:"tls,omitempty"`
+}
+
+// TLSConfig holds the paths of the PEM files of a TLS connection to an external plugin endpoint
+type TLSConfig struct {
+	// CAFile holds the certificate authorities that verify the endpoint's certificate, the system certificate
+	// authorities if not set
+	CAFile string `json:"caFile,omitempty"`
+
+	// CertFile holds the client certificate presented to endpoints that require mutual TLS
+	CertFile string `json:"certFile,omitempty"`
+
+	// KeyFile holds the private key of the client certificate
+	KeyFile string `json:"keyFile,omitempty"`
+}
+
+// ParseConfigs parses the configurations of the external binder plugins from JSON
+func ParseConfigs(configsJSON string) ([]Config, error) {
+	if configsJSON == "" {
+		return nil, nil
+	}
+
+	var configs []Config
+	if err := json.Unmarshal([]byte(configsJSON), &configs); err != nil {
+		return nil, fmt.Errorf("failed to parse external plugins configuration: %w", err)
+	}
+
+	names := map[string]bool{}
+	for i := range configs {
+		if err := configs[i].validate(); err != nil {
+			return nil, err
+		}
+		if names[configs[i].Name] {
+			return nil, fmt.Errorf("external plugin %s is configured more than once", configs[i].Name)
+		}
+		names[configs[i].Name] = true
+	}
+	return configs, nil
+}
+
+func (c *Config) validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("external plugin has no name")
+	}
+	if c.Endpoint == "" {
+		return fmt.Errorf("external plugin %s has no endpoint", c.Name)
+	}
+	if c.Protocol != ProtocolHTTP && c.Protocol != ProtocolGRPC {
+		return fmt.Errorf("external plugin %s has unknown protocol %q", c.Name, c.Protocol)
+	}
+	if c.FailurePolicy == "" {
+		c.FailurePolicy = FailurePolicyFail
+	}
+	if c.FailurePolicy != FailurePolicyFail && c.FailurePolicy != FailurePolicyIgnore {
+		return fmt.Errorf("external plugin %s has unknown failure policy %q", c.Name, c.FailurePolicy)
+	}
+	if c.TimeoutSeconds != nil && *c.TimeoutSeconds <= 0 {
+		return fmt.Errorf("external plugin %s has a non-positive timeout", c.Name)
+	}
+	if c.TLS != nil && (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return fmt.Errorf("external plugin %s must set both the certificate and the key files of its client "+
+			"certificate", c.Name)
+	}
+	return nil
+}
+
+// build loads the files of the TLS configuration
+func (c *TLSConfig) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.CAFile != "" {
+		caPEM, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read certificate authorities: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate authorities were found in %s", c.CAFile)
+		}
+	}
+	if c.CertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+func (c *Config) timeout() time.Duration {
+	if c.TimeoutSeconds == nil {
+		return defaultTimeout
+	}
+	return time.Duration(*c.TimeoutSeconds) * time.Second
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package external
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
+)
+
+const (
+	preBindMethod  = "PreBind"
+	postBindMethod = "PostBind"
+	rollbackMethod = "Rollback"
+)
+
+// Request is the body of the calls to the endpoints of external plugins
+type Request struct {
+	Pod         *v1.Pod               `json:"pod"`
+	NodeName    string                `json:"nodeName"`
+	BindRequest *v1alpha2.BindRequest `json:"bindRequest"`
+}
+
+// Response is the body of the responses of the endpoints of external plugins
+type Response struct {
+	// Error fails the call when set
+	Error string `json:"error,omitempty"`
+
+	// Permanent marks the error as one that retrying to bind the pod to the same node will not fix
+	Permanent bool `json:"permanent,omitempty"`
+}
+
+type caller interface {
+	call(ctx context.Context, method string, request *Request) (*Response, error)
+	close() error
+}
+
+// Plugin is a binder plugin that forwards PreBind, PostBind and Rollback to an external endpoint
+type Plugin struct {
+	config Config
+	caller caller
+}
+
+func New(config Config) (*Plugin, error) {
+	var tlsConfig *tls.Config
+	var err error
+	if config.TLS != nil {
+		if tlsConfig, err = config.TLS.build(); err != nil {
+			return nil, fmt.Errorf("failed to create external plugin %s: %w", config.Name, err)
+		}
+	}
+
+	var c caller
+	switch config.Protocol {
+	case ProtocolHTTP:
+		c = newHTTPCaller(config.Endpoint, tlsConfig)
+	case ProtocolGRPC:
+		c, err = newGRPCCaller(config.Endpoint, tlsConfig)
+	default:
+		err = fmt.Errorf("unknown protocol %q", config.Protocol)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create external plugin %s: %w", config.Name, err)
+	}
+
+	return &Plugin{config: config, caller: c}, nil
+}
+
+func (p *Plugin) Name() string {
+	return p.config.Name
+}
+
+func (p *Plugin) PreBind(ctx context.Context, pod *v1.Pod, node *v1.Node, bindRequest *v1alpha2.BindRequest,
+	_ *state.BindingState) error {
+	return p.callWithFailurePolicy(ctx, preBindMethod, pod, node, bindRequest)
+}
+
+func (p *Plugin) PostBind(ctx context.Context, pod *v1.Pod, node *v1.Node, bindRequest *v1alpha2.BindRequest,
+	_ *state.BindingState) {
+	if err := p.callEndpoint(ctx, postBindMethod, pod, node, bindRequest); err != nil {
+		log.FromContext(ctx).Error(err, "External plugin failed in PostBind", "plugin", p.config.Name,
+			"namespace", pod.Namespace, "name", pod.Name)
+	}
+}
+
+func (p *Plugin) Rollback(ctx context.Context, pod *v1.Pod, node *v1.Node, bindRequest *v1alpha2.BindRequest,
+	_ *state.BindingState) error {
+	return p.callWithFailurePolicy(ctx, rollbackMethod, pod, node, bindRequest)
+}
+
+// Close closes the connection to the endpoint of the plugin
+func (p *Plugin) Close() error {
+	return p.caller.close()
+}
+
+func (p *Plugin) callWithFailurePolicy(ctx context.Context, method string, pod *v1.Pod, node *v1.Node,
+	bindRequest *v1alpha2.BindRequest) error {
+	err := p.callEndpoint(ctx, method, pod, node, bindRequest)
+	if err != nil && p.config.FailurePolicy == FailurePolicyIgnore {
+		log.FromContext(ctx).Info("Ignoring failure of external plugin", "plugin", p.config.Name,
+			"method", method, "namespace", pod.Namespace, "name", pod.Name, "error", err.Error())
+		return nil
+	}
+	return err
+}
+
+func (p *Plugin) callEndpoint(ctx context.Context, method string, pod *v1.Pod, node *v1.Node,
+	bindRequest *v1alpha2.BindRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, p.config.timeout())
+	defer cancel()
+
+	request := &Request{Pod: pod, BindRequest: bindRequest}
+	if node != nil {
+		request.NodeName = node.Name
+	}
+	response, err := p.caller.call(ctx, method, request)
+	if err != nil {
+		return fmt.Errorf("failed to call %s of external plugin %s: %w", method, p.config.Name, err)
+	}
+	if response.Error == "" {
+		return nil
+	}
+
+	err = errors.New(response.Error)
+	if response.Permanent {
+		err = plugins.NewPermanentError(err)
+	}
+	return fmt.Errorf("external plugin %s failed in %s: %w", p.config.Name, method, err)
+}
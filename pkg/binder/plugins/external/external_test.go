@@ -0,0 +1,259 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
+)
+
+func TestParseConfigs(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		configsJSON string
+		expected    []Config
+		expectedErr bool
+	}{
+		{
+			name:        "empty",
+			configsJSON: "",
+		},
+		{
+			name:        "defaults the failure policy",
+			configsJSON: `[{"name":"network","protocol":"HTTP","endpoint":"http://network:8080"}]`,
+			expected: []Config{
+				{Name: "network", Protocol: ProtocolHTTP, Endpoint: "http://network:8080",
+					FailurePolicy: FailurePolicyFail},
+			},
+		},
+		{
+			name: "multiple plugins",
+			configsJSON: `[{"name":"network","protocol":"GRPC","endpoint":"network:9090","timeoutSeconds":3},
+				{"name":"license","protocol":"HTTP","endpoint":"http://license","failurePolicy":"Ignore"}]`,
+			expected: []Config{
+				{Name: "network", Protocol: ProtocolGRPC, Endpoint: "network:9090", TimeoutSeconds: ptr.To(3),
+					FailurePolicy: FailurePolicyFail},
+				{Name: "license", Protocol: ProtocolHTTP, Endpoint: "http://license",
+					FailurePolicy: FailurePolicyIgnore},
+			},
+		},
+		{
+			name:        "unknown protocol",
+			configsJSON: `[{"name":"network","protocol":"UDP","endpoint":"network:9090"}]`,
+			expectedErr: true,
+		},
+		{
+			name:        "unknown failure policy",
+			configsJSON: `[{"name":"network","protocol":"HTTP","endpoint":"http://network","failurePolicy":"Retry"}]`,
+			expectedErr: true,
+		},
+		{
+			name: "duplicate name",
+			configsJSON: `[{"name":"network","protocol":"HTTP","endpoint":"http://a"},
+				{"name":"network","protocol":"HTTP","endpoint":"http://b"}]`,
+			expectedErr: true,
+		},
+		{
+			name: "client certificate without a key",
+			configsJSON: `[{"name":"network","protocol":"GRPC","endpoint":"network:9090",
+				"tls":{"caFile":"/tls/ca.crt","certFile":"/tls/tls.crt"}}]`,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid json",
+			configsJSON: `{"name":"network"}`,
+			expectedErr: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			configs, err := ParseConfigs(test.configsJSON)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, configs)
+		})
+	}
+}
+
+func TestHTTPPlugin(t *testing.T) {
+	var receivedPaths []string
+	var receivedRequest Request
+	response := Response{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPaths = append(receivedPaths, r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	plugin, err := New(Config{Name: "network", Protocol: ProtocolHTTP, Endpoint: server.URL + "/",
+		FailurePolicy: FailurePolicyFail})
+	require.NoError(t, err)
+	defer plugin.Close()
+
+	pod, node, bindRequest := testBindingObjects()
+	assert.NoError(t, plugin.PreBind(context.Background(), pod, node, bindRequest, nil))
+	assert.Equal(t, pod.Name, receivedRequest.Pod.Name)
+	assert.Equal(t, node.Name, receivedRequest.NodeName)
+	assert.Equal(t, bindRequest.Spec.SelectedNode, receivedRequest.BindRequest.Spec.SelectedNode)
+
+	response = Response{Error: "no network attachment", Permanent: true}
+	err = plugin.PreBind(context.Background(), pod, node, bindRequest, nil)
+	assert.ErrorContains(t, err, "no network attachment")
+	assert.True(t, errors.Is(err, plugins.ErrPermanent))
+
+	plugin.PostBind(context.Background(), pod, node, bindRequest, nil)
+	assert.Error(t, plugin.Rollback(context.Background(), pod, node, bindRequest, nil))
+	assert.Equal(t, []string{"/prebind", "/prebind", "/postbind", "/rollback"}, receivedPaths)
+}
+
+func TestHTTPPluginFailurePolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	pod, node, bindRequest := testBindingObjects()
+	for _, test := range []struct {
+		failurePolicy string
+		expectedErr   bool
+	}{
+		{failurePolicy: FailurePolicyFail, expectedErr: true},
+		{failurePolicy: FailurePolicyIgnore, expectedErr: false},
+	} {
+		t.Run(test.failurePolicy, func(t *testing.T) {
+			plugin, err := New(Config{Name: "license", Protocol: ProtocolHTTP, Endpoint: server.URL,
+				FailurePolicy: test.failurePolicy})
+			require.NoError(t, err)
+			defer plugin.Close()
+
+			err = plugin.PreBind(context.Background(), pod, node, bindRequest, nil)
+			assert.Equal(t, test.expectedErr, err != nil)
+			assert.False(t, errors.Is(err, plugins.ErrPermanent))
+		})
+	}
+}
+
+func TestHTTPPluginTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	plugin, err := New(Config{Name: "storage", Protocol: ProtocolHTTP, Endpoint: server.URL,
+		TimeoutSeconds: ptr.To(1), FailurePolicy: FailurePolicyFail})
+	require.NoError(t, err)
+	defer plugin.Close()
+
+	pod, node, bindRequest := testBindingObjects()
+	start := time.Now()
+	err = plugin.PreBind(context.Background(), pod, node, bindRequest, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestHTTPPluginTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(writer).Encode(Response{})
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+
+	plugin, err := New(Config{Name: "network", Protocol: ProtocolHTTP, Endpoint: server.URL,
+		FailurePolicy: FailurePolicyFail, TLS: &TLSConfig{CAFile: caFile}})
+	require.NoError(t, err)
+	pod, node, bindRequest := testBindingObjects()
+	assert.NoError(t, plugin.PreBind(context.Background(), pod, node, bindRequest, nil))
+
+	_, err = New(Config{Name: "network", Protocol: ProtocolHTTP, Endpoint: server.URL,
+		FailurePolicy: FailurePolicyFail, TLS: &TLSConfig{CAFile: filepath.Join(t.TempDir(), "missing.crt")}})
+	assert.Error(t, err)
+}
+
+func TestGRPCPlugin(t *testing.T) {
+	var receivedMethods []string
+	var receivedRequest Request
+	handler := func(method string) grpc.MethodDesc {
+		return grpc.MethodDesc{
+			MethodName: method,
+			Handler: func(_ any, _ context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (
+				any, error) {
+				receivedMethods = append(receivedMethods, method)
+				if err := dec(&receivedRequest); err != nil {
+					return nil, err
+				}
+				if method == rollbackMethod {
+					return &Response{Error: "license was not checked out"}, nil
+				}
+				return &Response{}, nil
+			},
+		}
+	}
+	server := grpc.NewServer(grpc.ForceServerCodec(JSONCodec{}))
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: GRPCServiceName,
+		HandlerType: (*any)(nil),
+		Methods:     []grpc.MethodDesc{handler(preBindMethod), handler(postBindMethod), handler(rollbackMethod)},
+	}, struct{}{})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	plugin, err := New(Config{Name: "license", Protocol: ProtocolGRPC, Endpoint: listener.Addr().String(),
+		FailurePolicy: FailurePolicyFail})
+	require.NoError(t, err)
+	defer plugin.Close()
+
+	pod, node, bindRequest := testBindingObjects()
+	assert.NoError(t, plugin.PreBind(context.Background(), pod, node, bindRequest, nil))
+	assert.Equal(t, pod.Name, receivedRequest.Pod.Name)
+	assert.Equal(t, node.Name, receivedRequest.NodeName)
+
+	plugin.PostBind(context.Background(), pod, node, bindRequest, nil)
+	assert.ErrorContains(t, plugin.Rollback(context.Background(), pod, node, bindRequest, nil),
+		"license was not checked out")
+	assert.Equal(t, []string{preBindMethod, postBindMethod, rollbackMethod}, receivedMethods)
+}
+
+func testBindingObjects() (*v1.Pod, *v1.Node, *v1alpha2.BindRequest) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+	bindRequest := &v1alpha2.BindRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+		Spec:       v1alpha2.BindRequestSpec{PodName: pod.Name, SelectedNode: node.Name},
+	}
+	return pod, node, bindRequest
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package external
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// GRPCServiceName is the gRPC service that external plugin endpoints implement. Its PreBind, PostBind and Rollback
+// methods take a Request and return a Response, both encoded as JSON with the "json" content subtype.
+const GRPCServiceName = "kai.binder.v1.ExternalPlugin"
+
+// JSONCodec is the gRPC codec of the external plugin service. Go servers can use it with grpc.ForceServerCodec.
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (JSONCodec) Name() string {
+	return "json"
+}
+
+type grpcCaller struct {
+	conn *grpc.ClientConn
+}
+
+// newGRPCCaller connects to the target over TLS if tlsConfig is set, and in plaintext otherwise.
+func newGRPCCaller(target string, tlsConfig *tls.Config) (*grpcCaller, error) {
+	transportCredentials := insecure.NewCredentials()
+	if tlsConfig != nil {
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(target,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(JSONCodec{})),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcCaller{conn: conn}, nil
+}
+
+func (c *grpcCaller) call(ctx context.Context, method string, request *Request) (*Response, error) {
+	response := &Response{}
+	fullMethod := fmt.Sprintf("/%s/%s", GRPCServiceName, method)
+	if err := c.conn.Invoke(ctx, fullMethod, request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *grpcCaller) close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const maxHTTPResponseBytes = 1 << 20
+
+// httpCaller calls the methods of an external plugin with a POST of the request to <endpoint>/<method>, lower cased
+type httpCaller struct {
+	endpoint string
+	client   *http.Client
+}
+
+func newHTTPCaller(endpoint string, tlsConfig *tls.Config) *httpCaller {
+	client := &http.Client{}
+	if tlsConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		client.Transport = transport
+	}
+	return &httpCaller{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   client,
+	}
+}
+
+func (c *httpCaller) call(ctx context.Context, method string, request *Request) (*Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/%s", c.endpoint, strings.ToLower(method))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := c.client.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(httpResponse.Body, maxHTTPResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if httpResponse.StatusCode < http.StatusOK || httpResponse.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("endpoint returned status %d: %s", httpResponse.StatusCode, string(responseBody))
+	}
+
+	response := &Response{}
+	if len(responseBody) == 0 {
+		return response, nil
+	}
+	if err = json.Unmarshal(responseBody, response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return response, nil
+}
+
+func (c *httpCaller) close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
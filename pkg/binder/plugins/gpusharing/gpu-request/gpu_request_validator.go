@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 )
 
 func ValidateGpuRequests(pod *v1.Pod) error {
@@ -19,6 +20,7 @@ func ValidateGpuRequests(pod *v1.Pod) error {
 	gpuFractionsCountFromAnnotation, hasGpuFractionsCount := pod.Annotations[constants.GpuFractionsNumDevices]
 
 	mpsFromAnnotation, hasMpsAnnotation := pod.Annotations[constants.MpsAnnotation]
+	_, hasGpuIsolationAnnotation := pod.Annotations[constants.GpuIsolationAnnotation]
 
 	wholeGPULimit := getFirstGPULimit(pod)
 	hasWholeGPULimit := wholeGPULimit != nil
@@ -29,6 +31,13 @@ func ValidateGpuRequests(pod *v1.Pod) error {
 		return fmt.Errorf("MPS is only supported with GPU fraction request")
 	}
 
+	if !isFractional && hasGpuIsolationAnnotation {
+		return fmt.Errorf("GPU isolation is only supported with GPU fraction request")
+	}
+	if _, err := resources.GetGpuIsolationMode(pod); err != nil {
+		return err
+	}
+
 	if hasGpuFractionAnnotation && hasWholeGPULimit {
 		return fmt.Errorf("cannot have both GPU fraction request and whole GPU resource request/limit")
 	}
@@ -100,6 +100,63 @@ func TestValidateGpuRequests(t *testing.T) {
 			},
 			error: fmt.Errorf("MPS is only supported with GPU fraction request"),
 		},
+		{
+			name: "allow GPU isolation with fractional GPU annotation request",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuIsolationAnnotation: "time-slicing",
+						constants.GpuFraction:            "0.5",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{},
+						},
+					},
+				},
+			},
+			error: nil,
+		},
+		{
+			name: "block GPU isolation without GPU fraction request",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuIsolationAnnotation: "mps",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{},
+						},
+					},
+				},
+			},
+			error: fmt.Errorf("GPU isolation is only supported with GPU fraction request"),
+		},
+		{
+			name: "block unknown GPU isolation mode",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuIsolationAnnotation: "mig",
+						constants.GpuMemory:              "1024",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{},
+						},
+					},
+				},
+			},
+			error: fmt.Errorf("invalid gpu-isolation annotation value \"mig\", " +
+				"supported values are none, mps and time-slicing"),
+		},
 		{
 			name: "forbid GPU annotation and GPU request mismatch (fractional)",
 			pod: &v1.Pod{
@@ -18,7 +18,9 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common/gpusharingconfigmap"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 )
 
 const (
@@ -28,21 +30,28 @@ const (
 type GPUSharing struct {
 	kubeClient             client.Client
 	gpuDevicePluginUsesCdi bool
+	isolationBackends      map[resources.GpuIsolationMode]IsolationBackend
 }
 
 func New(kubeClient client.Client, gpuDevicePluginUsesCdi bool) *GPUSharing {
 	return &GPUSharing{
 		kubeClient:             kubeClient,
 		gpuDevicePluginUsesCdi: gpuDevicePluginUsesCdi,
+		isolationBackends:      defaultIsolationBackends(),
 	}
 }
 
+// SetIsolationBackend replaces the backend that configures the pods requesting the given GPU isolation mode.
+func (p *GPUSharing) SetIsolationBackend(mode resources.GpuIsolationMode, backend IsolationBackend) {
+	p.isolationBackends[mode] = backend
+}
+
 func (p *GPUSharing) Name() string {
 	return "gpusharing"
 }
 
 func (p *GPUSharing) PreBind(
-	ctx context.Context, pod *v1.Pod, _ *v1.Node, bindRequest *v1alpha2.BindRequest, state *state.BindingState,
+	ctx context.Context, pod *v1.Pod, node *v1.Node, bindRequest *v1alpha2.BindRequest, state *state.BindingState,
 ) error {
 	if !common.IsSharedGPUAllocation(bindRequest) {
 		return nil
@@ -76,7 +85,47 @@ func (p *GPUSharing) PreBind(
 		return err
 	}
 
-	return common.SetGPUPortion(ctx, p.kubeClient, pod, containerRef, bindRequest.Spec.ReceivedGPU.Portion)
+	err = common.SetGPUPortion(ctx, p.kubeClient, pod, containerRef, bindRequest.Spec.ReceivedGPU.Portion)
+	if err != nil {
+		return err
+	}
+
+	return p.configureIsolation(ctx, pod, node, containerRef, bindRequest.Spec.ReceivedGPU)
+}
+
+func (p *GPUSharing) configureIsolation(ctx context.Context, pod *v1.Pod, node *v1.Node,
+	containerRef *gpusharingconfigmap.PodContainerRef, receivedGPU *v1alpha2.ReceivedGPU) error {
+	isolationMode, err := resources.GetGpuIsolationMode(pod)
+	if err != nil {
+		return plugins.NewPermanentError(err)
+	}
+	backend, found := p.isolationBackends[isolationMode]
+	if !found {
+		return plugins.NewPermanentError(
+			fmt.Errorf("no isolation backend is configured for GPU isolation mode %s", isolationMode))
+	}
+	isolationEnvVars, err := backend.EnvVars(pod, node, receivedGPU)
+	if err != nil {
+		return fmt.Errorf("failed to configure %s GPU isolation: %w", isolationMode, err)
+	}
+
+	directEnvVarsMapName, err := gpusharingconfigmap.ExtractDirectEnvVarsConfigMapName(pod, containerRef)
+	if err != nil {
+		return err
+	}
+	updateFunc := func(data map[string]string) error {
+		data[common.GPUIsolationMode] = string(isolationMode)
+		for name, value := range isolationEnvVars {
+			data[name] = value
+		}
+		return nil
+	}
+	err = common.UpdateConfigMapEnvironmentVariable(ctx, p.kubeClient, pod, directEnvVarsMapName, updateFunc)
+	if err != nil {
+		return fmt.Errorf("failed to update GPU isolation in gpu sharing configmap for pod <%s/%s>: %v",
+			pod.Namespace, pod.Name, err)
+	}
+	return nil
 }
 
 func (p *GPUSharing) createCapabilitiesConfigMapIfMissing(ctx context.Context, pod *v1.Pod,
@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common/gpusharingconfigmap"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 )
 
@@ -514,3 +515,85 @@ func TestGPUSharingRollbackDeleteConfigMap(t *testing.T) {
 		})
 	}
 }
+
+func TestGPUSharingPreBindIsolation(t *testing.T) {
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		expectedEnvVars map[string]string
+	}{
+		{
+			name:        "no isolation",
+			annotations: map[string]string{},
+			expectedEnvVars: map[string]string{
+				common.GPUIsolationMode:        "none",
+				constants.NvidiaVisibleDevices: "0",
+			},
+		},
+		{
+			name:        "mps isolation",
+			annotations: map[string]string{constants.GpuIsolationAnnotation: "mps"},
+			expectedEnvVars: map[string]string{
+				common.GPUIsolationMode:         "mps",
+				constants.NvidiaVisibleDevices:  "0",
+				mpsActiveThreadPercentageEnvVar: "50",
+				mpsPinnedDeviceMemLimitEnvVar:   "0=8192M",
+			},
+		},
+		{
+			name:        "time-slicing isolation",
+			annotations: map[string]string{constants.GpuIsolationAnnotation: "time-slicing"},
+			expectedEnvVars: map[string]string{
+				common.GPUIsolationMode:        "time-slicing",
+				constants.NvidiaVisibleDevices: "0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.annotations[constants.GpuSharingConfigMapAnnotation] = "test-pod-abc1234-shared-gpu"
+			tt.annotations[constants.GpuFraction] = "0.5"
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-pod",
+					Namespace:   "test-ns",
+					UID:         "test-pod-uid",
+					Annotations: tt.annotations,
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "container-0"}},
+				},
+			}
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "node-0",
+					Labels: map[string]string{constants.NvidiaGpuMemory: "16384"},
+				},
+			}
+			bindRequest := &v1alpha2.BindRequest{
+				Spec: v1alpha2.BindRequestSpec{
+					ReceivedResourceType: common.ReceivedTypeFraction,
+					ReceivedGPU:          &v1alpha2.ReceivedGPU{Count: 1, Portion: "0.5"},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			_ = v1.AddToScheme(scheme)
+			kubeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			plugin := New(kubeClient, false)
+			err := plugin.PreBind(context.Background(), pod, node, bindRequest,
+				&state.BindingState{ReservedGPUIds: []string{"0"}})
+			assert.NoError(t, err)
+
+			directEnvVarsConfigMap := &v1.ConfigMap{}
+			err = kubeClient.Get(context.Background(), types.NamespacedName{
+				Namespace: "test-ns",
+				Name:      "test-pod-abc1234-shared-gpu-0-evar",
+			}, directEnvVarsConfigMap)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedEnvVars, directEnvVarsConfigMap.Data)
+		})
+	}
+}
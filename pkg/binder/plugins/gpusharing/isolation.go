@@ -0,0 +1,88 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpusharing
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+)
+
+const (
+	mpsActiveThreadPercentageEnvVar = "CUDA_MPS_ACTIVE_THREAD_PERCENTAGE"
+	mpsPinnedDeviceMemLimitEnvVar   = "CUDA_MPS_PINNED_DEVICE_MEM_LIMIT"
+)
+
+// IsolationBackend configures the isolation of a fractional GPU pod from the other pods that share its devices.
+type IsolationBackend interface {
+	// EnvVars returns the environment variables that enforce the isolation of the pod, to be set in the
+	// fraction container of the pod through the shared GPU configmap.
+	EnvVars(pod *v1.Pod, node *v1.Node, receivedGPU *v1alpha2.ReceivedGPU) (map[string]string, error)
+}
+
+func defaultIsolationBackends() map[resources.GpuIsolationMode]IsolationBackend {
+	return map[resources.GpuIsolationMode]IsolationBackend{
+		resources.GpuIsolationNone:        &noIsolation{},
+		resources.GpuIsolationMps:         &mpsIsolation{},
+		resources.GpuIsolationTimeSlicing: &noIsolation{},
+	}
+}
+
+// noIsolation doesn't enforce anything on the pod. It is used for pods that rely on their workloads to respect the
+// received portion, and for time-slicing, which is enforced by the device plugin on the device level.
+type noIsolation struct{}
+
+func (n *noIsolation) EnvVars(*v1.Pod, *v1.Node, *v1alpha2.ReceivedGPU) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// mpsIsolation limits the pod, as an MPS client, to its portion of the compute and memory of every received device.
+type mpsIsolation struct{}
+
+func (m *mpsIsolation) EnvVars(
+	pod *v1.Pod, node *v1.Node, receivedGPU *v1alpha2.ReceivedGPU,
+) (map[string]string, error) {
+	portion, err := strconv.ParseFloat(receivedGPU.Portion, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse received GPU portion %s: %w", receivedGPU.Portion, err)
+	}
+
+	envVars := map[string]string{
+		mpsActiveThreadPercentageEnvVar: strconv.Itoa(int(math.Ceil(portion * 100))),
+	}
+
+	memoryLimit, found := mpsDeviceMemoryLimit(pod, node, portion)
+	if !found {
+		return envVars, nil
+	}
+	var deviceLimits []string
+	for deviceIndex := range max(receivedGPU.Count, 1) {
+		deviceLimits = append(deviceLimits, fmt.Sprintf("%d=%dM", deviceIndex, memoryLimit))
+	}
+	envVars[mpsPinnedDeviceMemLimitEnvVar] = strings.Join(deviceLimits, ",")
+	return envVars, nil
+}
+
+// mpsDeviceMemoryLimit returns the memory, in MiB, that the pod may use on each of its devices - either the requested
+// gpu-memory or the received portion of the memory of the node GPUs.
+func mpsDeviceMemoryLimit(pod *v1.Pod, node *v1.Node, portion float64) (int64, bool) {
+	if gpuMemory, err := resources.GetGPUMemory(pod); err == nil && gpuMemory > 0 {
+		return gpuMemory, true
+	}
+	if node == nil {
+		return 0, false
+	}
+	nodeGpuMemory, err := strconv.ParseInt(node.Labels[constants.NvidiaGpuMemory], 10, 64)
+	if err != nil || nodeGpuMemory <= 0 {
+		return 0, false
+	}
+	return int64(math.Floor(float64(nodeGpuMemory) * portion)), true
+}
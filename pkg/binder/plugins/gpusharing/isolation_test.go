@@ -0,0 +1,73 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpusharing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestMpsIsolationEnvVars(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		nodeLabels  map[string]string
+		receivedGPU *v1alpha2.ReceivedGPU
+		want        map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "memory limit from node GPU memory",
+			nodeLabels:  map[string]string{constants.NvidiaGpuMemory: "40960"},
+			receivedGPU: &v1alpha2.ReceivedGPU{Count: 1, Portion: "0.25"},
+			want: map[string]string{
+				mpsActiveThreadPercentageEnvVar: "25",
+				mpsPinnedDeviceMemLimitEnvVar:   "0=10240M",
+			},
+		},
+		{
+			name:        "memory limit from gpu-memory request on every device",
+			annotations: map[string]string{constants.GpuMemory: "2000"},
+			nodeLabels:  map[string]string{constants.NvidiaGpuMemory: "40960"},
+			receivedGPU: &v1alpha2.ReceivedGPU{Count: 2, Portion: "0.05"},
+			want: map[string]string{
+				mpsActiveThreadPercentageEnvVar: "5",
+				mpsPinnedDeviceMemLimitEnvVar:   "0=2000M,1=2000M",
+			},
+		},
+		{
+			name:        "no memory limit when the node GPU memory is unknown",
+			receivedGPU: &v1alpha2.ReceivedGPU{Count: 1, Portion: "0.333"},
+			want: map[string]string{
+				mpsActiveThreadPercentageEnvVar: "34",
+			},
+		},
+		{
+			name:        "invalid portion",
+			receivedGPU: &v1alpha2.ReceivedGPU{Count: 1, Portion: "half"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Labels: tt.nodeLabels}}
+
+			envVars, err := (&mpsIsolation{}).EnvVars(pod, node, tt.receivedGPU)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, envVars)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/k8s_utils"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/k8s-plugins/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/k8s-plugins/dynamicresources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/k8s-plugins/volumebinding"
@@ -176,8 +177,9 @@ func (p *K8sPlugins) bindPluginWrapper(
 
 	err = plugin.Filter(ctx, pod, node, state)
 	if err != nil {
-		return fmt.Errorf("K8sPlugin %s failed Filter for pod: %s/%s and node %s. error: %s",
-			plugin.Name(), pod.Namespace, pod.Name, node.Name, err), state
+		// The node does not fit the pod, binding it to the same node again will not help
+		return plugins.NewPermanentError(fmt.Errorf("K8sPlugin %s failed Filter for pod: %s/%s and node %s. error: %s",
+			plugin.Name(), pod.Namespace, pod.Name, node.Name, err)), state
 	}
 
 	err = plugin.Allocate(ctx, pod, node.Name, state)
@@ -38,7 +38,7 @@ func (bp *BinderPlugins) PreBind(ctx context.Context, pod *v1.Pod, host *v1.Node
 			logger := log.FromContext(context.Background())
 			logger.Error(err, "PreBind plugin failed for pod",
 				"plugin", p.Name(), "namespace", pod.Namespace, "name", pod.Name)
-			return fmt.Errorf("plugin %s failed in PreBind: %v", p.Name(), err)
+			return fmt.Errorf("plugin %s failed in PreBind: %w", p.Name(), err)
 		}
 	}
 	return nil
@@ -32,17 +32,23 @@ const (
 	TopOwnerMetadataKey = "kai.scheduler/top-owner-metadata"
 
 	// Annotations
-	PodGroupAnnotationForPod      = "pod-group-name"
-	GpuFraction                   = "gpu-fraction"
-	GpuFractionContainerName      = "gpu-fraction-container-name"
-	GpuMemory                     = "gpu-memory"
-	ReceivedResourceType          = "received-resource-type"
-	GpuFractionsNumDevices        = "gpu-fraction-num-devices"
-	MpsAnnotation                 = "mps"
-	StalePodgroupTimeStamp        = "kai.scheduler/stale-podgroup-timestamp"
-	LastStartTimeStamp            = "kai.scheduler/last-start-timestamp"
-	GpuSharingConfigMapAnnotation = "runai/shared-gpu-configmap"
-	NvidiaVisibleDevices          = "NVIDIA_VISIBLE_DEVICES"
+	PodGroupAnnotationForPod        = "pod-group-name"
+	GpuFraction                     = "gpu-fraction"
+	GpuFractionContainerName        = "gpu-fraction-container-name"
+	GpuMemory                       = "gpu-memory"
+	ReceivedResourceType            = "received-resource-type"
+	GpuFractionsNumDevices          = "gpu-fraction-num-devices"
+	MpsAnnotation                   = "mps"
+	GpuIsolationAnnotation          = "gpu-isolation"
+	StalePodgroupTimeStamp          = "kai.scheduler/stale-podgroup-timestamp"
+	LastStartTimeStamp              = "kai.scheduler/last-start-timestamp"
+	GracefulEvictionWindow          = "kai.scheduler/graceful-eviction-window"
+	PreemptionRequestedTimeStamp    = "kai.scheduler/preemption-requested-timestamp"
+	PreemptionRequestedPods         = "kai.scheduler/preemption-requested-pods"
+	PreemptionAcknowledgedTimeStamp = "kai.scheduler/preemption-acknowledged-timestamp"
+	ExpectedRuntime                 = "kai.scheduler/expected-runtime"
+	GpuSharingConfigMapAnnotation   = "runai/shared-gpu-configmap"
+	NvidiaVisibleDevices            = "NVIDIA_VISIBLE_DEVICES"
 
 	// UsageDB Prometheus Selector
 	DefaultAccountingLabelKey   = "kai.scheduler/accounting"
@@ -54,6 +60,8 @@ const (
 	MigStrategyLabel         = "nvidia.com/mig.strategy"
 	GpuCountLabel            = "nvidia.com/gpu.count"
 	SubGroupLabelKey         = "kai.scheduler/subgroup-name"
+	GangPodGroupLabelKey     = "kai.scheduler/gang-podgroup-name"
+	GangIDLabelKey           = "kai.scheduler/gang-id"
 )
 
 // QueueValidatedVersions returns the list of queue versions that we validate with a webhook. This will be used by the
@@ -0,0 +1,45 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// GpuIsolationMode is the mechanism that isolates fractional GPU pods that share the same device.
+type GpuIsolationMode string
+
+const (
+	// GpuIsolationNone leaves the shared device unisolated, relying on the workloads to respect their portion.
+	GpuIsolationNone GpuIsolationMode = "none"
+	// GpuIsolationMps runs the pod as an MPS client with enforced active thread percentage and memory limit.
+	GpuIsolationMps GpuIsolationMode = "mps"
+	// GpuIsolationTimeSlicing shares the device between the pods in time slices.
+	GpuIsolationTimeSlicing GpuIsolationMode = "time-slicing"
+)
+
+// GetGpuIsolationMode returns the GPU isolation mode requested by a pod using the gpu-isolation annotation.
+// Pods with the legacy mps annotation are considered as requesting MPS isolation.
+func GetGpuIsolationMode(pod *v1.Pod) (GpuIsolationMode, error) {
+	isolationMode, found := pod.Annotations[constants.GpuIsolationAnnotation]
+	if !found {
+		if pod.Annotations[constants.MpsAnnotation] == "true" {
+			return GpuIsolationMps, nil
+		}
+		return GpuIsolationNone, nil
+	}
+
+	switch GpuIsolationMode(isolationMode) {
+	case GpuIsolationNone, GpuIsolationMps, GpuIsolationTimeSlicing:
+		return GpuIsolationMode(isolationMode), nil
+	default:
+		return GpuIsolationNone, fmt.Errorf("invalid %s annotation value %q, supported values are %s, %s and %s",
+			constants.GpuIsolationAnnotation, isolationMode,
+			GpuIsolationNone, GpuIsolationMps, GpuIsolationTimeSlicing)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetGpuIsolationMode(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        GpuIsolationMode
+		wantErr     bool
+	}{
+		{
+			"No annotations",
+			nil,
+			GpuIsolationNone,
+			false,
+		},
+		{
+			"MPS isolation",
+			map[string]string{"gpu-isolation": "mps"},
+			GpuIsolationMps,
+			false,
+		},
+		{
+			"Time slicing isolation",
+			map[string]string{"gpu-isolation": "time-slicing"},
+			GpuIsolationTimeSlicing,
+			false,
+		},
+		{
+			"Legacy mps annotation",
+			map[string]string{"mps": "true"},
+			GpuIsolationMps,
+			false,
+		},
+		{
+			"Isolation annotation overrides the legacy mps annotation",
+			map[string]string{"mps": "true", "gpu-isolation": "none"},
+			GpuIsolationNone,
+			false,
+		},
+		{
+			"Invalid isolation mode",
+			map[string]string{"gpu-isolation": "mig"},
+			GpuIsolationNone,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			got, err := GetGpuIsolationMode(pod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetGpuIsolationMode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetGpuIsolationMode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
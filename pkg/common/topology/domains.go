@@ -0,0 +1,31 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"strings"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+)
+
+// IsNodePartOfTopology returns true if the node labels have a value for each level of the topology.
+func IsNodePartOfTopology(levels []kaiv1alpha1.TopologyLevel, nodeLabels map[string]string) bool {
+	for _, level := range levels {
+		if _, found := nodeLabels[level.NodeLabel]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// DomainID returns the ID of the domain at levels[levelIndex] that a node with the given labels belongs to. The ID
+// joins the node's label values of all the levels from the top level down to the domain's level, so that domains with
+// the same label value under different parent domains are told apart.
+func DomainID(levels []kaiv1alpha1.TopologyLevel, levelIndex int, nodeLabels map[string]string) string {
+	domainsNames := make([]string, levelIndex+1)
+	for index := levelIndex; index >= 0; index-- {
+		domainsNames[index] = nodeLabels[levels[index].NodeLabel]
+	}
+	return strings.Join(domainsNames, ".")
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/topology"
+)
+
+func TestDomainID(t *testing.T) {
+	levels := []kaiv1alpha1.TopologyLevel{{NodeLabel: "zone"}, {NodeLabel: "rack"}}
+	nodeLabels := map[string]string{"zone": "zone1", "rack": "rack1"}
+
+	assert.Equal(t, "zone1", topology.DomainID(levels, 0, nodeLabels))
+	assert.Equal(t, "zone1.rack1", topology.DomainID(levels, 1, nodeLabels))
+}
+
+func TestIsNodePartOfTopology(t *testing.T) {
+	levels := []kaiv1alpha1.TopologyLevel{{NodeLabel: "zone"}, {NodeLabel: "rack"}}
+	tests := []struct {
+		name       string
+		nodeLabels map[string]string
+		expected   bool
+	}{
+		{
+			name:       "all levels labeled",
+			nodeLabels: map[string]string{"zone": "zone1", "rack": "rack1"},
+			expected:   true,
+		},
+		{
+			name:       "missing level label",
+			nodeLabels: map[string]string{"zone": "zone1"},
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, topology.IsNodePartOfTopology(levels, tt.nodeLabels))
+		})
+	}
+}
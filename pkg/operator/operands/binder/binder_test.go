@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
+	kaiv1binder "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/binder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/common/test_utils"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -96,6 +97,47 @@ var _ = Describe("Binder", func() {
 				Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("kai", "scheduler"))
 			})
 
+			It("passes the external plugins to the binder", func(ctx context.Context) {
+				kaiConfig.Spec.Binder.ExternalPlugins = []kaiv1binder.ExternalPlugin{
+					{Name: "network", Protocol: "GRPC", Endpoint: "network-plugin:9090", TimeoutSeconds: ptr.To(5)},
+				}
+				objects, err := b.DesiredState(ctx, fakeKubeClient, kaiConfig)
+				Expect(err).To(BeNil())
+
+				deploymentT := test_utils.FindTypeInObjects[*appsv1.Deployment](objects)
+				Expect(deploymentT).NotTo(BeNil())
+				args := (*deploymentT).Spec.Template.Spec.Containers[0].Args
+				Expect(args).To(ContainElements("--external-plugins",
+					`[{"name":"network","protocol":"GRPC","endpoint":"network-plugin:9090","timeoutSeconds":5}]`))
+			})
+
+			It("mounts the TLS secrets of the external plugins", func(ctx context.Context) {
+				kaiConfig.Spec.Binder.ExternalPlugins = []kaiv1binder.ExternalPlugin{
+					{Name: "network", Protocol: "GRPC", Endpoint: "network-plugin:9090",
+						TLS: &kaiv1binder.ExternalPluginTLS{SecretName: "network-plugin-tls", MutualTLS: ptr.To(true)}},
+				}
+				objects, err := b.DesiredState(ctx, fakeKubeClient, kaiConfig)
+				Expect(err).To(BeNil())
+
+				deploymentT := test_utils.FindTypeInObjects[*appsv1.Deployment](objects)
+				Expect(deploymentT).NotTo(BeNil())
+				podSpec := (*deploymentT).Spec.Template.Spec
+				Expect(podSpec.Containers[0].Args).To(ContainElements("--external-plugins",
+					`[{"name":"network","protocol":"GRPC","endpoint":"network-plugin:9090","tls":{`+
+						`"caFile":"/etc/kai-binder/external-plugins/0/ca.crt",`+
+						`"certFile":"/etc/kai-binder/external-plugins/0/tls.crt",`+
+						`"keyFile":"/etc/kai-binder/external-plugins/0/tls.key"}}]`))
+				Expect(podSpec.Volumes).To(ContainElement(v1.Volume{
+					Name: "external-plugin-tls-0",
+					VolumeSource: v1.VolumeSource{
+						Secret: &v1.SecretVolumeSource{SecretName: "network-plugin-tls"},
+					},
+				}))
+				Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(v1.VolumeMount{
+					Name: "external-plugin-tls-0", MountPath: "/etc/kai-binder/external-plugins/0", ReadOnly: true,
+				}))
+			})
+
 			Context("CDI Detection", func() {
 				var (
 					clusterPolicy *nvidiav1.ClusterPolicy
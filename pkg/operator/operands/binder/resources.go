@@ -23,12 +23,15 @@ import (
 
 	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
 	kaiv1binder "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/binder"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/external"
 	kaiConfigUtils "github.com/NVIDIA/KAI-scheduler/pkg/operator/config"
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/common"
 )
 
 const (
 	defaultResourceName                    = "binder"
+	externalPluginTLSMountPath             = "/etc/kai-binder/external-plugins"
+	externalPluginCAKey                    = "ca.crt"
 	gpuOperatorVersionDefaultCDIDeprecated = "v25.10.0"
 	versionLabelName                       = "app.kubernetes.io/version"
 )
@@ -61,7 +64,12 @@ func (b *Binder) deploymentForKAIConfig(
 	deployment.Spec.Strategy.Type = appsv1.RecreateDeploymentStrategyType
 	deployment.Spec.Strategy.RollingUpdate = nil
 	deployment.Spec.Replicas = config.Replicas
-	deployment.Spec.Template.Spec.Containers[0].Args = buildArgsList(kaiConfig, config, fakeGPU, cdiEnabled)
+	args, err := buildArgsList(kaiConfig, config, fakeGPU, cdiEnabled)
+	if err != nil {
+		return nil, err
+	}
+	deployment.Spec.Template.Spec.Containers[0].Args = args
+	addExternalPluginTLSVolumes(&deployment.Spec.Template.Spec, config.ExternalPlugins)
 
 	return []client.Object{deployment}, nil
 }
@@ -205,7 +213,9 @@ func isCdiEnabled(ctx context.Context, readerClient client.Reader) (bool, error)
 	return false, nil
 }
 
-func buildArgsList(kaiConfig *kaiv1.Config, config *kaiv1binder.Binder, fakeGPU bool, cdiEnabled bool) []string {
+func buildArgsList(
+	kaiConfig *kaiv1.Config, config *kaiv1binder.Binder, fakeGPU bool, cdiEnabled bool,
+) ([]string, error) {
 	args := []string{
 		"--scheduler-name",
 		*kaiConfig.Spec.Global.SchedulerName,
@@ -263,6 +273,14 @@ func buildArgsList(kaiConfig *kaiv1.Config, config *kaiv1binder.Binder, fakeGPU
 		args = append(args, []string{fmt.Sprintf("--runtime-class-name=%s", *config.ResourceReservation.RuntimeClassName)}...)
 	}
 
+	if len(config.ExternalPlugins) > 0 {
+		externalPluginsJSON, err := json.Marshal(externalPluginConfigs(config.ExternalPlugins))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal binder external plugins: %w", err)
+		}
+		args = append(args, []string{"--external-plugins", string(externalPluginsJSON)}...)
+	}
+
 	// Serialize and add GPU reservation pod resource configurations
 	if config.ResourceReservation.PodResources != nil {
 		resourceRequirements := v1.ResourceRequirements{
@@ -270,10 +288,60 @@ func buildArgsList(kaiConfig *kaiv1.Config, config *kaiv1binder.Binder, fakeGPU
 			Limits:   config.ResourceReservation.PodResources.Limits,
 		}
 		resourcesJSON, err := json.Marshal(resourceRequirements)
-		if err == nil {
-			args = append(args, []string{"--resource-reservation-pod-resources", string(resourcesJSON)}...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal resource reservation pod resources: %w", err)
 		}
+		args = append(args, []string{"--resource-reservation-pod-resources", string(resourcesJSON)}...)
 	}
 
-	return args
+	return args, nil
+}
+
+// externalPluginConfigs converts the external plugins to the binder's configuration, pointing their TLS
+// configuration to the files of the secrets mounted by addExternalPluginTLSVolumes
+func externalPluginConfigs(externalPlugins []kaiv1binder.ExternalPlugin) []external.Config {
+	configs := make([]external.Config, 0, len(externalPlugins))
+	for i, externalPlugin := range externalPlugins {
+		config := external.Config{
+			Name:           externalPlugin.Name,
+			Protocol:       externalPlugin.Protocol,
+			Endpoint:       externalPlugin.Endpoint,
+			TimeoutSeconds: externalPlugin.TimeoutSeconds,
+			FailurePolicy:  externalPlugin.FailurePolicy,
+		}
+		if externalPlugin.TLS != nil {
+			mountPath := externalPluginTLSPath(i)
+			config.TLS = &external.TLSConfig{CAFile: mountPath + "/" + externalPluginCAKey}
+			if externalPlugin.TLS.MutualTLS != nil && *externalPlugin.TLS.MutualTLS {
+				config.TLS.CertFile = mountPath + "/" + v1.TLSCertKey
+				config.TLS.KeyFile = mountPath + "/" + v1.TLSPrivateKeyKey
+			}
+		}
+		configs = append(configs, config)
+	}
+	return configs
+}
+
+func addExternalPluginTLSVolumes(podSpec *v1.PodSpec, externalPlugins []kaiv1binder.ExternalPlugin) {
+	for i, externalPlugin := range externalPlugins {
+		if externalPlugin.TLS == nil {
+			continue
+		}
+		volumeName := fmt.Sprintf("external-plugin-tls-%d", i)
+		podSpec.Volumes = append(podSpec.Volumes, v1.Volume{
+			Name: volumeName,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{SecretName: externalPlugin.TLS.SecretName},
+			},
+		})
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, v1.VolumeMount{
+			Name:      volumeName,
+			MountPath: externalPluginTLSPath(i),
+			ReadOnly:  true,
+		})
+	}
+}
+
+func externalPluginTLSPath(index int) string {
+	return fmt.Sprintf("%s/%d", externalPluginTLSMountPath, index)
 }
@@ -62,6 +62,9 @@ func buildArgsList(kaiConfig *kaiv1.Config) []string {
 	if config.Args.GangScheduleKnative != nil {
 		args = append(args, "--knative-gang-schedule="+strconv.FormatBool(*config.Args.GangScheduleKnative))
 	}
+	if config.Args.KueueQueueNameMapping != nil {
+		args = append(args, "--kueue-queue-name-mapping="+strconv.FormatBool(*config.Args.KueueQueueNameMapping))
+	}
 
 	k8sClientConfig := config.K8sClientConfig
 	if k8sClientConfig.QPS != nil {
@@ -83,6 +86,11 @@ func buildArgsList(kaiConfig *kaiv1.Config) []string {
 			"--default-priorities-configmap-namespace", *config.Args.DefaultPrioritiesConfigMapNamespace)
 	}
 
+	if config.Args.DeclarativeGroupersConfigMapName != nil && config.Args.DeclarativeGroupersConfigMapNamespace != nil {
+		args = append(args, "--declarative-groupers-configmap-name", *config.Args.DeclarativeGroupersConfigMapName,
+			"--declarative-groupers-configmap-namespace", *config.Args.DeclarativeGroupersConfigMapNamespace)
+	}
+
 	if len(kaiConfig.Spec.Global.NamespaceLabelSelector) > 0 {
 		args = append(args, "--namespace-label-selector", formatLabelSelector(kaiConfig.Spec.Global.NamespaceLabelSelector))
 	}
@@ -157,6 +157,33 @@ func TestBuildArgsList(t *testing.T) {
 				"--knative-gang-schedule=true",
 			},
 		},
+		{
+			name: "with declarative groupers configmap",
+			config: &kaiv1.Config{
+				Spec: kaiv1.ConfigSpec{
+					Global: &kaiv1.GlobalConfig{
+						SchedulerName:    ptr.To(constants.DefaultSchedulerName),
+						QueueLabelKey:    ptr.To(constants.DefaultQueueLabel),
+						NodePoolLabelKey: ptr.To(constants.DefaultNodePoolLabelKey),
+					},
+					PodGrouper: &pod_grouper.PodGrouper{
+						Replicas: ptr.To(int32(1)),
+						Args: &pod_grouper.Args{
+							DeclarativeGroupersConfigMapName:      ptr.To("declarative-groupers"),
+							DeclarativeGroupersConfigMapNamespace: ptr.To("kai-scheduler"),
+						},
+						K8sClientConfig: &common.K8sClientConfig{},
+					},
+				},
+			},
+			expected: []string{
+				"--scheduler-name", constants.DefaultSchedulerName,
+				"--queue-label-key", constants.DefaultQueueLabel,
+				"--nodepool-label-key", constants.DefaultNodePoolLabelKey,
+				"--declarative-groupers-configmap-name", "declarative-groupers",
+				"--declarative-groupers-configmap-namespace", "kai-scheduler",
+			},
+		},
 		{
 			name: "with leader election",
 			config: &kaiv1.Config{
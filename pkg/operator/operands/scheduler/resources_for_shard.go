@@ -181,6 +181,14 @@ func getUsageDBConfig(shard *kaiv1.SchedulingShard, kaiConfig *kaiv1.Config) (*u
 
 	usageDBConfig := shard.Spec.UsageDBConfig.DeepCopy()
 
+	if usageDBConfig.ClientType == "local" {
+		if usageDBConfig.ConnectionString == "" && usageDBConfig.ConnectionStringEnvVar == "" {
+			usageDBConfig.ConnectionString = fmt.Sprintf("configmap://%s/%s-usage-history",
+				kaiConfig.Spec.Namespace, shard.Name)
+		}
+		return usageDBConfig, nil
+	}
+
 	if usageDBConfig.ClientType != "prometheus" {
 		return usageDBConfig, nil
 	}
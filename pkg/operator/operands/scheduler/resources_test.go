@@ -1142,6 +1142,45 @@ func TestGetUsageDBConfig(t *testing.T) {
 			expectError: true,
 			errorMsg:    "prometheus connection string not configured",
 		},
+		{
+			name: "local with default config map",
+			shard: &kaiv1.SchedulingShard{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: kaiv1.SchedulingShardSpec{
+					UsageDBConfig: &usagedbapi.UsageDBConfig{
+						ClientType: "local",
+					},
+				},
+			},
+			kaiConfig: &kaiv1.Config{
+				Spec: kaiv1.ConfigSpec{
+					Namespace: "kai-system",
+				},
+			},
+			expectError: false,
+			validate: func(t *testing.T, result *usagedbapi.UsageDBConfig) {
+				assert.NotNil(t, result)
+				assert.Equal(t, "local", result.ClientType)
+				assert.Equal(t, "configmap://kai-system/default-usage-history", result.ConnectionString)
+			},
+		},
+		{
+			name: "local with file store",
+			shard: &kaiv1.SchedulingShard{
+				Spec: kaiv1.SchedulingShardSpec{
+					UsageDBConfig: &usagedbapi.UsageDBConfig{
+						ClientType:       "local",
+						ConnectionString: "file:///var/lib/kai/usage.json",
+					},
+				},
+			},
+			kaiConfig:   &kaiv1.Config{},
+			expectError: false,
+			validate: func(t *testing.T, result *usagedbapi.UsageDBConfig) {
+				assert.NotNil(t, result)
+				assert.Equal(t, "file:///var/lib/kai/usage.json", result.ConnectionString)
+			},
+		},
 		{
 			name: "deep copy preserves usage params",
 			shard: &kaiv1.SchedulingShard{
@@ -0,0 +1,211 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	commontopology "github.com/NVIDIA/KAI-scheduler/pkg/common/topology"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/metrics"
+)
+
+const (
+	podNodeNameIndexer = "spec.nodeName"
+
+	// topologyEventsBatchPeriod is how long node and pod events wait before reconciling the topologies they map to.
+	topologyEventsBatchPeriod = 5 * time.Second
+)
+
+// TopologyReconciler reconciles the status of a Topology object
+type TopologyReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups="kai.scheduler",resources=topologies,verbs=get;list;watch
+// +kubebuilder:rbac:groups="kai.scheduler",resources=topologies/status,verbs=get;update;patch
+
+// Reconcile recalculates the domains of every level of the topology, and the nodes, GPUs and pod groups of each domain.
+func (r *TopologyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	logger.V(3).Info("Reconciling topology", "topology name", req.Name)
+	topology := &kaiv1alpha1.Topology{}
+	err := r.Get(ctx, req.NamespacedName, topology)
+	if err != nil {
+		ignoreNotFoundErr := client.IgnoreNotFound(err)
+		if ignoreNotFoundErr == nil {
+			// If the topology is not found, reset its metrics
+			metrics.ResetTopologyMetrics(req.Name)
+		}
+		return ctrl.Result{}, ignoreNotFoundErr
+	}
+
+	// Only the nodes that are labeled for the levels of the topology, and the pods bound to them, are accounted for
+	nodes := v1.NodeList{}
+	if err = r.List(ctx, &nodes, client.HasLabels(topologyLevelLabels(topology.Spec.Levels))); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	var pods []v1.Pod
+	for _, node := range nodes.Items {
+		nodePods := v1.PodList{}
+		if err = r.List(ctx, &nodePods, client.MatchingFields{podNodeNameIndexer: node.Name}); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to list pods of node %s: %w", node.Name, err)
+		}
+		pods = append(pods, nodePods.Items...)
+	}
+	nodesUsage, err := calculateNodesUsage(ctx, pods, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	status := buildTopologyStatus(topology.Spec.Levels, nodes.Items, nodesUsage)
+	if !equality.Semantic.DeepEqual(topology.Status, status) {
+		originalTopology := topology.DeepCopy()
+		topology.Status = status
+		err = r.Status().Patch(ctx, topology, client.MergeFrom(originalTopology))
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to patch status for topology %s, error: %w", topology.Name, err)
+		}
+	}
+
+	metrics.SetTopologyMetrics(topology)
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TopologyReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), &v1.Pod{}, podNodeNameIndexer, podNodeNameIndexerFunc)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&kaiv1alpha1.Topology{}).
+		Watches(&v1.Node{}, r.batchedEventHandler(r.mapNodeToTopologies),
+			builder.WithPredicates(nodeTopologyChangedPredicate())).
+		Watches(&v1.Pod{}, r.batchedEventHandler(r.mapPodToTopologies)).
+		WithOptions(
+			controller.Options{
+				SkipNameValidation: &skipNameValidation,
+			}).
+		Complete(r)
+}
+
+// batchedEventHandler enqueues the topologies that mapFunc maps an event's objects to after
+// topologyEventsBatchPeriod, so that the events of a burst, like a large job starting, are handled by a single
+// reconcile of each topology.
+func (r *TopologyReconciler) batchedEventHandler(
+	mapFunc func(ctx context.Context, obj client.Object) []reconcile.Request,
+) handler.EventHandler {
+	enqueue := func(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+		objects ...client.Object) {
+		for _, obj := range objects {
+			for _, request := range mapFunc(ctx, obj) {
+				queue.AddAfter(request, topologyEventsBatchPeriod)
+			}
+		}
+	}
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent,
+			queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, queue, e.Object)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent,
+			queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, queue, e.ObjectOld, e.ObjectNew)
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent,
+			queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, queue, e.Object)
+		},
+	}
+}
+
+// nodeTopologyChangedPredicate filters out node updates that change neither the labels nor the allocatable
+// resources of the node, like heartbeats.
+func nodeTopologyChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, oldOk := e.ObjectOld.(*v1.Node)
+			newNode, newOk := e.ObjectNew.(*v1.Node)
+			if !oldOk || !newOk {
+				return true
+			}
+			return !maps.Equal(oldNode.Labels, newNode.Labels) ||
+				!equality.Semantic.DeepEqual(oldNode.Status.Allocatable, newNode.Status.Allocatable)
+		},
+	}
+}
+
+// mapNodeToTopologies maps a node to the topologies that it has a label for every level of.
+func (r *TopologyReconciler) mapNodeToTopologies(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.topologiesOfNodeLabels(ctx, obj.GetLabels())
+}
+
+// mapPodToTopologies maps a pod to the topologies of the node that it is bound to.
+func (r *TopologyReconciler) mapPodToTopologies(ctx context.Context, obj client.Object) []reconcile.Request {
+	pod, ok := obj.(*v1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil
+	}
+	node := &v1.Node{}
+	if err := r.Get(ctx, types.NamespacedName{Name: pod.Spec.NodeName}, node); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			log.FromContext(ctx).Error(err, "Failed to get node of pod", "pod", client.ObjectKeyFromObject(pod),
+				"node", pod.Spec.NodeName)
+		}
+		return nil
+	}
+	return r.topologiesOfNodeLabels(ctx, node.Labels)
+}
+
+func (r *TopologyReconciler) topologiesOfNodeLabels(
+	ctx context.Context, nodeLabels map[string]string,
+) []reconcile.Request {
+	topologies := kaiv1alpha1.TopologyList{}
+	if err := r.List(ctx, &topologies); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list topologies")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, topology := range topologies.Items {
+		if !commontopology.IsNodePartOfTopology(topology.Spec.Levels, nodeLabels) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: topology.Name},
+		})
+	}
+	return requests
+}
+
+func podNodeNameIndexerFunc(obj client.Object) []string {
+	pod, ok := obj.(*v1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil
+	}
+	return []string{pod.Spec.NodeName}
+}
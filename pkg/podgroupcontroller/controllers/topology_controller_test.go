@@ -0,0 +1,203 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/metrics"
+)
+
+func TestTopologyReconciler_Reconcile(t *testing.T) {
+	metrics.InitMetrics("test")
+
+	topology := &kaiv1alpha1.Topology{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-topology"},
+		Spec: kaiv1alpha1.TopologySpec{
+			Levels: []kaiv1alpha1.TopologyLevel{{NodeLabel: "zone"}, {NodeLabel: "rack"}},
+		},
+	}
+	objects := []client.Object{
+		topology,
+		newTopologyNode("node1", "4", map[string]string{"zone": "zone1", "rack": "rack1"}),
+		newTopologyNode("node2", "4", map[string]string{"zone": "zone1", "rack": "rack2"}),
+		newTopologyNode("node3", "4", map[string]string{"zone": "zone1"}),
+		newTopologyPod("pod1", "pg1", "node1", "1", v1.PodRunning),
+		newTopologyPod("pod2", "pg1", "node2", "2", v1.PodRunning),
+		newTopologyPod("pod3", "pg2", "node2", "1", v1.PodSucceeded),
+		newTopologyPod("pod4", "pg3", "node3", "1", v1.PodRunning),
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	require.NoError(t, kaiv1alpha1.AddToScheme(scheme))
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).
+		WithStatusSubresource(&kaiv1alpha1.Topology{}).
+		WithIndex(&v1.Pod{}, podNodeNameIndexer, podNodeNameIndexerFunc).Build()
+
+	reconciler := &TopologyReconciler{Client: kubeClient, Scheme: scheme}
+	_, err := reconciler.Reconcile(context.Background(),
+		ctrl.Request{NamespacedName: types.NamespacedName{Name: topology.Name}})
+	require.NoError(t, err)
+
+	updatedTopology := &kaiv1alpha1.Topology{}
+	require.NoError(t, kubeClient.Get(context.Background(), client.ObjectKeyFromObject(topology), updatedTopology))
+	expectedStatus := kaiv1alpha1.TopologyStatus{
+		Levels: []kaiv1alpha1.TopologyLevelStatus{
+			{
+				NodeLabel: "zone",
+				Domains: []kaiv1alpha1.TopologyDomainStatus{
+					{
+						ID:            "zone1",
+						Nodes:         2,
+						TotalGPUs:     resource.MustParse("8"),
+						AllocatedGPUs: resource.MustParse("3"),
+						IdleGPUs:      resource.MustParse("5"),
+						PodGroupCount: 1,
+						PodGroups:     []string{"ns/pg1"},
+					},
+				},
+			},
+			{
+				NodeLabel: "rack",
+				Domains: []kaiv1alpha1.TopologyDomainStatus{
+					{
+						ID:            "zone1.rack1",
+						Nodes:         1,
+						TotalGPUs:     resource.MustParse("4"),
+						AllocatedGPUs: resource.MustParse("1"),
+						IdleGPUs:      resource.MustParse("3"),
+						PodGroupCount: 1,
+						PodGroups:     []string{"ns/pg1"},
+					},
+					{
+						ID:            "zone1.rack2",
+						Nodes:         1,
+						TotalGPUs:     resource.MustParse("4"),
+						AllocatedGPUs: resource.MustParse("2"),
+						IdleGPUs:      resource.MustParse("2"),
+						PodGroupCount: 1,
+						PodGroups:     []string{"ns/pg1"},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, len(expectedStatus.Levels), len(updatedTopology.Status.Levels))
+	for levelIndex, expectedLevel := range expectedStatus.Levels {
+		actualLevel := updatedTopology.Status.Levels[levelIndex]
+		assert.Equal(t, expectedLevel.NodeLabel, actualLevel.NodeLabel)
+		require.Equal(t, len(expectedLevel.Domains), len(actualLevel.Domains))
+		for domainIndex, expectedDomain := range expectedLevel.Domains {
+			actualDomain := actualLevel.Domains[domainIndex]
+			assert.Equal(t, expectedDomain.ID, actualDomain.ID)
+			assert.Equal(t, expectedDomain.Nodes, actualDomain.Nodes)
+			assert.Zero(t, expectedDomain.TotalGPUs.Cmp(actualDomain.TotalGPUs), actualDomain.ID)
+			assert.Zero(t, expectedDomain.AllocatedGPUs.Cmp(actualDomain.AllocatedGPUs), actualDomain.ID)
+			assert.Zero(t, expectedDomain.IdleGPUs.Cmp(actualDomain.IdleGPUs), actualDomain.ID)
+			assert.Equal(t, expectedDomain.PodGroupCount, actualDomain.PodGroupCount)
+			assert.Equal(t, expectedDomain.PodGroups, actualDomain.PodGroups)
+		}
+	}
+}
+
+func TestBuildTopologyStatus_CapsDomainPodGroups(t *testing.T) {
+	levels := []kaiv1alpha1.TopologyLevel{{NodeLabel: "zone"}}
+	nodes := []v1.Node{*newTopologyNode("node1", "100", map[string]string{"zone": "zone1"})}
+	usage := &nodeUsage{podGroups: map[string]bool{}}
+	for i := 0; i < kaiv1alpha1.MaxDomainPodGroups+5; i++ {
+		usage.podGroups[fmt.Sprintf("ns/pg%02d", i)] = true
+	}
+
+	status := buildTopologyStatus(levels, nodes, map[string]*nodeUsage{"node1": usage})
+
+	require.Len(t, status.Levels, 1)
+	require.Len(t, status.Levels[0].Domains, 1)
+	domain := status.Levels[0].Domains[0]
+	assert.Equal(t, int32(kaiv1alpha1.MaxDomainPodGroups+5), domain.PodGroupCount)
+	require.Len(t, domain.PodGroups, kaiv1alpha1.MaxDomainPodGroups)
+	assert.Equal(t, "ns/pg00", domain.PodGroups[0])
+	assert.Equal(t, fmt.Sprintf("ns/pg%02d", kaiv1alpha1.MaxDomainPodGroups-1), domain.PodGroups[len(domain.PodGroups)-1])
+}
+
+func TestTopologyReconciler_MapToTopologies(t *testing.T) {
+	objects := []client.Object{
+		&kaiv1alpha1.Topology{
+			ObjectMeta: metav1.ObjectMeta{Name: "rack-topology"},
+			Spec: kaiv1alpha1.TopologySpec{
+				Levels: []kaiv1alpha1.TopologyLevel{{NodeLabel: "zone"}, {NodeLabel: "rack"}},
+			},
+		},
+		&kaiv1alpha1.Topology{
+			ObjectMeta: metav1.ObjectMeta{Name: "block-topology"},
+			Spec: kaiv1alpha1.TopologySpec{
+				Levels: []kaiv1alpha1.TopologyLevel{{NodeLabel: "zone"}, {NodeLabel: "block"}},
+			},
+		},
+		newTopologyNode("rack-node", "4", map[string]string{"zone": "zone1", "rack": "rack1"}),
+		newTopologyNode("unlabeled-node", "4", map[string]string{}),
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	require.NoError(t, kaiv1alpha1.AddToScheme(scheme))
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	reconciler := &TopologyReconciler{Client: kubeClient, Scheme: scheme}
+
+	rackTopologyRequest := []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "rack-topology"}}}
+	assert.Equal(t, rackTopologyRequest,
+		reconciler.mapNodeToTopologies(context.Background(), objects[2]))
+	assert.Empty(t, reconciler.mapNodeToTopologies(context.Background(), objects[3]))
+	assert.Equal(t, rackTopologyRequest, reconciler.mapPodToTopologies(context.Background(),
+		newTopologyPod("pod1", "pg1", "rack-node", "1", v1.PodRunning)))
+	assert.Empty(t, reconciler.mapPodToTopologies(context.Background(),
+		newTopologyPod("pod2", "pg1", "unlabeled-node", "1", v1.PodRunning)))
+	assert.Empty(t, reconciler.mapPodToTopologies(context.Background(),
+		newTopologyPod("pod3", "pg1", "", "1", v1.PodPending)))
+}
+
+func newTopologyNode(name, gpus string, labels map[string]string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{"nvidia.com/gpu": resource.MustParse(gpus)},
+		},
+	}
+}
+
+func newTopologyPod(name, podGroupName, nodeName, gpus string, phase v1.PodPhase) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns",
+			Name:        name,
+			Annotations: map[string]string{"pod-group-name": podGroupName},
+		},
+		Spec: v1.PodSpec{
+			NodeName: nodeName,
+			Containers: []v1.Container{
+				{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{"nvidia.com/gpu": resource.MustParse(gpus)},
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
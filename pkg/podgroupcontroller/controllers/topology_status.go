@@ -0,0 +1,135 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	commontopology "github.com/NVIDIA/KAI-scheduler/pkg/common/topology"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/cluster_relations"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/metadata"
+)
+
+func topologyLevelLabels(levels []kaiv1alpha1.TopologyLevel) []string {
+	labels := make([]string, 0, len(levels))
+	for _, level := range levels {
+		labels = append(labels, level.NodeLabel)
+	}
+	return labels
+}
+
+// nodeUsage is the GPUs and pod groups allocated on a single node.
+type nodeUsage struct {
+	allocatedGPUs resource.Quantity
+	podGroups     map[string]bool
+}
+
+func calculateNodesUsage(ctx context.Context, pods []v1.Pod, kubeClient client.Client) (map[string]*nodeUsage, error) {
+	nodesUsage := map[string]*nodeUsage{}
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Spec.NodeName == "" || pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		podMetadata, err := metadata.GetPodMetadata(ctx, pod, kubeClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate allocated resources for pod %s/%s: %w",
+				pod.Namespace, pod.Name, err)
+		}
+		if len(podMetadata.AllocatedResources) == 0 {
+			continue
+		}
+
+		usage, found := nodesUsage[pod.Spec.NodeName]
+		if !found {
+			usage = &nodeUsage{podGroups: map[string]bool{}}
+			nodesUsage[pod.Spec.NodeName] = usage
+		}
+		if gpus, found := podMetadata.AllocatedResources[constants.NvidiaGpuResource]; found {
+			usage.allocatedGPUs.Add(gpus)
+		}
+		if podGroupName, err := cluster_relations.GetPodGroupName(pod); err == nil {
+			usage.podGroups[fmt.Sprintf("%s/%s", pod.Namespace, podGroupName)] = true
+		}
+	}
+	return nodesUsage, nil
+}
+
+// buildTopologyStatus groups the nodes that have a label for every level of the topology into the domains of each
+// level, and sums up the usage of the nodes of each domain.
+func buildTopologyStatus(
+	levels []kaiv1alpha1.TopologyLevel, nodes []v1.Node, nodesUsage map[string]*nodeUsage,
+) kaiv1alpha1.TopologyStatus {
+	status := kaiv1alpha1.TopologyStatus{}
+	for levelIndex, level := range levels {
+		domainsByID := map[string]*kaiv1alpha1.TopologyDomainStatus{}
+		domainsPodGroups := map[string]map[string]bool{}
+		for _, node := range nodes {
+			if !commontopology.IsNodePartOfTopology(levels, node.Labels) {
+				continue
+			}
+
+			domainID := commontopology.DomainID(levels, levelIndex, node.Labels)
+			domain, found := domainsByID[domainID]
+			if !found {
+				domain = &kaiv1alpha1.TopologyDomainStatus{ID: domainID}
+				domainsByID[domainID] = domain
+				domainsPodGroups[domainID] = map[string]bool{}
+			}
+
+			domain.Nodes += 1
+			if gpus, found := node.Status.Allocatable[constants.NvidiaGpuResource]; found {
+				domain.TotalGPUs.Add(gpus)
+			}
+			if usage, found := nodesUsage[node.Name]; found {
+				domain.AllocatedGPUs.Add(usage.allocatedGPUs)
+				for podGroup := range usage.podGroups {
+					domainsPodGroups[domainID][podGroup] = true
+				}
+			}
+		}
+
+		levelStatus := kaiv1alpha1.TopologyLevelStatus{NodeLabel: level.NodeLabel}
+		for domainID, domain := range domainsByID {
+			domain.IdleGPUs = domain.TotalGPUs.DeepCopy()
+			domain.IdleGPUs.Sub(domain.AllocatedGPUs)
+			if domain.IdleGPUs.Sign() < 0 {
+				domain.IdleGPUs = resource.Quantity{}
+			}
+			domain.PodGroupCount = int32(len(domainsPodGroups[domainID]))
+			domain.PodGroups = listDomainPodGroups(domainsPodGroups[domainID])
+			levelStatus.Domains = append(levelStatus.Domains, *domain)
+		}
+		sort.Slice(levelStatus.Domains, func(i, j int) bool {
+			return levelStatus.Domains[i].ID < levelStatus.Domains[j].ID
+		})
+		status.Levels = append(status.Levels, levelStatus)
+	}
+	return status
+}
+
+// listDomainPodGroups returns the first pod groups of a domain, sorted, so that the status of large domains stays small.
+func listDomainPodGroups(podGroups map[string]bool) []string {
+	if len(podGroups) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(podGroups))
+	for podGroup := range podGroups {
+		names = append(names, podGroup)
+	}
+	sort.Strings(names)
+	if len(names) > kaiv1alpha1.MaxDomainPodGroups {
+		names = names[:kaiv1alpha1.MaxDomainPodGroups]
+	}
+	return names
+}
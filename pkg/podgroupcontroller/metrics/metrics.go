@@ -0,0 +1,139 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"math"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto" // auto-registry collectors in default registry
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+)
+
+const (
+	topologyNameLabel = "topology"
+	levelLabel        = "level"
+	domainLabel       = "domain"
+)
+
+var (
+	initiated = false
+
+	topologyDomainNodes         *prometheus.GaugeVec
+	topologyDomainGpus          *prometheus.GaugeVec
+	topologyDomainAllocatedGpus *prometheus.GaugeVec
+	topologyDomainIdleGpus      *prometheus.GaugeVec
+	topologyDomainPodGroups     *prometheus.GaugeVec
+)
+
+// InitMetrics initializes the topology domain metrics of the pod group controller.
+// params:
+//
+//	namespace: the Prometheus namespace for the metrics
+func InitMetrics(namespace string) {
+	if initiated {
+		return
+	}
+	initiated = true
+
+	domainMetricsLabels := []string{topologyNameLabel, levelLabel, domainLabel}
+
+	topologyDomainNodes = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "topology_domain_nodes",
+			Help:      "Number of nodes in a topology domain",
+		}, domainMetricsLabels,
+	)
+
+	topologyDomainGpus = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "topology_domain_gpus",
+			Help:      "Number of allocatable GPUs in a topology domain",
+		}, domainMetricsLabels,
+	)
+
+	topologyDomainAllocatedGpus = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "topology_domain_allocated_gpus",
+			Help:      "Number of GPUs allocated to pods in a topology domain",
+		}, domainMetricsLabels,
+	)
+
+	topologyDomainIdleGpus = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "topology_domain_idle_gpus",
+			Help:      "Number of GPUs in a topology domain that are not allocated to any pod",
+		}, domainMetricsLabels,
+	)
+
+	topologyDomainPodGroups = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "topology_domain_pod_groups",
+			Help:      "Number of pod groups with pods allocated in a topology domain",
+		}, domainMetricsLabels,
+	)
+
+	metrics.Registry.MustRegister(topologyDomainNodes, topologyDomainGpus, topologyDomainAllocatedGpus,
+		topologyDomainIdleGpus, topologyDomainPodGroups)
+}
+
+func SetTopologyMetrics(topology *kaiv1alpha1.Topology) {
+	if topology == nil {
+		return
+	}
+
+	ResetTopologyMetrics(topology.Name)
+
+	for _, level := range topology.Status.Levels {
+		for _, domain := range level.Domains {
+			labelValues := []string{topology.Name, level.NodeLabel, domain.ID}
+			topologyDomainNodes.WithLabelValues(labelValues...).Set(float64(domain.Nodes))
+			topologyDomainGpus.WithLabelValues(labelValues...).Set(roundResourceQuantity(domain.TotalGPUs))
+			topologyDomainAllocatedGpus.WithLabelValues(labelValues...).Set(roundResourceQuantity(domain.AllocatedGPUs))
+			topologyDomainIdleGpus.WithLabelValues(labelValues...).Set(roundResourceQuantity(domain.IdleGPUs))
+			topologyDomainPodGroups.WithLabelValues(labelValues...).Set(float64(domain.PodGroupCount))
+		}
+	}
+}
+
+func ResetTopologyMetrics(topologyName string) {
+	topologyLabelIdentifier := prometheus.Labels{topologyNameLabel: topologyName}
+	topologyDomainNodes.DeletePartialMatch(topologyLabelIdentifier)
+	topologyDomainGpus.DeletePartialMatch(topologyLabelIdentifier)
+	topologyDomainAllocatedGpus.DeletePartialMatch(topologyLabelIdentifier)
+	topologyDomainIdleGpus.DeletePartialMatch(topologyLabelIdentifier)
+	topologyDomainPodGroups.DeletePartialMatch(topologyLabelIdentifier)
+}
+
+func roundResourceQuantity(quantity resource.Quantity) float64 {
+	return math.Round(quantity.AsApproximateFloat64()*10000) / 10000
+}
+
+func GetTopologyDomainNodesMetric() *prometheus.GaugeVec {
+	return topologyDomainNodes
+}
+
+func GetTopologyDomainGPUsMetric() *prometheus.GaugeVec {
+	return topologyDomainGpus
+}
+
+func GetTopologyDomainAllocatedGPUsMetric() *prometheus.GaugeVec {
+	return topologyDomainAllocatedGpus
+}
+
+func GetTopologyDomainIdleGPUsMetric() *prometheus.GaugeVec {
+	return topologyDomainIdleGpus
+}
+
+func GetTopologyDomainPodGroupsMetric() *prometheus.GaugeVec {
+	return topologyDomainPodGroups
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+)
+
+func TestTopologyMetrics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "podgroupcontroller metrics tests")
+}
+
+var _ = Describe("Topology Metrics", Ordered, func() {
+	var topology *kaiv1alpha1.Topology
+
+	BeforeAll(func() {
+		InitMetrics("testns")
+	})
+
+	BeforeEach(func() {
+		topology = &kaiv1alpha1.Topology{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-topology"},
+			Status: kaiv1alpha1.TopologyStatus{
+				Levels: []kaiv1alpha1.TopologyLevelStatus{
+					{
+						NodeLabel: "rack",
+						Domains: []kaiv1alpha1.TopologyDomainStatus{
+							{
+								ID:            "zone1.rack1",
+								Nodes:         2,
+								TotalGPUs:     resource.MustParse("16"),
+								AllocatedGPUs: resource.MustParse("2.5"),
+								IdleGPUs:      resource.MustParse("13.5"),
+								PodGroupCount: 2,
+								PodGroups:     []string{"ns/pg1", "ns/pg2"},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ResetTopologyMetrics("cluster-topology")
+	})
+
+	It("should set the metrics of every domain", func() {
+		SetTopologyMetrics(topology)
+
+		labelValues := []string{"cluster-topology", "rack", "zone1.rack1"}
+		Expect(testutil.ToFloat64(GetTopologyDomainNodesMetric().WithLabelValues(labelValues...))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(GetTopologyDomainGPUsMetric().WithLabelValues(labelValues...))).To(Equal(16.0))
+		Expect(testutil.ToFloat64(GetTopologyDomainAllocatedGPUsMetric().WithLabelValues(labelValues...))).To(Equal(2.5))
+		Expect(testutil.ToFloat64(GetTopologyDomainIdleGPUsMetric().WithLabelValues(labelValues...))).To(Equal(13.5))
+		Expect(testutil.ToFloat64(GetTopologyDomainPodGroupsMetric().WithLabelValues(labelValues...))).To(Equal(2.0))
+	})
+
+	It("should remove the metrics of domains that no longer exist", func() {
+		SetTopologyMetrics(topology)
+		Expect(testutil.CollectAndCount(GetTopologyDomainNodesMetric())).To(Equal(1))
+
+		topology.Status.Levels[0].Domains = nil
+		SetTopologyMetrics(topology)
+		Expect(testutil.CollectAndCount(GetTopologyDomainNodesMetric())).To(Equal(0))
+	})
+})
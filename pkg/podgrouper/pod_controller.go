@@ -51,12 +51,16 @@ type Configs struct {
 	KnativeGangSchedule      bool
 	SchedulerName            string
 	SchedulingQueueLabelKey  string
+	KueueQueueNameMapping    bool
 
 	PodLabelSelector       map[string]string
 	NamespaceLabelSelector map[string]string
 
 	DefaultConfigPerTypeConfigMapName      string
 	DefaultConfigPerTypeConfigMapNamespace string
+
+	DeclarativeGroupersConfigMapName      string
+	DeclarativeGroupersConfigMapNamespace string
 }
 
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
@@ -98,6 +98,7 @@ func (h *Handler) createPodGroupForMetadata(podGroupMetadata Metadata) *scheduli
 		},
 		Spec: schedulingv2alpha2.PodGroupSpec{
 			MinMember:         podGroupMetadata.MinAvailable,
+			MaxMember:         podGroupMetadata.MaxAvailable,
 			Queue:             podGroupMetadata.Queue,
 			PriorityClassName: podGroupMetadata.PriorityClassName,
 			SubGroups:         []schedulingv2alpha2.SubGroup{},
@@ -129,5 +130,12 @@ func (h *Handler) createPodGroupForMetadata(podGroupMetadata Metadata) *scheduli
 		Topology:               podGroupMetadata.Topology,
 	}
 
+	if podGroupMetadata.GPUPlacementStrategy != "" || podGroupMetadata.CPUPlacementStrategy != "" {
+		pg.Spec.PlacementStrategy = &schedulingv2alpha2.PlacementStrategy{
+			GPU: podGroupMetadata.GPUPlacementStrategy,
+			CPU: podGroupMetadata.CPUPlacementStrategy,
+		}
+	}
+
 	return pg
 }
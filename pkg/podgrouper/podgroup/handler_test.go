@@ -473,6 +473,43 @@ func Test_createPodGroupForMetadata(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "podgroup level placement strategy",
+			input: Metadata{
+				Name:                 "test-podgroup",
+				Namespace:            "test-namespace",
+				MinAvailable:         1,
+				GPUPlacementStrategy: "spread",
+				Owner: metav1.OwnerReference{
+					APIVersion: "v1",
+					Kind:       "Pod",
+					Name:       "owner",
+					UID:        "owner-uid",
+				},
+			},
+			expected: &schedulingv2alpha2.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-podgroup",
+					Namespace: "test-namespace",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "v1",
+							Kind:       "Pod",
+							Name:       "owner",
+							UID:        "owner-uid",
+						},
+					},
+				},
+				Spec: schedulingv2alpha2.PodGroupSpec{
+					MinMember:          1,
+					SubGroups:          []schedulingv2alpha2.SubGroup{},
+					TopologyConstraint: schedulingv2alpha2.TopologyConstraint{},
+					PlacementStrategy: &schedulingv2alpha2.PlacementStrategy{
+						GPU: "spread",
+					},
+				},
+			},
+		},
 		{
 			name: "empty topology values",
 			input: Metadata{
@@ -31,12 +31,16 @@ type Metadata struct {
 	Namespace         string
 	Name              string
 	MinAvailable      int32
+	MaxAvailable      *int32
 	Owner             metav1.OwnerReference
 	SubGroups         []*SubGroupMetadata
 
 	PreferredTopologyLevel string
 	RequiredTopologyLevel  string
 	Topology               string
+
+	GPUPlacementStrategy string
+	CPUPlacementStrategy string
 }
 
 func (m *Metadata) FindSubGroupForPod(podNamespace, podName string) *SubGroupMetadata {
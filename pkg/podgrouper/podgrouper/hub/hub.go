@@ -4,11 +4,14 @@
 package pluginshub
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/aml"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/cronjobs"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/declarative"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/defaultgrouper"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/deployment"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/grouper"
@@ -30,6 +33,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/skiptopowner"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/spark"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/spotrequest"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/volcano"
 )
 
 const (
@@ -69,33 +73,53 @@ type PluginsHub interface {
 }
 
 func (ph *DefaultPluginsHub) GetPodGrouperPlugin(gvk metav1.GroupVersionKind) grouper.Grouper {
-	if f, found := ph.customPlugins[gvk]; found {
-		return f
-	}
-
-	// search using wildcard version
-	gvk.Version = "*"
-	if f, found := ph.customPlugins[gvk]; found {
+	if f, found := ph.findCustomPlugin(gvk); found {
 		return f
 	}
 	return ph.defaultPlugin
 }
 
+// SetKueueQueueNameMapping sets whether the groupers map Kueue's queue-name label to the KAI queue of the same name
+func (ph *DefaultPluginsHub) SetKueueQueueNameMapping(kueueQueueNameMapping bool) {
+	ph.defaultPlugin.SetKueueQueueNameMapping(kueueQueueNameMapping)
+}
+
 func (ph *DefaultPluginsHub) GetDefaultPlugin() grouper.Grouper {
 	return ph.defaultPlugin
 }
 
 func (ph *DefaultPluginsHub) HasMatchingPlugin(gvk metav1.GroupVersionKind) bool {
-	if _, found := ph.customPlugins[gvk]; found {
-		return true
+	_, found := ph.findCustomPlugin(gvk)
+	return found
+}
+
+func (ph *DefaultPluginsHub) findCustomPlugin(gvk metav1.GroupVersionKind) (grouper.Grouper, bool) {
+	if f, found := ph.customPlugins[gvk]; found {
+		return f, true
 	}
 
 	// search using wildcard version
 	gvk.Version = "*"
-	if _, found := ph.customPlugins[gvk]; found {
-		return true
+	f, found := ph.customPlugins[gvk]
+	return f, found
+}
+
+// AddDeclarativeGroupers registers a declarative grouper for the GVK of every config.
+// A GVK that already has a grouper, for its version or for any version, cannot be overridden.
+func (ph *DefaultPluginsHub) AddDeclarativeGroupers(configs []declarative.Config) error {
+	for _, config := range configs {
+		gvk := config.GVK()
+		if existing, found := ph.findCustomPlugin(gvk); found {
+			return fmt.Errorf("cannot add a declarative grouper for %s, it is already handled by %s",
+				gvk.String(), existing.Name())
+		}
+		declarativeGrouper, err := declarative.NewDeclarativeGrouper(config, ph.defaultPlugin)
+		if err != nil {
+			return err
+		}
+		ph.customPlugins[gvk] = declarativeGrouper
 	}
-	return false
+	return nil
 }
 
 func NewDefaultPluginsHub(kubeClient client.Client, searchForLegacyPodGroups,
@@ -289,6 +313,11 @@ func NewDefaultPluginsHub(kubeClient client.Client, searchForLegacyPodGroups,
 			Version: "v1alpha1",
 			Kind:    "PodCliqueSet",
 		}: groveGrouper,
+		{
+			Group:   "batch.volcano.sh",
+			Version: "v1alpha1",
+			Kind:    "Job",
+		}: volcano.NewVolcanoJobGrouper(defaultGrouper),
 	}
 
 	skipTopOwnerGrouper := skiptopowner.NewSkipTopOwnerGrouper(kubeClient, defaultGrouper, table)
@@ -12,6 +12,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/declarative"
 )
 
 const (
@@ -80,6 +82,17 @@ var _ = Describe("SupportedTypes", func() {
 			Expect(hasPlugin).To(BeFalse())
 		})
 
+		It("should return the Volcano Job plugin", func() {
+			gvk := metav1.GroupVersionKind{
+				Group:   "batch.volcano.sh",
+				Version: "v1alpha1",
+				Kind:    "Job",
+			}
+			plugin := hub.GetPodGrouperPlugin(gvk)
+			Expect(plugin).NotTo(BeNil())
+			Expect(plugin.Name()).To(BeEquivalentTo("Volcano Job Grouper"))
+		})
+
 		It("should return skipTopOwner plugin for TrainJob", func() {
 			gvk := metav1.GroupVersionKind{
 				Group:   "trainer.kubeflow.org",
@@ -138,4 +151,45 @@ var _ = Describe("SupportedTypes", func() {
 			Expect(plugin.Name()).To(BeEquivalentTo("Default Grouper"))
 		})
 	})
+
+	Context("Declarative Groupers", func() {
+		var hub *DefaultPluginsHub
+
+		BeforeEach(func() {
+			hub = NewDefaultPluginsHub(
+				fake.NewFakeClient(), false, false, queueLabelKey, nodePoolLabelKey, "", "",
+			)
+		})
+
+		It("should return the declarative grouper for its GVK", func() {
+			Expect(hub.AddDeclarativeGroupers([]declarative.Config{
+				{Group: "example.com", Version: "*", Kind: "TrainingRun", MinAvailable: ".spec.workers"},
+			})).To(Succeed())
+
+			gvk := metav1.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "TrainingRun"}
+			Expect(hub.HasMatchingPlugin(gvk)).To(BeTrue())
+			Expect(hub.GetPodGrouperPlugin(gvk).Name()).To(
+				BeEquivalentTo("Declarative Grouper (example.com/*, Kind=TrainingRun)"))
+		})
+
+		It("should not override a built-in grouper", func() {
+			err := hub.AddDeclarativeGroupers([]declarative.Config{
+				{Group: "kubeflow.org", Version: "v1", Kind: "TFJob", MinAvailable: ".spec.workers"},
+			})
+			Expect(err).To(HaveOccurred())
+
+			gvk := metav1.GroupVersionKind{Group: "kubeflow.org", Version: "v1", Kind: "TFJob"}
+			Expect(hub.GetPodGrouperPlugin(gvk).Name()).To(BeEquivalentTo("TensorFlow Grouper"))
+		})
+
+		It("should not override a built-in grouper of any version", func() {
+			err := hub.AddDeclarativeGroupers([]declarative.Config{
+				{Group: apiGroupRunai, Version: "v2", Kind: kindTrainingWorkload, MinAvailable: ".spec.workers"},
+			})
+			Expect(err).To(HaveOccurred())
+
+			gvk := metav1.GroupVersionKind{Group: apiGroupRunai, Version: "v2", Kind: kindTrainingWorkload}
+			Expect(hub.GetPodGrouperPlugin(gvk).Name()).NotTo(ContainSubstring("Declarative Grouper"))
+		})
+	})
 })
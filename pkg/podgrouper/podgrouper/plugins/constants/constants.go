@@ -18,6 +18,8 @@ const (
 
 	DefaultQueueName = "default-queue"
 
+	KueueQueueNameLabelKey = "kueue.x-k8s.io/queue-name"
+
 	TopologyKey                   = "kai.scheduler/topology"
 	TopologyRequiredPlacementKey  = "kai.scheduler/topology-required-placement"
 	TopologyPreferredPlacementKey = "kai.scheduler/topology-preferred-placement"
@@ -25,4 +27,7 @@ const (
 	SegmentSizeKey                       = "kai.scheduler/segment-size"
 	SegmentTopologyRequiredPlacementKey  = "kai.scheduler/segment-topology-required-placement"
 	SegmentTopologyPreferredPlacementKey = "kai.scheduler/segment-topology-preferred-placement"
+
+	GPUPlacementStrategyKey = "kai.scheduler/gpu-placement-strategy"
+	CPUPlacementStrategyKey = "kai.scheduler/cpu-placement-strategy"
 )
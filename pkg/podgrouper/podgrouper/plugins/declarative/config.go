@@ -0,0 +1,120 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package declarative
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigMapGroupersKey is the key of the grouper configurations in the declarative groupers configmap
+const ConfigMapGroupersKey = "groupers"
+
+// Config configures a declarative grouper for the top owners of one GVK.
+// All the expressions are JSONPath expressions (e.g. "{.spec.minMembers}" or ".spec.minMembers").
+// An empty expression, or one that matches nothing, leaves the value of the default grouper.
+type Config struct {
+	// Group, Version and Kind of the top owner. Version "*" matches every version of the group and kind.
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+
+	// MinAvailable is evaluated against the top owner and must result in an integer
+	MinAvailable string `json:"minAvailable,omitempty"`
+
+	// Queue is evaluated against the top owner
+	Queue string `json:"queue,omitempty"`
+
+	// PriorityClassName is evaluated against the top owner
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// Preemptibility is evaluated against the top owner and must result in preemptible or non-preemptible
+	Preemptibility string `json:"preemptibility,omitempty"`
+
+	// Topology is evaluated against the top owner
+	Topology *TopologyConfig `json:"topology,omitempty"`
+
+	// SubGroups builds a subgroup out of every item of a list in the top owner
+	SubGroups *SubGroupsConfig `json:"subGroups,omitempty"`
+}
+
+// TopologyConfig configures the expressions of topology constraints
+type TopologyConfig struct {
+	// Topology is the name of the topology
+	Topology string `json:"topology,omitempty"`
+
+	// RequiredTopologyLevel is the topology level that all the pods must be placed in
+	RequiredTopologyLevel string `json:"requiredTopologyLevel,omitempty"`
+
+	// PreferredTopologyLevel is the topology level that the pods should be placed in
+	PreferredTopologyLevel string `json:"preferredTopologyLevel,omitempty"`
+}
+
+// SubGroupsConfig configures the subgroups of the pod group
+type SubGroupsConfig struct {
+	// List is evaluated against the top owner and must result in the list of items that describe the subgroups
+	List string `json:"list"`
+
+	// Name is evaluated against every item of List
+	Name string `json:"name"`
+
+	// MinAvailable is evaluated against every item of List and must result in an integer
+	MinAvailable string `json:"minAvailable"`
+
+	// RequiredTopologyLevel and PreferredTopologyLevel are evaluated against every item of List.
+	// They use the topology of the pod group.
+	RequiredTopologyLevel  string `json:"requiredTopologyLevel,omitempty"`
+	PreferredTopologyLevel string `json:"preferredTopologyLevel,omitempty"`
+
+	// PodLabelKey is the pod label whose value is the name of the subgroup of the pod
+	PodLabelKey string `json:"podLabelKey"`
+}
+
+func (c *Config) GVK() metav1.GroupVersionKind {
+	return metav1.GroupVersionKind{Group: c.Group, Version: c.Version, Kind: c.Kind}
+}
+
+// ParseConfigs parses the declarative grouper configurations from YAML or JSON
+func ParseConfigs(data string) ([]Config, error) {
+	if data == "" {
+		return nil, nil
+	}
+
+	var configs []Config
+	if err := yaml.UnmarshalStrict([]byte(data), &configs); err != nil {
+		return nil, fmt.Errorf("failed to parse declarative groupers configuration: %w", err)
+	}
+
+	gvks := map[metav1.GroupVersionKind]bool{}
+	for i := range configs {
+		if err := configs[i].validate(); err != nil {
+			return nil, err
+		}
+		gvk := configs[i].GVK()
+		if gvks[gvk] {
+			return nil, fmt.Errorf("declarative grouper for %s is configured more than once", gvk.String())
+		}
+		gvks[gvk] = true
+	}
+	return configs, nil
+}
+
+func (c *Config) validate() error {
+	if c.Version == "" || c.Kind == "" {
+		return fmt.Errorf("declarative grouper %s must have a version and a kind", c.GVK().String())
+	}
+	if c.SubGroups != nil {
+		if c.SubGroups.List == "" || c.SubGroups.Name == "" || c.SubGroups.MinAvailable == "" ||
+			c.SubGroups.PodLabelKey == "" {
+			return fmt.Errorf("subgroups of declarative grouper %s must have a list, a name, a minAvailable "+
+				"and a podLabelKey", c.GVK().String())
+		}
+	}
+	if _, err := compileConfig(c); err != nil {
+		return fmt.Errorf("declarative grouper %s: %w", c.GVK().String(), err)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package declarative
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/defaultgrouper"
+)
+
+var logger = log.FromContext(context.Background())
+
+type compiledConfig struct {
+	minAvailable      expression
+	queue             expression
+	priorityClassName expression
+	preemptibility    expression
+
+	topology               expression
+	requiredTopologyLevel  expression
+	preferredTopologyLevel expression
+
+	subGroups *compiledSubGroupsConfig
+}
+
+type compiledSubGroupsConfig struct {
+	list                   expression
+	name                   expression
+	minAvailable           expression
+	requiredTopologyLevel  expression
+	preferredTopologyLevel expression
+	podLabelKey            string
+}
+
+// DeclarativeGrouper creates pod groups for top owners of a GVK that has no dedicated grouper. It starts from the
+// metadata of the default grouper and overrides it with the values that the expressions of its Config extract
+// from the top owner.
+type DeclarativeGrouper struct {
+	*defaultgrouper.DefaultGrouper
+	config   Config
+	compiled *compiledConfig
+}
+
+func NewDeclarativeGrouper(config Config, defaultGrouper *defaultgrouper.DefaultGrouper) (*DeclarativeGrouper, error) {
+	compiled, err := compileConfig(&config)
+	if err != nil {
+		return nil, fmt.Errorf("declarative grouper %s: %w", config.GVK().String(), err)
+	}
+	return &DeclarativeGrouper{
+		DefaultGrouper: defaultGrouper,
+		config:         config,
+		compiled:       compiled,
+	}, nil
+}
+
+func (dg *DeclarativeGrouper) Name() string {
+	return fmt.Sprintf("Declarative Grouper (%s)", dg.config.GVK().String())
+}
+
+func (dg *DeclarativeGrouper) GetPodGroupMetadata(
+	topOwner *unstructured.Unstructured, pod *v1.Pod, allOwners ...*metav1.PartialObjectMetadata,
+) (*podgroup.Metadata, error) {
+	metadata, err := dg.DefaultGrouper.GetPodGroupMetadata(topOwner, pod, allOwners...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = dg.applyTopOwnerExpressions(topOwner, metadata); err != nil {
+		return nil, fmt.Errorf("failed to get pod group metadata from %s %s/%s: %w",
+			topOwner.GetKind(), topOwner.GetNamespace(), topOwner.GetName(), err)
+	}
+
+	if dg.compiled.subGroups != nil {
+		if err = dg.applySubGroups(topOwner, pod, metadata); err != nil {
+			return nil, fmt.Errorf("failed to get subgroups from %s %s/%s: %w",
+				topOwner.GetKind(), topOwner.GetNamespace(), topOwner.GetName(), err)
+		}
+	}
+
+	return metadata, nil
+}
+
+func (dg *DeclarativeGrouper) applyTopOwnerExpressions(topOwner *unstructured.Unstructured,
+	metadata *podgroup.Metadata) error {
+	minAvailable, found, err := dg.compiled.minAvailable.int32Value(topOwner.Object)
+	if err != nil {
+		return err
+	}
+	if found {
+		metadata.MinAvailable = minAvailable
+	}
+
+	for _, field := range []struct {
+		expression expression
+		target     *string
+	}{
+		{dg.compiled.queue, &metadata.Queue},
+		{dg.compiled.priorityClassName, &metadata.PriorityClassName},
+		{dg.compiled.topology, &metadata.Topology},
+		{dg.compiled.requiredTopologyLevel, &metadata.RequiredTopologyLevel},
+		{dg.compiled.preferredTopologyLevel, &metadata.PreferredTopologyLevel},
+	} {
+		value, found, err := field.expression.stringValue(topOwner.Object)
+		if err != nil {
+			return err
+		}
+		if found && value != "" {
+			*field.target = value
+		}
+	}
+
+	preemptibility, found, err := dg.compiled.preemptibility.stringValue(topOwner.Object)
+	if err != nil {
+		return err
+	}
+	if found && preemptibility != "" {
+		metadata.Preemptibility, err = v2alpha2.ParsePreemptibility(preemptibility)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dg *DeclarativeGrouper) applySubGroups(topOwner *unstructured.Unstructured, pod *v1.Pod,
+	metadata *podgroup.Metadata) error {
+	config := dg.compiled.subGroups
+	items, err := config.list.values(topOwner.Object)
+	if err != nil {
+		return err
+	}
+
+	subGroupName, hasSubGroupLabel := pod.Labels[config.podLabelKey]
+	podAssigned := false
+	var subGroups []*podgroup.SubGroupMetadata
+	var totalMinAvailable int32
+	names := map[string]bool{}
+	for index, item := range items {
+		subGroup, err := buildSubGroup(config, item, metadata.Topology)
+		if err != nil {
+			return fmt.Errorf("item %d: %w", index, err)
+		}
+		if names[subGroup.Name] {
+			return fmt.Errorf("subgroup %s appears more than once", subGroup.Name)
+		}
+		names[subGroup.Name] = true
+
+		if hasSubGroupLabel && subGroupName == subGroup.Name {
+			subGroup.PodsReferences = append(subGroup.PodsReferences, pod.Name)
+			podAssigned = true
+		}
+		subGroups = append(subGroups, subGroup)
+		totalMinAvailable += subGroup.MinAvailable
+	}
+	if len(subGroups) == 0 {
+		return nil
+	}
+	if !hasSubGroupLabel {
+		logger.V(1).Info("Subgroup label not found on pod", "pod", pod.Name, "namespace", pod.Namespace,
+			"label", config.podLabelKey)
+	} else if !podAssigned {
+		logger.V(1).Info("Subgroup of pod not found in top owner", "pod", pod.Name, "namespace", pod.Namespace,
+			"subgroup", subGroupName)
+	}
+
+	metadata.SubGroups = subGroups
+	if dg.compiled.minAvailable == "" {
+		metadata.MinAvailable = totalMinAvailable
+	}
+	return nil
+}
+
+func buildSubGroup(config *compiledSubGroupsConfig, item interface{}, topology string) (
+	*podgroup.SubGroupMetadata, error) {
+	name, found, err := config.name.stringValue(item)
+	if err != nil {
+		return nil, err
+	}
+	if !found || name == "" {
+		return nil, fmt.Errorf("expression %q did not result in a subgroup name", config.name)
+	}
+
+	minAvailable, found, err := config.minAvailable.int32Value(item)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("expression %q did not result in the minAvailable of subgroup %s",
+			config.minAvailable, name)
+	}
+
+	requiredTopologyLevel, _, err := config.requiredTopologyLevel.stringValue(item)
+	if err != nil {
+		return nil, err
+	}
+	preferredTopologyLevel, _, err := config.preferredTopologyLevel.stringValue(item)
+	if err != nil {
+		return nil, err
+	}
+
+	subGroup := &podgroup.SubGroupMetadata{
+		Name:           name,
+		MinAvailable:   minAvailable,
+		PodsReferences: []string{},
+	}
+	if requiredTopologyLevel != "" || preferredTopologyLevel != "" {
+		if topology == "" {
+			return nil, fmt.Errorf("subgroup %s has topology constraints but the pod group has no topology", name)
+		}
+		subGroup.TopologyConstraints = &podgroup.TopologyConstraintMetadata{
+			Topology:               topology,
+			RequiredTopologyLevel:  requiredTopologyLevel,
+			PreferredTopologyLevel: preferredTopologyLevel,
+		}
+	}
+	return subGroup, nil
+}
+
+type expressionTarget struct {
+	text   string
+	target *expression
+}
+
+func compileConfig(config *Config) (*compiledConfig, error) {
+	compiled := &compiledConfig{}
+	expressions := []expressionTarget{
+		{config.MinAvailable, &compiled.minAvailable},
+		{config.Queue, &compiled.queue},
+		{config.PriorityClassName, &compiled.priorityClassName},
+		{config.Preemptibility, &compiled.preemptibility},
+	}
+	if config.Topology != nil {
+		expressions = append(expressions, []expressionTarget{
+			{config.Topology.Topology, &compiled.topology},
+			{config.Topology.RequiredTopologyLevel, &compiled.requiredTopologyLevel},
+			{config.Topology.PreferredTopologyLevel, &compiled.preferredTopologyLevel},
+		}...)
+	}
+	if config.SubGroups != nil {
+		compiled.subGroups = &compiledSubGroupsConfig{podLabelKey: config.SubGroups.PodLabelKey}
+		expressions = append(expressions, []expressionTarget{
+			{config.SubGroups.List, &compiled.subGroups.list},
+			{config.SubGroups.Name, &compiled.subGroups.name},
+			{config.SubGroups.MinAvailable, &compiled.subGroups.minAvailable},
+			{config.SubGroups.RequiredTopologyLevel, &compiled.subGroups.requiredTopologyLevel},
+			{config.SubGroups.PreferredTopologyLevel, &compiled.subGroups.preferredTopologyLevel},
+		}...)
+	}
+
+	for _, e := range expressions {
+		var err error
+		if *e.target, err = newExpression(e.text); err != nil {
+			return nil, err
+		}
+	}
+	return compiled, nil
+}
@@ -0,0 +1,248 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/defaultgrouper"
+)
+
+const (
+	queueLabelKey    = "kai.scheduler/queue"
+	nodePoolLabelKey = "kai.scheduler/node-pool"
+	roleLabelKey     = "example.com/role"
+)
+
+const trainingRunConfig = `
+- group: example.com
+  version: "*"
+  kind: TrainingRun
+  queue: "{.spec.team}"
+  priorityClassName: "{.spec.priority}"
+  preemptibility: ".spec.preemptibility"
+  topology:
+    topology: "{.spec.placement.topology}"
+    requiredTopologyLevel: "{.spec.placement.required}"
+  subGroups:
+    list: "{.spec.roles[*]}"
+    name: "{.name}"
+    minAvailable: "{.replicas}"
+    preferredTopologyLevel: "{.pack}"
+    podLabelKey: example.com/role
+`
+
+func TestParseConfigs(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		data        string
+		expectedLen int
+		expectedErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:        "yaml",
+			data:        trainingRunConfig,
+			expectedLen: 1,
+		},
+		{
+			name:        "json",
+			data:        `[{"group":"example.com","version":"v1","kind":"Simulation","minAvailable":".spec.size"}]`,
+			expectedLen: 1,
+		},
+		{
+			name:        "missing kind",
+			data:        `[{"group":"example.com","version":"v1","minAvailable":".spec.size"}]`,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid expression",
+			data:        `[{"group":"example.com","version":"v1","kind":"Simulation","minAvailable":"{.spec.size"}]`,
+			expectedErr: true,
+		},
+		{
+			name: "subgroups without a pod label key",
+			data: `[{"group":"example.com","version":"v1","kind":"Simulation",
+				"subGroups":{"list":".spec.roles","name":".name","minAvailable":".replicas"}}]`,
+			expectedErr: true,
+		},
+		{
+			name: "duplicate gvk",
+			data: `[{"group":"example.com","version":"v1","kind":"Simulation"},
+				{"group":"example.com","version":"v1","kind":"Simulation"}]`,
+			expectedErr: true,
+		},
+		{
+			name:        "unknown field",
+			data:        `[{"group":"example.com","version":"v1","kind":"Simulation","minMembers":".spec.size"}]`,
+			expectedErr: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			configs, err := ParseConfigs(test.data)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, configs, test.expectedLen)
+		})
+	}
+}
+
+func TestGetPodGroupMetadata(t *testing.T) {
+	grouper := newTestGrouper(t, trainingRunConfig)
+	topOwner := trainingRun()
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "run-worker-0", Namespace: "team-a", Labels: map[string]string{roleLabelKey: "worker"},
+	}}
+
+	metadata, err := grouper.GetPodGroupMetadata(topOwner, pod)
+	require.NoError(t, err)
+
+	assert.Equal(t, "pg-run-run-uid", metadata.Name)
+	assert.Equal(t, "team-a", metadata.Queue)
+	assert.Equal(t, "inference", metadata.PriorityClassName)
+	assert.Equal(t, v2alpha2.NonPreemptible, metadata.Preemptibility)
+	assert.Equal(t, "cluster-topology", metadata.Topology)
+	assert.Equal(t, "zone", metadata.RequiredTopologyLevel)
+	assert.Equal(t, int32(5), metadata.MinAvailable)
+	assert.Equal(t, []*podgroup.SubGroupMetadata{
+		{
+			Name:           "leader",
+			MinAvailable:   1,
+			PodsReferences: []string{},
+		},
+		{
+			Name:           "worker",
+			MinAvailable:   4,
+			PodsReferences: []string{"run-worker-0"},
+			TopologyConstraints: &podgroup.TopologyConstraintMetadata{
+				Topology:               "cluster-topology",
+				PreferredTopologyLevel: "rack",
+			},
+		},
+	}, metadata.SubGroups)
+}
+
+func TestGetPodGroupMetadata_MissingValuesKeepDefaults(t *testing.T) {
+	grouper := newTestGrouper(t, `
+- group: example.com
+  version: v1
+  kind: Simulation
+  minAvailable: "{.spec.size}"
+  queue: "{.spec.team}"
+`)
+	topOwner := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Simulation",
+		"metadata": map[string]interface{}{
+			"name": "sim", "namespace": "team-a", "uid": "sim-uid",
+			"labels": map[string]interface{}{queueLabelKey: "label-queue"},
+		},
+		"spec": map[string]interface{}{},
+	}}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "sim-0", Namespace: "team-a"}}
+
+	metadata, err := grouper.GetPodGroupMetadata(topOwner, pod)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), metadata.MinAvailable)
+	assert.Equal(t, "label-queue", metadata.Queue)
+	assert.Empty(t, metadata.SubGroups)
+}
+
+func TestGetPodGroupMetadata_Errors(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		config string
+		spec   map[string]interface{}
+	}{
+		{
+			name:   "min available is not an integer",
+			config: "- {group: example.com, version: v1, kind: Simulation, minAvailable: .spec.size}",
+			spec:   map[string]interface{}{"size": "large"},
+		},
+		{
+			name:   "invalid preemptibility",
+			config: "- {group: example.com, version: v1, kind: Simulation, preemptibility: .spec.preemptibility}",
+			spec:   map[string]interface{}{"preemptibility": "sometimes"},
+		},
+		{
+			name: "subgroup without a name",
+			config: "- {group: example.com, version: v1, kind: Simulation, subGroups: " +
+				"{list: .spec.roles, name: .name, minAvailable: .replicas, podLabelKey: role}}",
+			spec: map[string]interface{}{"roles": []interface{}{map[string]interface{}{"replicas": int64(1)}}},
+		},
+		{
+			name: "subgroup topology without a pod group topology",
+			config: "- {group: example.com, version: v1, kind: Simulation, subGroups: " +
+				"{list: .spec.roles, name: .name, minAvailable: .replicas, requiredTopologyLevel: .pack, " +
+				"podLabelKey: role}}",
+			spec: map[string]interface{}{"roles": []interface{}{
+				map[string]interface{}{"name": "worker", "replicas": int64(1), "pack": "rack"}}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			grouper := newTestGrouper(t, test.config)
+			topOwner := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Simulation",
+				"metadata":   map[string]interface{}{"name": "sim", "namespace": "team-a", "uid": "sim-uid"},
+				"spec":       test.spec,
+			}}
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "sim-0", Namespace: "team-a"}}
+
+			_, err := grouper.GetPodGroupMetadata(topOwner, pod)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func newTestGrouper(t *testing.T, data string) *DeclarativeGrouper {
+	configs, err := ParseConfigs(data)
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+
+	kubeClient := fake.NewFakeClient()
+	grouper, err := NewDeclarativeGrouper(configs[0],
+		defaultgrouper.NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, kubeClient))
+	require.NoError(t, err)
+	return grouper
+}
+
+func trainingRun() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1beta1",
+		"kind":       "TrainingRun",
+		"metadata": map[string]interface{}{
+			"name":      "run",
+			"namespace": "team-a",
+			"uid":       "run-uid",
+		},
+		"spec": map[string]interface{}{
+			"team":           "team-a",
+			"priority":       "inference",
+			"preemptibility": "non-preemptible",
+			"placement": map[string]interface{}{
+				"topology": "cluster-topology",
+				"required": "zone",
+			},
+			"roles": []interface{}{
+				map[string]interface{}{"name": "leader", "replicas": int64(1)},
+				map[string]interface{}{"name": "worker", "replicas": int64(4), "pack": "rack"},
+			},
+		},
+	}}
+}
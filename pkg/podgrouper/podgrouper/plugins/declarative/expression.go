@@ -0,0 +1,100 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package declarative
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// expression is a JSONPath expression. A JSONPath template is not safe for concurrent use, so every evaluation
+// parses the expression again.
+type expression string
+
+func newExpression(text string) (expression, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", nil
+	}
+	if !strings.HasPrefix(text, "{") {
+		text = "{" + text + "}"
+	}
+	if _, err := parseExpression(text); err != nil {
+		return "", fmt.Errorf("invalid expression %q: %w", text, err)
+	}
+	return expression(text), nil
+}
+
+func parseExpression(text string) (*jsonpath.JSONPath, error) {
+	path := jsonpath.New("declarative-grouper").AllowMissingKeys(true)
+	if err := path.Parse(text); err != nil {
+		return nil, err
+	}
+	return path, nil
+}
+
+// values returns the values that the expression matches in data. A single match that is a list is expanded to
+// its items.
+func (e expression) values(data interface{}) ([]interface{}, error) {
+	if e == "" {
+		return nil, nil
+	}
+	path, err := parseExpression(string(e))
+	if err != nil {
+		return nil, err
+	}
+	results, err := path.FindResults(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate expression %q: %w", e, err)
+	}
+
+	var values []interface{}
+	for _, result := range results {
+		for _, value := range result {
+			if value.IsValid() && value.CanInterface() && value.Interface() != nil {
+				values = append(values, value.Interface())
+			}
+		}
+	}
+	if len(values) == 1 && reflect.ValueOf(values[0]).Kind() == reflect.Slice {
+		items := reflect.ValueOf(values[0])
+		values = make([]interface{}, 0, items.Len())
+		for i := 0; i < items.Len(); i++ {
+			values = append(values, items.Index(i).Interface())
+		}
+	}
+	return values, nil
+}
+
+// stringValue returns the single value that the expression matches in data, and whether it matched anything
+func (e expression) stringValue(data interface{}) (string, bool, error) {
+	values, err := e.values(data)
+	if err != nil {
+		return "", false, err
+	}
+	switch len(values) {
+	case 0:
+		return "", false, nil
+	case 1:
+		return fmt.Sprint(values[0]), true, nil
+	default:
+		return "", false, fmt.Errorf("expression %q matched %d values instead of one", e, len(values))
+	}
+}
+
+func (e expression) int32Value(data interface{}) (int32, bool, error) {
+	value, found, err := e.stringValue(data)
+	if err != nil || !found {
+		return 0, found, err
+	}
+	number, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, false, fmt.Errorf("expression %q resulted in %q, which is not an integer", e, value)
+	}
+	return int32(number), true, nil
+}
@@ -33,6 +33,8 @@ var (
 type DefaultGrouper struct {
 	queueLabelKey    string
 	nodePoolLabelKey string
+	// kueueQueueNameMapping maps Kueue's queue-name label to the KAI queue of the same name
+	kueueQueueNameMapping bool
 
 	// default config per type - includes the default priority class name and preemptibility per workload type
 	defaultConfigPerTypeConfigMapName      string
@@ -53,6 +55,10 @@ func (dg *DefaultGrouper) SetDefaultConfigPerTypeConfigMapParams(defaultConfigPe
 	dg.defaultConfigPerTypeConfigMapNamespace = defaultConfigPerTypeConfigMapNamespace
 }
 
+func (dg *DefaultGrouper) SetKueueQueueNameMapping(kueueQueueNameMapping bool) {
+	dg.kueueQueueNameMapping = kueueQueueNameMapping
+}
+
 func (dg *DefaultGrouper) Name() string {
 	return "Default Grouper"
 }
@@ -87,6 +93,8 @@ func (dg *DefaultGrouper) GetPodGroupMetadata(topOwner *unstructured.Unstructure
 	podGroupMetadata.PreferredTopologyLevel = annotations["kai.scheduler/topology-preferred-placement"]
 	podGroupMetadata.RequiredTopologyLevel = annotations["kai.scheduler/topology-required-placement"]
 	podGroupMetadata.Topology = annotations["kai.scheduler/topology"]
+	podGroupMetadata.GPUPlacementStrategy = annotations[constants.GPUPlacementStrategyKey]
+	podGroupMetadata.CPUPlacementStrategy = annotations[constants.CPUPlacementStrategyKey]
 
 	return &podGroupMetadata, nil
 }
@@ -132,9 +140,7 @@ func (dg *DefaultGrouper) CalcPodGroupLabels(topOwner *unstructured.Unstructured
 }
 
 func (dg *DefaultGrouper) CalcPodGroupQueue(topOwner *unstructured.Unstructured, pod *v1.Pod) string {
-	if queue, found := topOwner.GetLabels()[dg.queueLabelKey]; found {
-		return queue
-	} else if queue, found = pod.GetLabels()[dg.queueLabelKey]; found {
+	if queue, found := dg.CalcPodGroupQueueFromLabels(topOwner, pod); found {
 		return queue
 	}
 
@@ -146,6 +152,24 @@ func (dg *DefaultGrouper) CalcPodGroupQueue(topOwner *unstructured.Unstructured,
 	return constants.DefaultQueueName
 }
 
+// CalcPodGroupQueueFromLabels returns the queue that the top owner or the pod explicitly request with a label.
+// When the Kueue queue-name mapping is enabled, the queue label wins over Kueue's queue-name label, whose local queue
+// is mapped to the KAI queue of the same name.
+func (dg *DefaultGrouper) CalcPodGroupQueueFromLabels(topOwner *unstructured.Unstructured, pod *v1.Pod) (string, bool) {
+	labelKeys := []string{dg.queueLabelKey}
+	if dg.kueueQueueNameMapping {
+		labelKeys = append(labelKeys, constants.KueueQueueNameLabelKey)
+	}
+	for _, labelKey := range labelKeys {
+		if queue, found := topOwner.GetLabels()[labelKey]; found {
+			return queue, true
+		} else if queue, found = pod.GetLabels()[labelKey]; found {
+			return queue, true
+		}
+	}
+	return "", false
+}
+
 func (dg *DefaultGrouper) calculateQueueName(topOwner *unstructured.Unstructured, pod *v1.Pod) string {
 	project := ""
 	if projectLabel, found := topOwner.GetLabels()[constants.ProjectLabelKey]; found {
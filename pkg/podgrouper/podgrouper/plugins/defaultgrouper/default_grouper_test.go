@@ -204,6 +204,71 @@ func TestGetPodGroupMetadataOnQueueFromPod(t *testing.T) {
 	assert.Equal(t, "my-queue", podGroupMetadata.Queue)
 }
 
+func TestGetPodGroupMetadataOnQueueFromKueueLabel(t *testing.T) {
+	tests := []struct {
+		name          string
+		ownerLabels   map[string]interface{}
+		podLabels     map[string]string
+		disableKueue  bool
+		expectedQueue string
+	}{
+		{
+			name:          "kueue label on owner",
+			ownerLabels:   map[string]interface{}{constants.KueueQueueNameLabelKey: "team-a"},
+			expectedQueue: "team-a",
+		},
+		{
+			name:          "kueue label on pod",
+			podLabels:     map[string]string{constants.KueueQueueNameLabelKey: "team-b"},
+			expectedQueue: "team-b",
+		},
+		{
+			name:          "queue label wins over kueue label",
+			ownerLabels:   map[string]interface{}{constants.KueueQueueNameLabelKey: "team-a"},
+			podLabels:     map[string]string{queueLabelKey: "my-queue"},
+			expectedQueue: "my-queue",
+		},
+		{
+			name: "kueue label wins over project",
+			ownerLabels: map[string]interface{}{
+				constants.KueueQueueNameLabelKey: "team-a",
+				constants.ProjectLabelKey:        "my-proj",
+			},
+			expectedQueue: "team-a",
+		},
+		{
+			name:          "kueue label is ignored without the mapping",
+			ownerLabels:   map[string]interface{}{constants.KueueQueueNameLabelKey: "team-a"},
+			disableKueue:  true,
+			expectedQueue: constants.DefaultQueueName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind":       "Job",
+					"apiVersion": "batch/v1",
+					"metadata": map[string]interface{}{
+						"name":      "test_name",
+						"namespace": "test_namespace",
+						"uid":       "1",
+						"labels":    tt.ownerLabels,
+					},
+				},
+			}
+			pod := &v1.Pod{ObjectMeta: v12.ObjectMeta{Labels: tt.podLabels}}
+
+			defaultGrouper := NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, fake.NewFakeClient())
+			defaultGrouper.SetKueueQueueNameMapping(!tt.disableKueue)
+			podGroupMetadata, err := defaultGrouper.GetPodGroupMetadata(owner, pod, convertOwnerToPartial(owner))
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedQueue, podGroupMetadata.Queue)
+		})
+	}
+}
+
 func TestGetPodGroupMetadataOnPriorityClassFromOwner(t *testing.T) {
 	myPriorityClass := priorityClassObj("my-priority", 1000)
 	kubeClient := fake.NewFakeClient(myPriorityClass)
@@ -907,6 +972,32 @@ func TestGetPodGroupMetadataWithTopology(t *testing.T) {
 	assert.Equal(t, "network", podGroupMetadata.Topology)
 }
 
+func TestGetPodGroupMetadataWithPlacementStrategy(t *testing.T) {
+	owner := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind":       "test_kind",
+			"apiVersion": "test_version",
+			"metadata": map[string]interface{}{
+				"name":      "test_name",
+				"namespace": "test_namespace",
+				"uid":       "1",
+				"annotations": map[string]interface{}{
+					"kai.scheduler/gpu-placement-strategy": "spread",
+					"kai.scheduler/cpu-placement-strategy": "binpack",
+				},
+			},
+		},
+	}
+	pod := &v1.Pod{}
+
+	defaultGrouper := NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, fake.NewFakeClient())
+	podGroupMetadata, err := defaultGrouper.GetPodGroupMetadata(owner, pod, convertOwnerToPartial(owner))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "spread", podGroupMetadata.GPUPlacementStrategy)
+	assert.Equal(t, "binpack", podGroupMetadata.CPUPlacementStrategy)
+}
+
 // TestCalcPodGroupPriorityClass_NonExistentDefaultFromConfigMap tests when default priority class from configmap doesn't exist
 func TestCalcPodGroupPriorityClass_NonExistentDefaultFromConfigMap(t *testing.T) {
 	defaultsConfigmap := &v1.ConfigMap{
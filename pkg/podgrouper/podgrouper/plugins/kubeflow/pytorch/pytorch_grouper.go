@@ -54,6 +54,11 @@ func (ptg *PyTorchGrouper) GetPodGroupMetadata(
 		podGroupMetadata.MinAvailable = int32(minReplicas)
 	}
 
+	maxReplicas, err := getMaxReplicas(topOwner)
+	if err == nil {
+		podGroupMetadata.MaxAvailable = ptr.To(int32(maxReplicas))
+	}
+
 	minAvailable, err := getMinAvailable(topOwner)
 	if err == nil {
 		podGroupMetadata.MinAvailable = int32(minAvailable)
@@ -303,6 +308,17 @@ func getMinReplicas(topOwner *unstructured.Unstructured) (int64, error) {
 	return minReplicas, nil
 }
 
+func getMaxReplicas(topOwner *unstructured.Unstructured) (int64, error) {
+	maxReplicas, found, err := unstructured.NestedInt64(topOwner.Object, "spec", "elasticPolicy", "maxReplicas")
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("maxReplicas not found in PyTorchJob %s/%s", topOwner.GetNamespace(), topOwner.GetName())
+	}
+	return maxReplicas, nil
+}
+
 func getMinAvailable(topOwner *unstructured.Unstructured) (int64, error) {
 	minReplicas, found, err := unstructured.NestedInt64(topOwner.Object, "spec", "runPolicy", "schedulingPolicy", "minAvailable")
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
@@ -21,6 +22,7 @@ import (
 const (
 	minReplicasNum    = 66
 	minAvailableNum   = 33
+	maxReplicasNum    = 99
 	workerReplicasNum = 4
 	masterReplicasNum = 2
 	queueLabelKey     = "kai.scheduler/queue"
@@ -54,6 +56,21 @@ func TestGetPodGroupMetadata_OnlyMinReplicas(t *testing.T) {
 	assert.EqualValues(t, minReplicasNum, metadata.MinAvailable)
 }
 
+func TestGetPodGroupMetadata_MaxReplicas(t *testing.T) {
+	pytorchJob := getBasicPytorchJob()
+	err := unstructured.SetNestedField(pytorchJob.Object, int64(minReplicasNum), "spec", "elasticPolicy", "minReplicas")
+	assert.Nil(t, err, "Got error when setting minReplicas for pytorch job")
+	err = unstructured.SetNestedField(pytorchJob.Object, int64(maxReplicasNum), "spec", "elasticPolicy", "maxReplicas")
+	assert.Nil(t, err, "Got error when setting maxReplicas for pytorch job")
+
+	pod := &v1.Pod{}
+	grouper := newTestPyTorchGrouper()
+	metadata, err := grouper.GetPodGroupMetadata(pytorchJob, pod)
+	assert.Nil(t, err, "Got error when getting pytorch pod group metadata")
+	assert.EqualValues(t, minReplicasNum, metadata.MinAvailable)
+	assert.EqualValues(t, ptr.To(int32(maxReplicasNum)), metadata.MaxAvailable)
+}
+
 func TestGetPodGroupMetadata_OnlyMinAvailable(t *testing.T) {
 	pytorchJob := getBasicPytorchJob()
 
@@ -0,0 +1,231 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package volcano
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/defaultgrouper"
+)
+
+const (
+	// Volcano pod labels and defaults (duplicated to avoid importing the Volcano project).
+	labelKeyTaskSpec    = "volcano.sh/task-spec"
+	defaultTaskPrefix   = "default"
+	volcanoDefaultQueue = "default"
+)
+
+var logger = log.FromContext(context.Background())
+
+// VolcanoJobGrouper creates PodGroups for Volcano Jobs (batch.volcano.sh/Job):
+//   - Every task becomes a subgroup with the task's minAvailable. When the job has no spec.minAvailable, a task
+//     without minAvailable needs all its replicas, like in Volcano
+//   - When the job has spec.minAvailable and none of its tasks has minAvailable, no subgroups are created
+//   - MinAvailable: spec.minAvailable, or the sum of the tasks' minAvailable when it is not set
+//   - Queue: spec.queue, unless the job or the pod has a queue label. Volcano's "default" queue maps to the
+//     default KAI queue
+//   - PriorityClassName: spec.priorityClassName, unless the job has a priorityClassName label
+//
+// The job's lifecycle policies are left to the Volcano job controller.
+type VolcanoJobGrouper struct {
+	*defaultgrouper.DefaultGrouper
+}
+
+func NewVolcanoJobGrouper(defaultGrouper *defaultgrouper.DefaultGrouper) *VolcanoJobGrouper {
+	return &VolcanoJobGrouper{
+		DefaultGrouper: defaultGrouper,
+	}
+}
+
+func (g *VolcanoJobGrouper) Name() string {
+	return "Volcano Job Grouper"
+}
+
+// +kubebuilder:rbac:groups=batch.volcano.sh,resources=jobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch.volcano.sh,resources=jobs/finalizers,verbs=patch;update;create
+
+func (g *VolcanoJobGrouper) GetPodGroupMetadata(
+	topOwner *unstructured.Unstructured, pod *v1.Pod, allOwners ...*metav1.PartialObjectMetadata,
+) (*podgroup.Metadata, error) {
+	metadata, err := g.DefaultGrouper.GetPodGroupMetadata(topOwner, pod, allOwners...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = g.applyQueue(topOwner, pod, metadata); err != nil {
+		return nil, err
+	}
+	if err = applyPriorityClassName(topOwner, metadata); err != nil {
+		return nil, err
+	}
+
+	minAvailable, found, err := unstructured.NestedInt64(topOwner.Object, "spec", "minAvailable")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec.minAvailable from Volcano Job %s/%s: %w",
+			topOwner.GetNamespace(), topOwner.GetName(), err)
+	}
+	if minAvailable > math.MaxInt32 {
+		return nil, fmt.Errorf("spec.minAvailable of Volcano Job %s/%s exceeds int32 max value",
+			topOwner.GetNamespace(), topOwner.GetName())
+	}
+	jobHasMinAvailable := found && minAvailable > 0
+
+	subGroups, tasksMinAvailable, err := parseTaskSubGroups(topOwner, jobHasMinAvailable)
+	if err != nil {
+		return nil, err
+	}
+	assignPodToTaskSubGroup(pod, subGroups)
+	metadata.SubGroups = subGroups
+
+	if jobHasMinAvailable {
+		metadata.MinAvailable = int32(minAvailable)
+	} else if tasksMinAvailable > 0 {
+		metadata.MinAvailable = tasksMinAvailable
+	}
+
+	return metadata, nil
+}
+
+func (g *VolcanoJobGrouper) applyQueue(topOwner *unstructured.Unstructured, pod *v1.Pod,
+	metadata *podgroup.Metadata) error {
+	if _, found := g.CalcPodGroupQueueFromLabels(topOwner, pod); found {
+		return nil
+	}
+
+	queue, found, err := unstructured.NestedString(topOwner.Object, "spec", "queue")
+	if err != nil {
+		return fmt.Errorf("failed to read spec.queue from Volcano Job %s/%s: %w",
+			topOwner.GetNamespace(), topOwner.GetName(), err)
+	}
+	if !found || queue == "" {
+		return nil
+	}
+	if queue == volcanoDefaultQueue {
+		queue = constants.DefaultQueueName
+	}
+	metadata.Queue = queue
+	return nil
+}
+
+func applyPriorityClassName(topOwner *unstructured.Unstructured, metadata *podgroup.Metadata) error {
+	if _, found := topOwner.GetLabels()[constants.PriorityLabelKey]; found {
+		return nil
+	}
+
+	priorityClassName, found, err := unstructured.NestedString(topOwner.Object, "spec", "priorityClassName")
+	if err != nil {
+		return fmt.Errorf("failed to read spec.priorityClassName from Volcano Job %s/%s: %w",
+			topOwner.GetNamespace(), topOwner.GetName(), err)
+	}
+	if found && priorityClassName != "" {
+		metadata.PriorityClassName = priorityClassName
+	}
+	return nil
+}
+
+// parseTaskSubGroups returns a subgroup per task of the Volcano Job, and the sum of their minAvailable
+func parseTaskSubGroups(topOwner *unstructured.Unstructured, jobHasMinAvailable bool) (
+	[]*podgroup.SubGroupMetadata, int32, error) {
+	tasks, found, err := unstructured.NestedSlice(topOwner.Object, "spec", "tasks")
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read spec.tasks from Volcano Job %s/%s: %w",
+			topOwner.GetNamespace(), topOwner.GetName(), err)
+	}
+	if !found {
+		return nil, 0, nil
+	}
+
+	var subGroups []*podgroup.SubGroupMetadata
+	var totalMinAvailable int64
+	anyTaskHasMinAvailable := false
+	for index, taskRaw := range tasks {
+		task, ok := taskRaw.(map[string]interface{})
+		if !ok {
+			return nil, 0, fmt.Errorf("invalid structure of spec.tasks[%d] in Volcano Job %s/%s",
+				index, topOwner.GetNamespace(), topOwner.GetName())
+		}
+
+		if _, found := task["minAvailable"]; found {
+			anyTaskHasMinAvailable = true
+		}
+		subGroup, err := parseTaskSubGroup(task, index, jobHasMinAvailable)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to parse spec.tasks[%d] of Volcano Job %s/%s: %w",
+				index, topOwner.GetNamespace(), topOwner.GetName(), err)
+		}
+		subGroups = append(subGroups, subGroup)
+		totalMinAvailable += int64(subGroup.MinAvailable)
+	}
+	if jobHasMinAvailable && !anyTaskHasMinAvailable {
+		return nil, 0, nil
+	}
+	if totalMinAvailable > math.MaxInt32 {
+		return nil, 0, fmt.Errorf("minAvailable of the tasks of Volcano Job %s/%s exceeds int32 max value",
+			topOwner.GetNamespace(), topOwner.GetName())
+	}
+	return subGroups, int32(totalMinAvailable), nil
+}
+
+func parseTaskSubGroup(task map[string]interface{}, index int, jobHasMinAvailable bool) (
+	*podgroup.SubGroupMetadata, error) {
+	name, _, err := unstructured.NestedString(task, "name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read name: %w", err)
+	}
+	if name == "" {
+		// Volcano names unnamed tasks by their index
+		name = defaultTaskPrefix + strconv.Itoa(index)
+	}
+
+	replicas, _, err := unstructured.NestedInt64(task, "replicas")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read replicas: %w", err)
+	}
+	minAvailable, found, err := unstructured.NestedInt64(task, "minAvailable")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read minAvailable: %w", err)
+	}
+	if !found && !jobHasMinAvailable {
+		minAvailable = replicas
+	}
+	if minAvailable < 0 || minAvailable > math.MaxInt32 {
+		return nil, fmt.Errorf("invalid minAvailable %d of task %s", minAvailable, name)
+	}
+
+	return &podgroup.SubGroupMetadata{
+		Name:           name,
+		MinAvailable:   int32(minAvailable),
+		PodsReferences: []string{},
+	}, nil
+}
+
+func assignPodToTaskSubGroup(pod *v1.Pod, subGroups []*podgroup.SubGroupMetadata) {
+	taskName, found := pod.Labels[labelKeyTaskSpec]
+	if !found {
+		taskName, found = pod.Annotations[labelKeyTaskSpec]
+	}
+	if !found {
+		logger.V(1).Info("Volcano task label not found on pod", "pod", pod.Name, "namespace", pod.Namespace)
+		return
+	}
+
+	for _, subGroup := range subGroups {
+		if subGroup.Name == taskName {
+			subGroup.PodsReferences = append(subGroup.PodsReferences, pod.Name)
+			return
+		}
+	}
+	logger.V(1).Info("Volcano task of pod not found in job", "pod", pod.Name, "namespace", pod.Namespace,
+		"task", taskName)
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package volcano
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/defaultgrouper"
+)
+
+const (
+	queueLabelKey    = "kai.scheduler/queue"
+	nodePoolLabelKey = "kai.scheduler/node-pool"
+)
+
+func TestGetPodGroupMetadata(t *testing.T) {
+	tests := []struct {
+		name                 string
+		spec                 map[string]interface{}
+		labels               map[string]interface{}
+		podLabels            map[string]string
+		expectedQueue        string
+		expectedPriority     string
+		expectedMinAvailable int32
+		expectedSubGroups    []*podgroup.SubGroupMetadata
+	}{
+		{
+			name: "tasks without min available need all replicas",
+			spec: map[string]interface{}{
+				"queue":             "research",
+				"priorityClassName": "inference",
+				"tasks": []interface{}{
+					map[string]interface{}{"name": "ps", "replicas": int64(1)},
+					map[string]interface{}{"name": "worker", "replicas": int64(4)},
+				},
+			},
+			podLabels:            map[string]string{labelKeyTaskSpec: "worker"},
+			expectedQueue:        "research",
+			expectedPriority:     "inference",
+			expectedMinAvailable: 5,
+			expectedSubGroups: []*podgroup.SubGroupMetadata{
+				{Name: "ps", MinAvailable: 1, PodsReferences: []string{}},
+				{Name: "worker", MinAvailable: 4, PodsReferences: []string{"job-worker-0"}},
+			},
+		},
+		{
+			name: "per task min available",
+			spec: map[string]interface{}{
+				"minAvailable": int64(3),
+				"tasks": []interface{}{
+					map[string]interface{}{"name": "ps", "replicas": int64(1), "minAvailable": int64(1)},
+					map[string]interface{}{"name": "worker", "replicas": int64(4), "minAvailable": int64(2)},
+				},
+			},
+			podLabels:            map[string]string{labelKeyTaskSpec: "ps"},
+			expectedQueue:        constants.DefaultQueueName,
+			expectedPriority:     constants.TrainPriorityClass,
+			expectedMinAvailable: 3,
+			expectedSubGroups: []*podgroup.SubGroupMetadata{
+				{Name: "ps", MinAvailable: 1, PodsReferences: []string{"job-worker-0"}},
+				{Name: "worker", MinAvailable: 2, PodsReferences: []string{}},
+			},
+		},
+		{
+			name: "job min available without per task min available",
+			spec: map[string]interface{}{
+				"minAvailable": int64(2),
+				"tasks": []interface{}{
+					map[string]interface{}{"name": "worker", "replicas": int64(4)},
+				},
+			},
+			podLabels:            map[string]string{labelKeyTaskSpec: "worker"},
+			expectedQueue:        constants.DefaultQueueName,
+			expectedPriority:     constants.TrainPriorityClass,
+			expectedMinAvailable: 2,
+		},
+		{
+			name: "unnamed tasks and the default volcano queue",
+			spec: map[string]interface{}{
+				"queue": "default",
+				"tasks": []interface{}{
+					map[string]interface{}{"replicas": int64(2)},
+				},
+			},
+			podLabels:            map[string]string{labelKeyTaskSpec: "default0"},
+			expectedQueue:        constants.DefaultQueueName,
+			expectedPriority:     constants.TrainPriorityClass,
+			expectedMinAvailable: 2,
+			expectedSubGroups: []*podgroup.SubGroupMetadata{
+				{Name: "default0", MinAvailable: 2, PodsReferences: []string{"job-worker-0"}},
+			},
+		},
+		{
+			name: "labels win over the job spec",
+			spec: map[string]interface{}{
+				"queue":             "research",
+				"priorityClassName": "inference",
+				"tasks": []interface{}{
+					map[string]interface{}{"name": "worker", "replicas": int64(1)},
+				},
+			},
+			labels: map[string]interface{}{
+				queueLabelKey:              "team-a",
+				constants.PriorityLabelKey: "build",
+			},
+			expectedQueue:        "team-a",
+			expectedPriority:     constants.TrainPriorityClass,
+			expectedMinAvailable: 1,
+			expectedSubGroups: []*podgroup.SubGroupMetadata{
+				{Name: "worker", MinAvailable: 1, PodsReferences: []string{}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topOwner := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "batch.volcano.sh/v1alpha1",
+				"kind":       "Job",
+				"metadata": map[string]interface{}{
+					"name":      "job",
+					"namespace": "team-a",
+					"uid":       "job-uid",
+					"labels":    tt.labels,
+				},
+				"spec": tt.spec,
+			}}
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "job-worker-0", Namespace: "team-a",
+				Labels: tt.podLabels}}
+
+			grouper := NewVolcanoJobGrouper(
+				defaultgrouper.NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, fake.NewFakeClient()))
+			metadata, err := grouper.GetPodGroupMetadata(topOwner, pod)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedQueue, metadata.Queue)
+			assert.Equal(t, tt.expectedPriority, metadata.PriorityClassName)
+			assert.Equal(t, tt.expectedMinAvailable, metadata.MinAvailable)
+			assert.Equal(t, tt.expectedSubGroups, metadata.SubGroups)
+		})
+	}
+}
+
+func TestGetPodGroupMetadata_InvalidTasks(t *testing.T) {
+	topOwner := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch.volcano.sh/v1alpha1",
+		"kind":       "Job",
+		"metadata":   map[string]interface{}{"name": "job", "namespace": "team-a", "uid": "job-uid"},
+		"spec": map[string]interface{}{
+			"tasks": []interface{}{
+				map[string]interface{}{"name": "worker", "replicas": "four"},
+			},
+		},
+	}}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "job-worker-0", Namespace: "team-a"}}
+
+	grouper := NewVolcanoJobGrouper(
+		defaultgrouper.NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, fake.NewFakeClient()))
+	_, err := grouper.GetPodGroupMetadata(topOwner, pod)
+	assert.Error(t, err)
+}
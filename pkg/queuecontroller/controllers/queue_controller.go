@@ -6,6 +6,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -75,6 +76,11 @@ func (r *QueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, fmt.Errorf("failed to update child queues: %v", err)
 	}
 
+	now := time.Now()
+	resourceSchedules := queue.Spec.ParseResourceSchedules()
+	resource_updater.SetEffectiveResources(queue, resourceSchedules, now)
+	resource_updater.UpdateBudgetStatus(queue, originalQueue.Status.Allocated, now)
+
 	err = r.Client.Status().Patch(ctx, queue, client.MergeFrom(originalQueue))
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to patch status for queue %s, error: %v", queue.Name, err)
@@ -82,7 +88,18 @@ func (r *QueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 	metrics.SetQueueMetrics(queue)
 
-	return ctrl.Result{}, err
+	result := ctrl.Result{}
+	// Requeue when a resource schedule starts or ends, to keep the effective resources in the status up to date
+	if nextTransition, found := resourceSchedules.NextTransition(now); found {
+		result.RequeueAfter = nextTransition.Sub(now)
+	}
+	// Requeue when the budget runs out or renews, to keep the remaining budget in the status up to date
+	if nextBudgetUpdate, found := resource_updater.NextBudgetUpdate(queue, now); found {
+		if result.RequeueAfter == 0 || nextBudgetUpdate.Sub(now) < result.RequeueAfter {
+			result.RequeueAfter = nextBudgetUpdate.Sub(now)
+		}
+	}
+	return result, err
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -0,0 +1,84 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_updater
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+const gpuResourceNameSuffix = "/gpu"
+
+// UpdateBudgetStatus accounts the GPU-hours that the queue consumed since the budget status was last updated. The
+// GPUs in previousAllocated are the queue's allocation since then, as allocations only change between reconciles.
+func UpdateBudgetStatus(queue *v2.Queue, previousAllocated v1.ResourceList, now time.Time) {
+	budget := queue.Spec.Budget
+	if budget == nil {
+		queue.Status.Budget = nil
+		return
+	}
+	periodStart, err := budget.PeriodStart(now)
+	if err != nil {
+		return
+	}
+
+	status := queue.Status.Budget
+	if status == nil {
+		// Nothing was accounted yet, start accounting from now
+		status = &v2.QueueBudgetStatus{PeriodStart: metav1.NewTime(periodStart), LastUpdateTime: metav1.NewTime(now)}
+	}
+	if !status.PeriodStart.Time.Equal(periodStart) {
+		status.PeriodStart = metav1.NewTime(periodStart)
+		status.ConsumedGPUHours = 0
+	}
+
+	accountedSince := status.LastUpdateTime.Time
+	if accountedSince.Before(periodStart) {
+		accountedSince = periodStart
+	}
+	if now.After(accountedSince) {
+		status.ConsumedGPUHours += getGpus(previousAllocated) * now.Sub(accountedSince).Hours()
+	}
+	status.LastUpdateTime = metav1.NewTime(now)
+	status.RemainingGPUHours = math.Max(0, budget.GPUHours-status.ConsumedGPUHours)
+	queue.Status.Budget = status
+}
+
+// NextBudgetUpdate returns the time at which the queue's budget should be accounted again: when the queue exhausts
+// its budget at its current allocation, or when the next budget period starts.
+func NextBudgetUpdate(queue *v2.Queue, now time.Time) (time.Time, bool) {
+	if queue.Spec.Budget == nil || queue.Status.Budget == nil {
+		return time.Time{}, false
+	}
+	next, err := queue.Spec.Budget.NextPeriodStart(now)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	remaining := queue.Status.Budget.RemainingGPUHours
+	if gpus := getGpus(queue.Status.Allocated); gpus > 0 && remaining > 0 {
+		// Round up, so that the budget is exhausted by the time the queue is reconciled
+		exhaustion := now.Add(time.Duration(remaining / gpus * float64(time.Hour))).Truncate(time.Second).Add(time.Second)
+		if exhaustion.Before(next) {
+			next = exhaustion
+		}
+	}
+	return next, true
+}
+
+func getGpus(resources v1.ResourceList) float64 {
+	gpus := float64(0)
+	for resourceName, quantity := range resources {
+		if strings.HasSuffix(string(resourceName), gpuResourceNameSuffix) {
+			gpus += quantity.AsApproximateFloat64()
+		}
+	}
+	return gpus
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_updater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+func TestUpdateBudgetStatus(t *testing.T) {
+	periodStart := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	fourGpus := v1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}
+	queue := &v2.Queue{
+		Spec: v2.QueueSpec{
+			Budget: &v2.QueueBudget{GPUHours: 10},
+		},
+	}
+
+	// The first update starts the accounting
+	UpdateBudgetStatus(queue, fourGpus, periodStart.Add(time.Hour))
+	assert.True(t, periodStart.Equal(queue.Status.Budget.PeriodStart.Time))
+	assert.Equal(t, float64(0), queue.Status.Budget.ConsumedGPUHours)
+	assert.Equal(t, float64(10), queue.Status.Budget.RemainingGPUHours)
+
+	UpdateBudgetStatus(queue, fourGpus, periodStart.Add(2*time.Hour))
+	assert.Equal(t, float64(4), queue.Status.Budget.ConsumedGPUHours)
+	assert.Equal(t, float64(6), queue.Status.Budget.RemainingGPUHours)
+	assert.False(t, queue.BudgetExhausted(periodStart.Add(2*time.Hour)))
+
+	UpdateBudgetStatus(queue, fourGpus, periodStart.Add(4*time.Hour))
+	assert.Equal(t, float64(12), queue.Status.Budget.ConsumedGPUHours)
+	assert.Equal(t, float64(0), queue.Status.Budget.RemainingGPUHours)
+	assert.True(t, queue.BudgetExhausted(periodStart.Add(4*time.Hour)))
+
+	// Only the consumption since the start of the next period is accounted for it
+	nextPeriodStart := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	assert.False(t, queue.BudgetExhausted(nextPeriodStart))
+	UpdateBudgetStatus(queue, v1.ResourceList{"nvidia.com/gpu": resource.MustParse("0.5")},
+		nextPeriodStart.Add(2*time.Hour))
+	assert.True(t, nextPeriodStart.Equal(queue.Status.Budget.PeriodStart.Time))
+	assert.Equal(t, float64(1), queue.Status.Budget.ConsumedGPUHours)
+	assert.Equal(t, float64(9), queue.Status.Budget.RemainingGPUHours)
+
+	queue.Spec.Budget = nil
+	UpdateBudgetStatus(queue, fourGpus, nextPeriodStart.Add(3*time.Hour))
+	assert.Nil(t, queue.Status.Budget)
+}
+
+func TestNextBudgetUpdate(t *testing.T) {
+	now := time.Date(2025, 6, 30, 20, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		allocated v1.ResourceList
+		remaining float64
+		expected  time.Time
+	}{
+		{
+			name:      "budget runs out before the next period",
+			allocated: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+			remaining: 3,
+			expected:  now.Add(90*time.Minute + time.Second),
+		},
+		{
+			name:      "next period starts before the budget runs out",
+			allocated: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+			remaining: 100,
+			expected:  time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "no allocated gpus",
+			allocated: v1.ResourceList{},
+			remaining: 3,
+			expected:  time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &v2.Queue{
+				Spec: v2.QueueSpec{Budget: &v2.QueueBudget{GPUHours: 100}},
+				Status: v2.QueueStatus{
+					Allocated: tt.allocated,
+					Budget:    &v2.QueueBudgetStatus{RemainingGPUHours: tt.remaining},
+				},
+			}
+			next, found := NextBudgetUpdate(queue, now)
+			assert.True(t, found)
+			assert.True(t, tt.expected.Equal(next), "expected %v, got %v", tt.expected, next)
+		})
+	}
+}
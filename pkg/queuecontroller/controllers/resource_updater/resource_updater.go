@@ -6,6 +6,7 @@ package resource_updater
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -76,3 +77,14 @@ func (ru *ResourceUpdater) sumPodGroupsResources(ctx context.Context, queue *v2.
 
 	return nil
 }
+
+// SetEffectiveResources sets the queue's resources that are in effect at the given time, and the resource schedule
+// they come from, in the queue's status.
+func SetEffectiveResources(queue *v2.Queue, schedules v2.ResourceSchedules, now time.Time) {
+	queue.Status.ActiveResourceSchedule = ""
+	queue.Status.EffectiveResources = queue.Spec.Resources.DeepCopy()
+	if schedule := schedules.Active(now); schedule != nil {
+		queue.Status.ActiveResourceSchedule = schedule.Name
+		queue.Status.EffectiveResources = schedule.Resources.DeepCopy()
+	}
+}
@@ -6,6 +6,7 @@ package resource_updater
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -299,6 +300,30 @@ func TestUpdateQueue_QueuesOnly(t *testing.T) {
 	assert.True(t, expectedMemory.Equal(queue.Status.Requested["memory"]))
 }
 
+func TestSetEffectiveResources(t *testing.T) {
+	queue := &v2.Queue{
+		ObjectMeta: v12.ObjectMeta{Name: "queue-name"},
+		Spec: v2.QueueSpec{
+			Resources: &v2.QueueResources{GPU: v2.QueueResource{Quota: 1, Limit: 2}},
+			ResourceSchedules: []v2.QueueResourceSchedule{
+				{
+					Name:      "nights",
+					Windows:   []v2.WeeklyWindow{{StartHour: 20, EndHour: 6}},
+					Resources: v2.QueueResources{GPU: v2.QueueResource{Quota: 4, Limit: 8}},
+				},
+			},
+		},
+	}
+
+	SetEffectiveResources(queue, queue.Spec.ParseResourceSchedules(), time.Date(2025, 6, 4, 22, 0, 0, 0, time.UTC))
+	assert.Equal(t, "nights", queue.Status.ActiveResourceSchedule)
+	assert.Equal(t, v2.QueueResource{Quota: 4, Limit: 8}, queue.Status.EffectiveResources.GPU)
+
+	SetEffectiveResources(queue, queue.Spec.ParseResourceSchedules(), time.Date(2025, 6, 4, 12, 0, 0, 0, time.UTC))
+	assert.Empty(t, queue.Status.ActiveResourceSchedule)
+	assert.Equal(t, v2.QueueResource{Quota: 1, Limit: 2}, queue.Status.EffectiveResources.GPU)
+}
+
 func newFakeClientBuilder(t *testing.T) *fake.ClientBuilder {
 	return fake.NewClientBuilder().
 		WithScheme(newTestScheme(t)).
@@ -38,6 +38,8 @@ var (
 	queueAllocatedCpus   *prometheus.GaugeVec
 	queueAllocatedMemory *prometheus.GaugeVec
 
+	queueBudgetRemainingGpuHours *prometheus.GaugeVec
+
 	additionalQueueLabelKeys       []string
 	queueLabelToDefaultMetricValue map[string]string
 )
@@ -135,8 +137,16 @@ func InitMetrics(namespace string, queueLabelToMetricLabelMap, queueLabelToDefau
 		}, queueMetricsLabels,
 	)
 
+	queueBudgetRemainingGpuHours = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "queue_budget_remaining_gpu_hours",
+			Help:      "Queue GPU-hours left in the current budget period",
+		}, queueMetricsLabels,
+	)
+
 	metrics.Registry.MustRegister(queueInfo, queueDeservedGPUs, queueQuotaCPU, queueQuotaMemory,
-		queueAllocatedGpus, queueAllocatedCpus, queueAllocatedMemory)
+		queueAllocatedGpus, queueAllocatedCpus, queueAllocatedMemory, queueBudgetRemainingGpuHours)
 }
 
 func SetQueueMetrics(queue *v2.Queue) {
@@ -149,9 +159,13 @@ func SetQueueMetrics(queue *v2.Queue) {
 	additionalMetricLabelValues := getAdditionalMetricLabelValues(queue.Labels)
 
 	queueName := queue.Name
-	gpuQuota := getGpuQuota(queue.Spec.Resources)
-	cpuQuota := getCpuQuotaCores(queue.Spec.Resources)
-	memoryQuota := getMemoryQuotaBytes(queue.Spec.Resources)
+	quotaResources := queue.Spec.Resources
+	if queue.Status.EffectiveResources != nil {
+		quotaResources = queue.Status.EffectiveResources
+	}
+	gpuQuota := getGpuQuota(quotaResources)
+	cpuQuota := getCpuQuotaCores(quotaResources)
+	memoryQuota := getMemoryQuotaBytes(quotaResources)
 	allocatedGpus := getAllocatedGpus(queue.Status)
 	allocatedCpus := getAllocatedCpuCores(queue.Status)
 	allocatedMemory := getAllocatedMemoryBytes(queue.Status)
@@ -165,6 +179,9 @@ func SetQueueMetrics(queue *v2.Queue) {
 	queueAllocatedGpus.WithLabelValues(queueQuotaMetricValues...).Set(allocatedGpus)
 	queueAllocatedCpus.WithLabelValues(queueQuotaMetricValues...).Set(allocatedCpus)
 	queueAllocatedMemory.WithLabelValues(queueQuotaMetricValues...).Set(allocatedMemory)
+	if queue.Spec.Budget != nil && queue.Status.Budget != nil {
+		queueBudgetRemainingGpuHours.WithLabelValues(queueQuotaMetricValues...).Set(queue.Status.Budget.RemainingGPUHours)
+	}
 }
 
 func ResetQueueMetrics(queueName string) {
@@ -176,6 +193,7 @@ func ResetQueueMetrics(queueName string) {
 	queueAllocatedGpus.DeletePartialMatch(queueLabelIdentifier)
 	queueAllocatedCpus.DeletePartialMatch(queueLabelIdentifier)
 	queueAllocatedMemory.DeletePartialMatch(queueLabelIdentifier)
+	queueBudgetRemainingGpuHours.DeletePartialMatch(queueLabelIdentifier)
 }
 
 func getGpuQuota(queueSpecResources *v2.QueueResources) float64 {
@@ -282,3 +300,7 @@ func GetQueueAllocatedCPUMetric() *prometheus.GaugeVec {
 func GetQueueAllocatedMemoryMetric() *prometheus.GaugeVec {
 	return queueAllocatedMemory
 }
+
+func GetQueueBudgetRemainingGPUHoursMetric() *prometheus.GaugeVec {
+	return queueBudgetRemainingGpuHours
+}
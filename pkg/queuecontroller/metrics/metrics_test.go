@@ -132,6 +132,30 @@ var _ = Describe("Queue Metrics", Ordered, func() {
 		expectMetricValue(queueAllocatedMemory, labels, 0)
 	})
 
+	It("should report the remaining budget of a queue with a budget", func() {
+		queue = &v2.Queue{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-queue",
+			},
+			Spec: v2.QueueSpec{
+				Resources: &v2.QueueResources{
+					GPU: v2.QueueResource{Quota: 1},
+				},
+				Budget: &v2.QueueBudget{GPUHours: 100},
+			},
+			Status: v2.QueueStatus{
+				Budget: &v2.QueueBudgetStatus{ConsumedGPUHours: 62.5, RemainingGPUHours: 37.5},
+			},
+		}
+		SetQueueMetrics(queue)
+
+		expectMetricValue(queueBudgetRemainingGpuHours, []string{"test-queue", "normal", ""}, 37.5)
+
+		queue.Spec.Budget = nil
+		SetQueueMetrics(queue)
+		Expect(testutil.CollectAndCount(queueBudgetRemainingGpuHours)).To(Equal(0))
+	})
+
 	It("should delete metrics when queue is deleted", func() {
 		queue = &v2.Queue{
 			ObjectMeta: metav1.ObjectMeta{
@@ -26,6 +26,7 @@ import (
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
@@ -56,11 +57,14 @@ func (alloc *allocateAction) Execute(ssn *framework.Session) {
 
 	log.InfraLogger.V(2).Infof("There are <%d> PodGroupInfos and <%d> Queues in total for scheduling",
 		jobsOrderByQueues.Len(), ssn.CountLeafQueues())
+	now := time.Now()
+	var backfill *backfillReservation
 	for !jobsOrderByQueues.IsEmpty() {
 		job := jobsOrderByQueues.PopNextJob()
 		stmt := ssn.Statement()
 		alreadyAllocated := job.GetNumAllocatedTasks() > 0
-		if ok, pipelined := attemptToAllocateJob(ssn, stmt, job); ok {
+		nodes := backfill.nodesForJob(job, maps.Values(ssn.ClusterInfo.Nodes), now)
+		if ok, pipelined := attemptToAllocateJob(ssn, stmt, job, nodes); ok {
 			metrics.IncPodgroupScheduledByAction()
 			err := stmt.Commit()
 			if err == nil && !pipelined && !alreadyAllocated {
@@ -72,18 +76,32 @@ func (alloc *allocateAction) Execute(ssn *framework.Session) {
 			}
 		} else {
 			stmt.Discard()
+			if ssn.UseBackfill() && backfill == nil {
+				backfill = newBackfillReservation(ssn, job, now)
+			}
 		}
 	}
 }
 
-func attemptToAllocateJob(ssn *framework.Session, stmt *framework.Statement, job *podgroup_info.PodGroupInfo) (allocated, pipelined bool) {
+// AttemptToScheduleJob attempts to allocate the job on all the nodes of the session.
+func (alloc *allocateAction) AttemptToScheduleJob(ssn *framework.Session, job *podgroup_info.PodGroupInfo) (
+	bool, *framework.Statement) {
+	stmt := ssn.Statement()
+	if allocated, _ := attemptToAllocateJob(ssn, stmt, job, maps.Values(ssn.ClusterInfo.Nodes)); !allocated {
+		stmt.Discard()
+		return false, nil
+	}
+	return true, stmt
+}
+
+func attemptToAllocateJob(ssn *framework.Session, stmt *framework.Statement, job *podgroup_info.PodGroupInfo,
+	nodes []*node_info.NodeInfo) (allocated, pipelined bool) {
 	queue := ssn.ClusterInfo.Queues[job.Queue]
 
 	resReq := podgroup_info.GetTasksToAllocateInitResource(job, ssn.PodSetOrderFn, ssn.TaskOrderFn, true, ssn.ClusterInfo.MinNodeGPUMemory)
 	log.InfraLogger.V(3).Infof("Attempting to allocate job: <%v/%v> of queue <%v>, resources: <%v>",
 		job.Namespace, job.Name, queue.Name, resReq)
 
-	nodes := maps.Values(ssn.ClusterInfo.Nodes)
 	if !common.AllocateJob(ssn, stmt, nodes, job, false) {
 		log.InfraLogger.V(3).Infof("Could not allocate resources for job: <%v/%v> of queue <%v>",
 			job.Namespace, job.Name, job.Queue)
@@ -0,0 +1,166 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package allocate_test
+
+import (
+	"testing"
+	"time"
+
+	. "go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/allocate"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestBackfill(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name             string
+		backfill         bool
+		expectedRuntimes map[string]time.Duration
+		numberOfBinds    int
+		expectedStatuses map[string]pod_status.PodStatus
+	}{
+		{
+			name:     "backfill disabled - small jobs use the nodes the gang waits for",
+			backfill: false,
+			expectedRuntimes: map[string]time.Duration{
+				"running_job0": time.Hour,
+				"short_job0":   30 * time.Minute,
+			},
+			numberOfBinds: 2,
+			expectedStatuses: map[string]pod_status.PodStatus{
+				"gang_job0":  pod_status.Pending,
+				"short_job0": pod_status.Binding,
+				"long_job0":  pod_status.Binding,
+			},
+		},
+		{
+			name:     "backfill enabled - only jobs that end before the reservation use the reserved nodes",
+			backfill: true,
+			expectedRuntimes: map[string]time.Duration{
+				"running_job0": time.Hour,
+				"short_job0":   30 * time.Minute,
+				"long_job0":    2 * time.Hour,
+			},
+			numberOfBinds: 1,
+			expectedStatuses: map[string]pod_status.PodStatus{
+				"gang_job0":  pod_status.Pending,
+				"short_job0": pod_status.Binding,
+				"long_job0":  pod_status.Pending,
+			},
+		},
+		{
+			name:     "backfill enabled - no reservation without expected runtimes of running jobs",
+			backfill: true,
+			expectedRuntimes: map[string]time.Duration{
+				"short_job0": 30 * time.Minute,
+			},
+			numberOfBinds: 2,
+			expectedStatuses: map[string]pod_status.PodStatus{
+				"gang_job0":  pod_status.Pending,
+				"short_job0": pod_status.Binding,
+				"long_job0":  pod_status.Binding,
+			},
+		},
+	}
+
+	for testNumber, tt := range tests {
+		t.Logf("Running test %d: %s", testNumber, tt.name)
+		topology := getBackfillTestTopology(tt.numberOfBinds)
+		ssn := test_utils.BuildSession(topology, controller)
+		ssn.SchedulerParams.Backfill = tt.backfill
+		for jobName, expectedRuntime := range tt.expectedRuntimes {
+			ssn.ClusterInfo.PodGroupInfos[common_info.PodGroupID(jobName)].ExpectedRuntime = ptr.To(expectedRuntime)
+		}
+
+		allocate.New().Execute(ssn)
+
+		for jobName, expectedStatus := range tt.expectedStatuses {
+			job := ssn.ClusterInfo.PodGroupInfos[common_info.PodGroupID(jobName)]
+			for _, task := range job.GetAllPodsMap() {
+				if task.Status != expectedStatus {
+					t.Errorf("Test %d: %s, task %s has status %s, expected %s",
+						testNumber, tt.name, task.Name, task.Status, expectedStatus)
+				}
+			}
+		}
+	}
+}
+
+func getBackfillTestTopology(numberOfBinds int) test_utils.TestTopologyBasic {
+	return test_utils.TestTopologyBasic{
+		Name: "backfill",
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "running_job0",
+				RequiredGPUsPerTask: 2,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Running, NodeName: "node0"},
+				},
+			},
+			{
+				Name:                "running_job1",
+				RequiredGPUsPerTask: 4,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Running, NodeName: "node1"},
+				},
+			},
+			{
+				Name:                "gang_job0",
+				RequiredGPUsPerTask: 2,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber + 2,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+					{State: pod_status.Pending},
+				},
+			},
+			{
+				Name:                "short_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber + 1,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+				},
+			},
+			{
+				Name:                "long_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 4},
+			"node1": {GPUs: 4},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{Name: "queue0", DeservedGPUs: 16},
+		},
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheBinds: numberOfBinds,
+			},
+		},
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package allocate_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "go.uber.org/mock/gomock"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/allocate"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestStarvationGuard(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name                  string
+		starvationThreshold   time.Duration
+		numberOfBinds         int
+		expectedStatuses      map[string]pod_status.PodStatus
+		expectNodesReservedOn bool
+	}{
+		{
+			name:                "starvation guard disabled",
+			starvationThreshold: 0,
+			numberOfBinds:       2,
+			expectedStatuses: map[string]pod_status.PodStatus{
+				"gang_job0":          pod_status.Pending,
+				"small_job0":         pod_status.Binding,
+				"high_priority_job0": pod_status.Binding,
+			},
+		},
+		{
+			name:                "gang pending for less than the threshold",
+			starvationThreshold: 3 * time.Hour,
+			numberOfBinds:       2,
+			expectedStatuses: map[string]pod_status.PodStatus{
+				"gang_job0":          pod_status.Pending,
+				"small_job0":         pod_status.Binding,
+				"high_priority_job0": pod_status.Binding,
+			},
+		},
+		{
+			name:                "gang pending for more than the threshold holds the nodes",
+			starvationThreshold: time.Hour,
+			numberOfBinds:       1,
+			expectedStatuses: map[string]pod_status.PodStatus{
+				"gang_job0":          pod_status.Pending,
+				"small_job0":         pod_status.Pending,
+				"high_priority_job0": pod_status.Binding,
+			},
+			expectNodesReservedOn: true,
+		},
+	}
+
+	for testNumber, tt := range tests {
+		t.Logf("Running test %d: %s", testNumber, tt.name)
+		ssn := test_utils.BuildSession(getStarvationGuardTestTopology(tt.numberOfBinds), controller)
+		ssn.SchedulerParams.StarvationThreshold = tt.starvationThreshold
+
+		allocate.New().Execute(ssn)
+
+		for jobName, expectedStatus := range tt.expectedStatuses {
+			job := ssn.ClusterInfo.PodGroupInfos[common_info.PodGroupID(jobName)]
+			for _, task := range job.GetAllPodsMap() {
+				if task.Status != expectedStatus {
+					t.Errorf("Test %d: %s, task %s has status %s, expected %s",
+						testNumber, tt.name, task.Name, task.Status, expectedStatus)
+				}
+			}
+		}
+
+		// The reservation is reported on the starving job, and on the jobs it kept off the held nodes
+		nodesReserved := getNodesReservedMessage(ssn.ClusterInfo.PodGroupInfos["gang_job0"]) != ""
+		if nodesReserved != tt.expectNodesReservedOn {
+			t.Errorf("Test %d: %s, expected nodes reserved: %v, got: %v",
+				testNumber, tt.name, tt.expectNodesReservedOn, nodesReserved)
+		}
+		blockedByReservation := false
+		for _, fitErrors := range ssn.ClusterInfo.PodGroupInfos["small_job0"].TasksFitErrors {
+			if strings.Contains(fitErrors.DetailedError(), "node is held for podgroup") {
+				blockedByReservation = true
+			}
+		}
+		if blockedByReservation != tt.expectNodesReservedOn {
+			t.Errorf("Test %d: %s, expected blocked by reservation: %v, got: %v",
+				testNumber, tt.name, tt.expectNodesReservedOn, blockedByReservation)
+		}
+	}
+}
+
+func TestStarvationGuardHoldsOnlyNodesTheJobCanRunOn(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	topology := getStarvationGuardTestTopology(2)
+	topology.Nodes["node2"] = nodes_fake.TestNodeBasic{GPUs: 4}
+	for _, job := range topology.Jobs {
+		if job.Name == "gang_job0" {
+			for _, task := range job.Tasks {
+				task.NodeAffinityNames = []string{"node1", "node2"}
+			}
+		}
+	}
+	ssn := test_utils.BuildSession(topology, controller)
+	ssn.SchedulerParams.StarvationThreshold = time.Hour
+
+	allocate.New().Execute(ssn)
+
+	// node0 has more free GPUs than node1, but the gang's affinity excludes it
+	message := getNodesReservedMessage(ssn.ClusterInfo.PodGroupInfos["gang_job0"])
+	if !strings.Contains(message, "holding nodes node2, node1") {
+		t.Errorf("expected node2 and node1 to be held for the gang, got: %q", message)
+	}
+	for _, task := range ssn.ClusterInfo.PodGroupInfos["small_job0"].GetAllPodsMap() {
+		if task.Status != pod_status.Binding || task.NodeName != "node0" {
+			t.Errorf("expected small_job0 to be bound to node0, got status %s on node %s", task.Status,
+				task.NodeName)
+		}
+	}
+}
+
+func getNodesReservedMessage(job *podgroup_info.PodGroupInfo) string {
+	for _, fitError := range job.JobFitErrors {
+		if fitError.Reason() == enginev2alpha2.NodesReserved {
+			return fitError.DetailedMessage()
+		}
+	}
+	return ""
+}
+
+func getStarvationGuardTestTopology(numberOfBinds int) test_utils.TestTopologyBasic {
+	return test_utils.TestTopologyBasic{
+		Name: "starvation guard",
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "running_job0",
+				RequiredGPUsPerTask: 2,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Running, NodeName: "node0"},
+				},
+			},
+			{
+				Name:                "running_job1",
+				RequiredGPUsPerTask: 3,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Running, NodeName: "node1"},
+				},
+			},
+			{
+				Name:                "gang_job0",
+				RequiredGPUsPerTask: 3,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				JobAgeInMinutes:     120,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+					{State: pod_status.Pending},
+				},
+			},
+			{
+				Name:                "small_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				JobAgeInMinutes:     1,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+				},
+			},
+			{
+				Name:                "high_priority_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber + 10,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 4},
+			"node1": {GPUs: 4},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{Name: "queue0", DeservedGPUs: 16},
+		},
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheBinds: numberOfBinds,
+			},
+		},
+	}
+}
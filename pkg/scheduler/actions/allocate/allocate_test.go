@@ -25,6 +25,7 @@ import (
 
 	. "go.uber.org/mock/gomock"
 
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/allocate"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
@@ -1811,6 +1812,7 @@ type failingBindCache struct {
 	cache.Cache
 }
 
-func (f *failingBindCache) Bind(podInfo *pod_info.PodInfo, hostname string, bindRequestAnnotations map[string]string) error {
+func (f *failingBindCache) Bind(podInfo *pod_info.PodInfo, hostname string, gang *schedulingv1alpha2.GangBinding,
+	bindRequestAnnotations map[string]string) error {
 	return fmt.Errorf("create pod error")
 }
@@ -0,0 +1,158 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package allocate
+
+import (
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// backfillReservation reserves nodes for the first job that could not be allocated for lack of resources, from the
+// time at which enough resources are expected to be released for it. Other jobs may only be allocated on the
+// reserved nodes if they are expected to finish before that time.
+type backfillReservation struct {
+	job             *podgroup_info.PodGroupInfo
+	reservationTime time.Time
+	reservedNodes   map[string]bool
+}
+
+type expectedRelease struct {
+	nodeName  string
+	endTime   time.Time
+	resources *resource_info.Resource
+}
+
+// newBackfillReservation returns the reservation for the job, or nil if reserving nodes would not let the job run
+// sooner: the job is blocked by its queue, already fits the released resources, or the running jobs that hold the
+// resources it needs do not declare an expected runtime. Only the nodes that are needed to cover the job's request
+// at the reservation time are reserved.
+func newBackfillReservation(ssn *framework.Session, job *podgroup_info.PodGroupInfo, now time.Time) *backfillReservation {
+	tasksToAllocate := podgroup_info.GetTasksToAllocate(job, ssn.PodSetOrderFn, ssn.TaskOrderFn, true)
+	if len(tasksToAllocate) == 0 {
+		return nil
+	}
+	if result := ssn.IsJobOverQueueCapacityFn(job, tasksToAllocate); !result.IsSchedulable {
+		return nil
+	}
+	required := podgroup_info.GetTasksToAllocateInitResource(job, ssn.PodSetOrderFn, ssn.TaskOrderFn, true,
+		ssn.ClusterInfo.MinNodeGPUMemory)
+
+	nodesAvailable := map[string]*resource_info.Resource{}
+	available := resource_info.EmptyResource()
+	for _, node := range ssn.ClusterInfo.Nodes {
+		free := node.NonAllocatedResources()
+		if !resource_info.HasRequiredResourceTypes(free, required) {
+			continue
+		}
+		available.Add(free)
+		nodesAvailable[node.Name] = free
+	}
+	if required.LessEqual(available) {
+		return nil
+	}
+
+	for _, release := range getExpectedReleases(ssn, now) {
+		available.Add(release.resources)
+		if _, found := nodesAvailable[release.nodeName]; !found {
+			nodesAvailable[release.nodeName] = resource_info.EmptyResource()
+		}
+		nodesAvailable[release.nodeName].Add(release.resources)
+		if required.LessEqual(available) {
+			reservation := &backfillReservation{
+				job:             job,
+				reservationTime: release.endTime,
+				reservedNodes:   selectReservedNodes(nodesAvailable, required),
+			}
+			log.InfraLogger.V(3).Infof("Reserved %d nodes for job <%s/%s> from %v",
+				len(reservation.reservedNodes), job.Namespace, job.Name, reservation.reservationTime)
+			return reservation
+		}
+	}
+	return nil
+}
+
+// selectReservedNodes returns the fewest nodes, taken from the one with the most available resources, whose available
+// resources cover the required resources.
+func selectReservedNodes(nodesAvailable map[string]*resource_info.Resource,
+	required *resource_info.Resource) map[string]bool {
+	nodeNames := make([]string, 0, len(nodesAvailable))
+	for nodeName := range nodesAvailable {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Slice(nodeNames, func(i, j int) bool {
+		compared := resource_info.CompareFreeResources(
+			nodesAvailable[nodeNames[i]], nodesAvailable[nodeNames[j]], required)
+		if compared != 0 {
+			return compared > 0
+		}
+		return nodeNames[i] < nodeNames[j]
+	})
+
+	reservedNodes := map[string]bool{}
+	reserved := resource_info.EmptyResource()
+	for _, nodeName := range nodeNames {
+		reserved.Add(nodesAvailable[nodeName])
+		reservedNodes[nodeName] = true
+		if required.LessEqual(reserved) {
+			break
+		}
+	}
+	return reservedNodes
+}
+
+// nodesForJob returns the nodes that the job may be allocated on without delaying the reserved job.
+func (r *backfillReservation) nodesForJob(job *podgroup_info.PodGroupInfo, nodes []*node_info.NodeInfo,
+	now time.Time) []*node_info.NodeInfo {
+	if r == nil || job.UID == r.job.UID {
+		return nodes
+	}
+	if job.ExpectedRuntime != nil && !now.Add(*job.ExpectedRuntime).After(r.reservationTime) {
+		return nodes
+	}
+
+	var unreservedNodes []*node_info.NodeInfo
+	for _, node := range nodes {
+		if !r.reservedNodes[node.Name] {
+			unreservedNodes = append(unreservedNodes, node)
+		}
+	}
+	return unreservedNodes
+}
+
+// getExpectedReleases returns the resources of running jobs that declared an expected runtime, ordered by the time
+// at which they are expected to be released.
+func getExpectedReleases(ssn *framework.Session, now time.Time) []expectedRelease {
+	var releases []expectedRelease
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		endTime, found := job.ExpectedEndTime()
+		if !found {
+			continue
+		}
+		if endTime.Before(now) {
+			endTime = now
+		}
+		for _, task := range job.GetAllPodsMap() {
+			if !pod_status.IsActiveAllocatedStatus(task.Status) || len(task.NodeName) == 0 {
+				continue
+			}
+			resources := resource_info.EmptyResource()
+			resources.AddResourceRequirements(task.ResReq)
+			releases = append(releases, expectedRelease{
+				nodeName: task.NodeName, endTime: endTime, resources: resources,
+			})
+		}
+	}
+
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].endTime.Before(releases[j].endTime)
+	})
+	return releases
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// TaskPlacement is the node that a task of an evaluated job was allocated or pipelined to.
+type TaskPlacement struct {
+	Name      string   `json:"name"`
+	Node      string   `json:"node"`
+	GPUGroups []string `json:"gpuGroups,omitempty"`
+	Pipelined bool     `json:"pipelined"`
+}
+
+// Victim is a task that was evicted, or moved to another node, to schedule an evaluated job.
+type Victim struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	PodGroup  string `json:"podGroup"`
+	Queue     string `json:"queue"`
+	Node      string `json:"node"`
+	// ReallocatedNode is the node that a consolidated victim is moved to.
+	ReallocatedNode string `json:"reallocatedNode,omitempty"`
+}
+
+// JobEvaluation describes how an action would schedule a job.
+type JobEvaluation struct {
+	// Action is the action that scheduled the job, empty if none of the actions could.
+	Action framework.ActionType `json:"action,omitempty"`
+	// Scheduled is true if the job's gang would be allocated.
+	Scheduled bool `json:"scheduled"`
+	// Pipelined is true if the job would wait on nodes that are released by its victims.
+	Pipelined  bool            `json:"pipelined"`
+	Placements []TaskPlacement `json:"placements"`
+	Victims    []Victim        `json:"victims"`
+}
+
+type taskState struct {
+	status pod_status.PodStatus
+	node   string
+}
+
+// EvaluateJob attempts to schedule the job with each of the actions in order, until one of them succeeds. The
+// statement of the successful attempt is discarded once its placements and victims are read, so the session's
+// state is left as it was and nothing is committed to the cache.
+func EvaluateJob(ssn *framework.Session, job *podgroup_info.PodGroupInfo,
+	actions []framework.JobAction) *JobEvaluation {
+	evaluation := &JobEvaluation{
+		Placements: []TaskPlacement{},
+		Victims:    []Victim{},
+	}
+
+	for _, action := range actions {
+		log.InfraLogger.V(4).Infof("Evaluating action %s for job <%s/%s>", action.Name(), job.Namespace, job.Name)
+		activeTasks := activeTaskStates(ssn, job)
+		scheduled, stmt := action.AttemptToScheduleJob(ssn, job)
+		if !scheduled {
+			if stmt != nil {
+				stmt.Discard()
+			}
+			continue
+		}
+
+		evaluation.Action = action.Name()
+		evaluation.Placements = jobPlacements(job)
+		evaluation.Victims = victims(ssn, job, activeTasks)
+		for _, placement := range evaluation.Placements {
+			evaluation.Pipelined = evaluation.Pipelined || placement.Pipelined
+		}
+		evaluation.Scheduled = !evaluation.Pipelined
+		stmt.Discard()
+		break
+	}
+
+	return evaluation
+}
+
+// UnschedulableReasons returns the job's fit errors as sorted, human-readable reasons.
+func UnschedulableReasons(job *podgroup_info.PodGroupInfo) []string {
+	var reasons []string
+	for _, jobFitError := range job.JobFitErrors {
+		reasons = append(reasons, jobFitError.DetailedMessage())
+	}
+
+	tasks := job.GetAllPodsMap()
+	for podID, taskFitErrors := range job.TasksFitErrors {
+		taskName := string(podID)
+		if task, found := tasks[podID]; found {
+			taskName = task.Name
+		}
+		reasons = append(reasons, fmt.Sprintf("%s: %s", taskName, taskFitErrors.DetailedError()))
+	}
+
+	sort.Strings(reasons)
+	return reasons
+}
+
+func activeTaskStates(ssn *framework.Session, evaluatedJob *podgroup_info.PodGroupInfo) map[common_info.PodID]taskState {
+	states := map[common_info.PodID]taskState{}
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if job.UID == evaluatedJob.UID {
+			continue
+		}
+		for _, task := range job.GetAllPodsMap() {
+			if !pod_status.IsActiveAllocatedStatus(task.Status) {
+				continue
+			}
+			states[task.UID] = taskState{status: task.Status, node: task.NodeName}
+		}
+	}
+	return states
+}
+
+func jobPlacements(job *podgroup_info.PodGroupInfo) []TaskPlacement {
+	placements := []TaskPlacement{}
+	for _, task := range job.GetAllPodsMap() {
+		if task.Status != pod_status.Allocated && task.Status != pod_status.Pipelined {
+			continue
+		}
+		placements = append(placements, TaskPlacement{
+			Name:      task.Name,
+			Node:      task.NodeName,
+			GPUGroups: task.GPUGroups,
+			Pipelined: task.Status == pod_status.Pipelined,
+		})
+	}
+	sort.Slice(placements, func(i, j int) bool {
+		return placements[i].Name < placements[j].Name
+	})
+	return placements
+}
+
+// victims returns the tasks whose status or node differ from the states recorded before the job was evaluated. The
+// statement may replace the pod infos of the tasks it changes, so they are looked up again by their ID.
+func victims(ssn *framework.Session, evaluatedJob *podgroup_info.PodGroupInfo,
+	activeTasks map[common_info.PodID]taskState) []Victim {
+	result := []Victim{}
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if job.UID == evaluatedJob.UID {
+			continue
+		}
+		for _, task := range job.GetAllPodsMap() {
+			state, found := activeTasks[task.UID]
+			if !found || (task.Status == state.status && task.NodeName == state.node) {
+				continue
+			}
+
+			victim := Victim{
+				Namespace: task.Namespace,
+				Name:      task.Name,
+				PodGroup:  job.Name,
+				Queue:     string(job.Queue),
+				Node:      state.node,
+			}
+			if task.Status != pod_status.Releasing && task.NodeName != state.node {
+				victim.ReallocatedNode = task.NodeName
+			}
+			result = append(result, victim)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	solverscenario "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common/solvers/scenario"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -18,6 +19,11 @@ import (
 
 type GenerateVictimsQueue func() *utils.JobsOrderByQueues
 
+type scenarioBuilder interface {
+	GetValidScenario() *solverscenario.ByNodeScenario
+	GetNextScenario() *solverscenario.ByNodeScenario
+}
+
 type JobSolver struct {
 	feasibleNodes        []*node_info.NodeInfo
 	solutionValidator    SolutionValidator
@@ -89,8 +95,7 @@ func (s *JobSolver) Solve(
 }
 
 func (s *JobSolver) solvePartialJob(ssn *framework.Session, state *solvingState, partialPendingJob *podgroup_info.PodGroupInfo) *solutionResult {
-	scenarioBuilder := NewPodAccumulatedScenarioBuilder(
-		ssn, partialPendingJob, state.recordedVictimsJobs, s.generateVictimsQueue())
+	scenarioBuilder := s.newScenarioBuilder(ssn, state, partialPendingJob)
 
 	feasibleNodeMap := map[string]*node_info.NodeInfo{}
 	for _, node := range s.feasibleNodes {
@@ -119,6 +124,21 @@ func (s *JobSolver) solvePartialJob(ssn *framework.Session, state *solvingState,
 	return nil
 }
 
+// newScenarioBuilder concentrates the victims of reclaim and preempt in a single topology domain when the pending job
+// has a required topology level. Consolidation reallocates its victims instead of evicting them, so they may make room
+// in any domain.
+func (s *JobSolver) newScenarioBuilder(
+	ssn *framework.Session, state *solvingState, partialPendingJob *podgroup_info.PodGroupInfo) scenarioBuilder {
+	if s.actionType == framework.Reclaim || s.actionType == framework.Preempt {
+		if topologyDomains := ssn.TopologyDomains(partialPendingJob); topologyDomains != nil {
+			return NewTopologyScenarioBuilder(
+				ssn, partialPendingJob, state.recordedVictimsJobs, s.generateVictimsQueue, topologyDomains)
+		}
+	}
+	return NewPodAccumulatedScenarioBuilder(
+		ssn, partialPendingJob, state.recordedVictimsJobs, s.generateVictimsQueue())
+}
+
 func getPartialJobRepresentative(
 	job *podgroup_info.PodGroupInfo, pendingTasks []*pod_info.PodInfo) *podgroup_info.PodGroupInfo {
 	jobRepresentative := job.CloneWithTasks(pendingTasks)
@@ -9,7 +9,6 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common/solvers/accumulated_scenario_filters"
 	idle_gpus_filter "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common/solvers/accumulated_scenario_filters/idle_gpus"
 	solverscenario "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common/solvers/scenario"
-	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
@@ -18,22 +17,31 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
 )
 
+// VictimsQueue orders the victim jobs that scenarios are accumulated from.
+type VictimsQueue interface {
+	IsEmpty() bool
+	PopNextJob() *podgroup_info.PodGroupInfo
+	PushJob(job *podgroup_info.PodGroupInfo)
+}
+
 type PodAccumulatedScenarioBuilder struct {
 	session         *framework.Session
 	scenarioFilters []accumulated_scenario_filters.Interface
 
 	lastScenario     *solverscenario.ByNodeScenario
-	victimsJobsQueue *utils.JobsOrderByQueues
+	victimsJobsQueue VictimsQueue
 
 	recordedVictimsTasks map[common_info.PodID]*pod_info.PodInfo
+	sharedGpuVictims     *sharedGpuVictims
 }
 
 func NewPodAccumulatedScenarioBuilder(
 	session *framework.Session, pendingJob *podgroup_info.PodGroupInfo, recordedVictimsJobs []*podgroup_info.PodGroupInfo,
-	victimsJobsQueue *utils.JobsOrderByQueues,
+	victimsJobsQueue VictimsQueue,
 ) *PodAccumulatedScenarioBuilder {
 
 	var scenario *solverscenario.ByNodeScenario = nil
+	var sharedGpuVictims *sharedGpuVictims = nil
 	recordedVictimsTasks := make(map[common_info.PodID]*pod_info.PodInfo)
 	tasksToAllocate := podgroup_info.GetTasksToAllocate(pendingJob, session.PodSetOrderFn, session.TaskOrderFn, false)
 	if len(tasksToAllocate) != 0 {
@@ -43,6 +51,8 @@ func NewPodAccumulatedScenarioBuilder(
 				recordedVictimsTasks[podId] = podInfo
 			}
 		}
+		sharedGpuVictims = newSharedGpuVictims(
+			session.ClusterInfo.Nodes, tasksToAllocate, scenario.RecordedVictimsTasks())
 	}
 
 	var scenarioFilters []accumulated_scenario_filters.Interface
@@ -63,13 +73,14 @@ func NewPodAccumulatedScenarioBuilder(
 		session:              session,
 		victimsJobsQueue:     victimsJobsQueue,
 		recordedVictimsTasks: recordedVictimsTasks,
+		sharedGpuVictims:     sharedGpuVictims,
 		lastScenario:         scenario,
 		scenarioFilters:      scenarioFilters,
 	}
 }
 
 func (asb *PodAccumulatedScenarioBuilder) GetNextScenario() *solverscenario.ByNodeScenario {
-	if asb.victimsJobsQueue.IsEmpty() {
+	if asb.victimsJobsQueue.IsEmpty() && !asb.hasDeferredVictims() {
 		return nil
 	}
 
@@ -82,6 +93,14 @@ func (asb *PodAccumulatedScenarioBuilder) GetNextScenario() *solverscenario.ByNo
 }
 
 func (asb *PodAccumulatedScenarioBuilder) addNextPotentialVictims() bool {
+	if asb.victimsJobsQueue.IsEmpty() {
+		// Only deferred victims are left, try them even though they don't free a usable shared gpu portion
+		if asb.lastScenario != nil {
+			asb.lastScenario.AddPotentialVictimsTasks(asb.sharedGpuVictims.popDeferredVictims())
+		}
+		return true
+	}
+
 	nextVictimJob := asb.victimsJobsQueue.PopNextJob()
 
 	potentialVictimTasks, jobHasMoreTasks := podgroup_info.GetTasksToEvict(
@@ -120,10 +139,23 @@ func (asb *PodAccumulatedScenarioBuilder) addNextPotentialVictims() bool {
 		asb.victimsJobsQueue.PushJob(jobToPush)
 	}
 
-	if asb.lastScenario != nil {
+	if asb.lastScenario == nil {
+		return true
+	}
+	if asb.sharedGpuVictims == nil {
 		asb.lastScenario.AddPotentialVictimsTasks(potentialVictimTasks)
+		return true
+	}
+
+	victimsTasksGroups := asb.sharedGpuVictims.addVictimTasks(potentialVictimTasks)
+	for _, victimsTasks := range victimsTasksGroups {
+		asb.lastScenario.AddPotentialVictimsTasks(victimsTasks)
 	}
-	return true
+	return len(victimsTasksGroups) > 0
+}
+
+func (asb *PodAccumulatedScenarioBuilder) hasDeferredVictims() bool {
+	return asb.sharedGpuVictims != nil && asb.sharedGpuVictims.hasDeferredVictims()
 }
 
 func (asb *PodAccumulatedScenarioBuilder) GetValidScenario() *solverscenario.ByNodeScenario {
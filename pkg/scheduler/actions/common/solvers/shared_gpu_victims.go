@@ -0,0 +1,173 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package solvers
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// sharedGpuVictims treats every shared gpu group as a unit when the pending tasks request gpu fractions. It tracks the
+// device portion that the potential victims free on each gpu group, and defers victims that only hold fractions of
+// groups that stay unusable for the pending tasks until other victims free the rest of the device.
+type sharedGpuVictims struct {
+	nodes        map[string]*node_info.NodeInfo
+	pendingTasks []*pod_info.PodInfo
+
+	freedMemory         map[sharedGpuKey]int64
+	freedIsolationModes map[sharedGpuKey]map[resources.GpuIsolationMode]int
+
+	deferredVictims [][]*pod_info.PodInfo
+}
+
+type sharedGpuKey struct {
+	nodeName string
+	gpuGroup string
+}
+
+// newSharedGpuVictims returns nil unless all the pending tasks request a shared gpu, as the victims of whole gpu
+// requests are accumulated pod by pod.
+func newSharedGpuVictims(
+	nodes map[string]*node_info.NodeInfo, pendingTasks []*pod_info.PodInfo, recordedVictimsTasks []*pod_info.PodInfo,
+) *sharedGpuVictims {
+	if len(pendingTasks) == 0 {
+		return nil
+	}
+	for _, task := range pendingTasks {
+		if !task.IsSharedGPURequest() {
+			return nil
+		}
+	}
+
+	victims := &sharedGpuVictims{
+		nodes:               nodes,
+		pendingTasks:        pendingTasks,
+		freedMemory:         map[sharedGpuKey]int64{},
+		freedIsolationModes: map[sharedGpuKey]map[resources.GpuIsolationMode]int{},
+	}
+	victims.addFreedResources(recordedVictimsTasks)
+	return victims
+}
+
+// addVictimTasks accounts for the device portions freed by the victim tasks and returns the groups of victim tasks to
+// add to the scenario, in order. Victim tasks that only hold fractions of gpu groups that are still unusable are
+// deferred. Once a victim makes a gpu group usable, the deferred victims of that group are returned before it, so the
+// victim that completes the group is the latest potential victim of the scenario.
+func (v *sharedGpuVictims) addVictimTasks(victimTasks []*pod_info.PodInfo) [][]*pod_info.PodInfo {
+	v.addFreedResources(victimTasks)
+
+	if !v.onlyHoldsSharedGpus(victimTasks) {
+		return [][]*pod_info.PodInfo{victimTasks}
+	}
+
+	usableGpus := map[sharedGpuKey]bool{}
+	for _, task := range victimTasks {
+		for _, gpuGroup := range task.GPUGroups {
+			key := sharedGpuKey{nodeName: task.NodeName, gpuGroup: gpuGroup}
+			if v.isUsable(key) {
+				usableGpus[key] = true
+			}
+		}
+	}
+	if len(usableGpus) == 0 {
+		log.InfraLogger.V(6).Infof("Deferring potential victims %s, they don't free a usable shared gpu portion",
+			victimPrintingStruct{victimTasks})
+		v.deferredVictims = append(v.deferredVictims, victimTasks)
+		return nil
+	}
+
+	var victimsTasksGroups [][]*pod_info.PodInfo
+	var remainingDeferredVictims [][]*pod_info.PodInfo
+	for _, deferredTasks := range v.deferredVictims {
+		if holdsAnyOfGpus(deferredTasks, usableGpus) {
+			victimsTasksGroups = append(victimsTasksGroups, deferredTasks)
+		} else {
+			remainingDeferredVictims = append(remainingDeferredVictims, deferredTasks)
+		}
+	}
+	v.deferredVictims = remainingDeferredVictims
+
+	return append(victimsTasksGroups, victimTasks)
+}
+
+func (v *sharedGpuVictims) hasDeferredVictims() bool {
+	return len(v.deferredVictims) > 0
+}
+
+// popDeferredVictims returns the earliest deferred victim tasks, for when there are no other victims left to try.
+func (v *sharedGpuVictims) popDeferredVictims() []*pod_info.PodInfo {
+	victimTasks := v.deferredVictims[0]
+	v.deferredVictims = v.deferredVictims[1:]
+	return victimTasks
+}
+
+func (v *sharedGpuVictims) addFreedResources(victimTasks []*pod_info.PodInfo) {
+	for _, task := range victimTasks {
+		node, found := v.nodes[task.NodeName]
+		// The memory of releasing tasks is already available to the pending tasks
+		if !found || !task.IsSharedGPUAllocation() || task.Status == pod_status.Releasing {
+			continue
+		}
+		for _, gpuGroup := range task.GPUGroups {
+			key := sharedGpuKey{nodeName: task.NodeName, gpuGroup: gpuGroup}
+			v.freedMemory[key] += node.GetResourceGpuMemory(task.ResReq)
+			if _, found = v.freedIsolationModes[key]; !found {
+				v.freedIsolationModes[key] = map[resources.GpuIsolationMode]int{}
+			}
+			v.freedIsolationModes[key][task.GpuIsolationMode] += 1
+		}
+	}
+}
+
+func (v *sharedGpuVictims) onlyHoldsSharedGpus(victimTasks []*pod_info.PodInfo) bool {
+	for _, task := range victimTasks {
+		if _, found := v.nodes[task.NodeName]; !found || !task.IsSharedGPUAllocation() {
+			return false
+		}
+	}
+	return true
+}
+
+// isUsable checks if any of the pending tasks fits the memory that is idle, releasing or freed by the victims on the
+// gpu group, next to the isolation modes of the tasks that remain on it.
+func (v *sharedGpuVictims) isUsable(key sharedGpuKey) bool {
+	node := v.nodes[key.nodeName]
+	availableMemory := node.MemoryOfEveryGpuOnNode -
+		node.AllocatedSharedGPUsMemory[key.gpuGroup] +
+		node.ReleasingSharedGPUsMemory[key.gpuGroup] +
+		v.freedMemory[key]
+
+	for _, task := range v.pendingTasks {
+		if node.GetResourceGpuMemory(task.ResReq) <= availableMemory && v.isIsolationCompatible(node, key, task) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *sharedGpuVictims) isIsolationCompatible(
+	node *node_info.NodeInfo, key sharedGpuKey, task *pod_info.PodInfo,
+) bool {
+	for isolationMode, tasksCount := range node.SharedGPUsIsolationModes[key.gpuGroup] {
+		remainingTasksCount := tasksCount - v.freedIsolationModes[key][isolationMode]
+		if isolationMode != task.GpuIsolationMode && remainingTasksCount > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func holdsAnyOfGpus(victimTasks []*pod_info.PodInfo, gpus map[sharedGpuKey]bool) bool {
+	for _, task := range victimTasks {
+		for _, gpuGroup := range task.GPUGroups {
+			if gpus[sharedGpuKey{nodeName: task.NodeName, gpuGroup: gpuGroup}] {
+				return true
+			}
+		}
+	}
+	return false
+}
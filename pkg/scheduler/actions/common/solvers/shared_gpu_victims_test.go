@@ -0,0 +1,118 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package solvers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+var _ = Describe("sharedGpuVictims", func() {
+	var (
+		nodes map[string]*node_info.NodeInfo
+	)
+
+	BeforeEach(func() {
+		// gpu "0" holds 0.75 + 0.25, gpu "1" holds 0.5
+		nodes = map[string]*node_info.NodeInfo{
+			"node-1": {
+				MemoryOfEveryGpuOnNode: 100,
+				GpuSharingNodeInfo: node_info.GpuSharingNodeInfo{
+					AllocatedSharedGPUsMemory: map[string]int64{"0": 100, "1": 50},
+					ReleasingSharedGPUsMemory: map[string]int64{},
+					SharedGPUsIsolationModes: map[string]map[resources.GpuIsolationMode]int{
+						"0": {resources.GpuIsolationNone: 2},
+						"1": {resources.GpuIsolationNone: 1},
+					},
+				},
+			},
+		}
+	})
+
+	It("is not used for whole gpu reclaimers", func() {
+		pendingTask := &pod_info.PodInfo{
+			ResourceRequestType: pod_info.RequestTypeRegular,
+			ResReq:              resource_info.NewResourceRequirementsWithGpus(1),
+		}
+		Expect(newSharedGpuVictims(nodes, []*pod_info.PodInfo{pendingTask}, nil)).To(BeNil())
+	})
+
+	It("defers victims that leave their shared gpu unusable", func() {
+		victims := newSharedGpuVictims(nodes, []*pod_info.PodInfo{buildPendingFractionTask(0.75)}, nil)
+		Expect(victims).NotTo(BeNil())
+
+		smallVictim := buildRunningFractionTask("small", 0.25, "0")
+		Expect(victims.addVictimTasks([]*pod_info.PodInfo{smallVictim})).To(BeEmpty())
+		Expect(victims.hasDeferredVictims()).To(BeTrue())
+
+		otherGpuVictim := buildRunningFractionTask("other-gpu", 0.5, "1")
+		Expect(victims.addVictimTasks([]*pod_info.PodInfo{otherGpuVictim})).To(Equal(
+			[][]*pod_info.PodInfo{{otherGpuVictim}}))
+		Expect(victims.hasDeferredVictims()).To(BeTrue())
+
+		Expect(victims.popDeferredVictims()).To(Equal([]*pod_info.PodInfo{smallVictim}))
+		Expect(victims.hasDeferredVictims()).To(BeFalse())
+	})
+
+	It("returns deferred victims before the victim that makes their shared gpu usable", func() {
+		victims := newSharedGpuVictims(nodes, []*pod_info.PodInfo{buildPendingFractionTask(0.75)}, nil)
+
+		smallVictim := buildRunningFractionTask("small", 0.25, "0")
+		Expect(victims.addVictimTasks([]*pod_info.PodInfo{smallVictim})).To(BeEmpty())
+
+		bigVictim := buildRunningFractionTask("big", 0.75, "0")
+		Expect(victims.addVictimTasks([]*pod_info.PodInfo{bigVictim})).To(Equal(
+			[][]*pod_info.PodInfo{{smallVictim}, {bigVictim}}))
+		Expect(victims.hasDeferredVictims()).To(BeFalse())
+	})
+
+	It("doesn't make a shared gpu usable with a task of a different isolation mode left on it", func() {
+		nodes["node-1"].SharedGPUsIsolationModes["0"] = map[resources.GpuIsolationMode]int{
+			resources.GpuIsolationNone: 1,
+			resources.GpuIsolationMps:  1,
+		}
+		victims := newSharedGpuVictims(nodes, []*pod_info.PodInfo{buildPendingFractionTask(0.25)}, nil)
+
+		victim := buildRunningFractionTask("victim", 0.25, "0")
+		Expect(victims.addVictimTasks([]*pod_info.PodInfo{victim})).To(BeEmpty())
+	})
+
+	It("accounts for the recorded victims", func() {
+		recordedVictim := buildRunningFractionTask("recorded", 0.75, "0")
+		victims := newSharedGpuVictims(nodes, []*pod_info.PodInfo{buildPendingFractionTask(0.75)},
+			[]*pod_info.PodInfo{recordedVictim})
+
+		victim := buildRunningFractionTask("victim", 0.25, "0")
+		Expect(victims.addVictimTasks([]*pod_info.PodInfo{victim})).To(Equal(
+			[][]*pod_info.PodInfo{{victim}}))
+	})
+})
+
+func buildPendingFractionTask(portion float64) *pod_info.PodInfo {
+	return &pod_info.PodInfo{
+		Status:              pod_status.Pending,
+		ResourceRequestType: pod_info.RequestTypeFraction,
+		ResReq:              resource_info.NewResourceRequirementsWithGpus(portion),
+		GpuIsolationMode:    resources.GpuIsolationNone,
+	}
+}
+
+func buildRunningFractionTask(name string, portion float64, gpuGroup string) *pod_info.PodInfo {
+	return &pod_info.PodInfo{
+		Name:                 name,
+		NodeName:             "node-1",
+		Status:               pod_status.Running,
+		ResourceRequestType:  pod_info.RequestTypeFraction,
+		ResourceReceivedType: pod_info.ReceivedTypeFraction,
+		ResReq:               resource_info.NewResourceRequirementsWithGpus(portion),
+		GPUGroups:            []string{gpuGroup},
+		GpuIsolationMode:     resources.GpuIsolationNone,
+	}
+}
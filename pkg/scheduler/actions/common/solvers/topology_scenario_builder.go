@@ -0,0 +1,210 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package solvers
+
+import (
+	"cmp"
+	"slices"
+
+	solverscenario "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common/solvers/scenario"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// TopologyScenarioBuilder builds the scenarios of a preemptor with a required topology level one candidate domain at a
+// time, accumulating only victims that run inside the domain. Domains that cannot free enough GPUs for the preemptor
+// even if all their victims are evicted are skipped.
+type TopologyScenarioBuilder struct {
+	session              *framework.Session
+	pendingJob           *podgroup_info.PodGroupInfo
+	recordedVictimsJobs  []*podgroup_info.PodGroupInfo
+	generateVictimsQueue GenerateVictimsQueue
+
+	domains         []*candidateDomain
+	nextDomainIndex int
+	domainBuilder   *PodAccumulatedScenarioBuilder
+}
+
+type candidateDomain struct {
+	nodeNames          map[string]bool
+	idleGpus           float64
+	victimsGpus        float64
+	hasRecordedVictims bool
+}
+
+func NewTopologyScenarioBuilder(
+	session *framework.Session, pendingJob *podgroup_info.PodGroupInfo, recordedVictimsJobs []*podgroup_info.PodGroupInfo,
+	generateVictimsQueue GenerateVictimsQueue, domains []node_info.NodeSet,
+) *TopologyScenarioBuilder {
+	return &TopologyScenarioBuilder{
+		session:              session,
+		pendingJob:           pendingJob,
+		recordedVictimsJobs:  recordedVictimsJobs,
+		generateVictimsQueue: generateVictimsQueue,
+		domains:              orderCandidateDomains(session, pendingJob, recordedVictimsJobs, generateVictimsQueue(), domains),
+	}
+}
+
+func (tsb *TopologyScenarioBuilder) GetValidScenario() *solverscenario.ByNodeScenario {
+	if tsb.domainBuilder != nil {
+		if scenario := tsb.domainBuilder.GetValidScenario(); scenario != nil {
+			return scenario
+		}
+	}
+	return tsb.getNextDomainScenario()
+}
+
+func (tsb *TopologyScenarioBuilder) GetNextScenario() *solverscenario.ByNodeScenario {
+	if tsb.domainBuilder != nil {
+		if scenario := tsb.domainBuilder.GetNextScenario(); scenario != nil {
+			return scenario
+		}
+	}
+	return tsb.getNextDomainScenario()
+}
+
+// getNextDomainScenario moves to the next candidate domain and returns its first valid scenario. The scenario without
+// potential victims is the same for all domains, so it is only returned for the first one.
+func (tsb *TopologyScenarioBuilder) getNextDomainScenario() *solverscenario.ByNodeScenario {
+	for tsb.nextDomainIndex < len(tsb.domains) {
+		domain := tsb.domains[tsb.nextDomainIndex]
+		isFirstDomain := tsb.nextDomainIndex == 0
+		tsb.nextDomainIndex++
+
+		log.InfraLogger.V(5).Infof(
+			"Building scenarios for job <%s/%s> in a topology domain of %d nodes with %v idle GPUs and %v victims GPUs",
+			tsb.pendingJob.Namespace, tsb.pendingJob.Name, len(domain.nodeNames), domain.idleGpus, domain.victimsGpus)
+		tsb.domainBuilder = NewPodAccumulatedScenarioBuilder(tsb.session, tsb.pendingJob, tsb.recordedVictimsJobs,
+			newDomainVictimsQueue(tsb.generateVictimsQueue(), domain.nodeNames))
+
+		var scenario *solverscenario.ByNodeScenario
+		if isFirstDomain {
+			scenario = tsb.domainBuilder.GetValidScenario()
+		} else {
+			scenario = tsb.domainBuilder.GetNextScenario()
+		}
+		if scenario != nil {
+			return scenario
+		}
+	}
+	tsb.domainBuilder = nil
+	return nil
+}
+
+// orderCandidateDomains drops the domains whose idle GPUs and the GPUs of all their victims cannot hold the pending
+// job, and orders the rest so that the domains of recorded victims come first, and then by their idle and releasing
+// GPUs, most first, so that the domains that need the fewest GPUs of victims to be freed are tried first.
+func orderCandidateDomains(
+	session *framework.Session, pendingJob *podgroup_info.PodGroupInfo, recordedVictimsJobs []*podgroup_info.PodGroupInfo,
+	victimsQueue *utils.JobsOrderByQueues, domains []node_info.NodeSet,
+) []*candidateDomain {
+	nodeDomains := map[string][]*candidateDomain{}
+	allDomains := make([]*candidateDomain, 0, len(domains))
+	for _, domainNodes := range domains {
+		domain := &candidateDomain{nodeNames: map[string]bool{}}
+		for _, node := range domainNodes {
+			domain.nodeNames[node.Name] = true
+			idleGpus, _ := node.GetSumOfIdleGPUs()
+			releasingGpus, _ := node.GetSumOfReleasingGPUs()
+			domain.idleGpus += idleGpus + releasingGpus
+			nodeDomains[node.Name] = append(nodeDomains[node.Name], domain)
+		}
+		allDomains = append(allDomains, domain)
+	}
+
+	countedVictims := map[common_info.PodID]bool{}
+	addVictimTask := func(task *pod_info.PodInfo, isRecordedVictim bool) {
+		if countedVictims[task.UID] || !pod_status.IsAliveStatus(task.Status) {
+			return
+		}
+		countedVictims[task.UID] = true
+		for _, domain := range nodeDomains[task.NodeName] {
+			domain.victimsGpus += task.AcceptedResource.GPUs() + float64(task.AcceptedResource.GetDraGpusCount())
+			domain.hasRecordedVictims = domain.hasRecordedVictims || isRecordedVictim
+		}
+	}
+	for _, job := range recordedVictimsJobs {
+		for _, task := range job.GetAllPodsMap() {
+			addVictimTask(task, true)
+		}
+	}
+	for !victimsQueue.IsEmpty() {
+		for _, task := range victimsQueue.PopNextJob().GetAllPodsMap() {
+			addVictimTask(task, false)
+		}
+	}
+
+	requiredGpus := 0.0
+	for _, task := range podgroup_info.GetTasksToAllocate(
+		pendingJob, session.PodSetOrderFn, session.TaskOrderFn, false) {
+		requiredGpus += task.ResReq.GPUs() + float64(task.ResReq.GetDraGpusCount())
+	}
+
+	candidateDomains := slices.DeleteFunc(allDomains, func(domain *candidateDomain) bool {
+		return domain.idleGpus+domain.victimsGpus < requiredGpus
+	})
+	slices.SortStableFunc(candidateDomains, func(i, j *candidateDomain) int {
+		if i.hasRecordedVictims != j.hasRecordedVictims {
+			if i.hasRecordedVictims {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(j.idleGpus, i.idleGpus)
+	})
+	return candidateDomains
+}
+
+// domainVictimsQueue pops only the victim jobs that have tasks running on the domain's nodes. Victim jobs are popped
+// whole, so that evicting them never breaks a gang that also runs outside the domain.
+type domainVictimsQueue struct {
+	victimsQueue *utils.JobsOrderByQueues
+	nodeNames    map[string]bool
+	nextJob      *podgroup_info.PodGroupInfo
+}
+
+func newDomainVictimsQueue(victimsQueue *utils.JobsOrderByQueues, nodeNames map[string]bool) *domainVictimsQueue {
+	return &domainVictimsQueue{
+		victimsQueue: victimsQueue,
+		nodeNames:    nodeNames,
+	}
+}
+
+func (dvq *domainVictimsQueue) IsEmpty() bool {
+	for dvq.nextJob == nil && !dvq.victimsQueue.IsEmpty() {
+		job := dvq.victimsQueue.PopNextJob()
+		if dvq.hasTasksInDomain(job) {
+			dvq.nextJob = job
+		}
+	}
+	return dvq.nextJob == nil
+}
+
+func (dvq *domainVictimsQueue) PopNextJob() *podgroup_info.PodGroupInfo {
+	if dvq.IsEmpty() {
+		return nil
+	}
+	job := dvq.nextJob
+	dvq.nextJob = nil
+	return job
+}
+
+func (dvq *domainVictimsQueue) PushJob(job *podgroup_info.PodGroupInfo) {
+	dvq.victimsQueue.PushJob(job)
+}
+
+func (dvq *domainVictimsQueue) hasTasksInDomain(job *podgroup_info.PodGroupInfo) bool {
+	for _, task := range job.GetAllPodsMap() {
+		if dvq.nodeNames[task.NodeName] {
+			return true
+		}
+	}
+	return false
+}
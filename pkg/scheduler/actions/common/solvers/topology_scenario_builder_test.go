@@ -0,0 +1,152 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package solvers
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+var _ = Describe("TopologyScenarioBuilder", func() {
+	var (
+		ssn          *framework.Session
+		reclaimerJob *podgroup_info.PodGroupInfo
+	)
+
+	generateVictimsQueue := func() *utils.JobsOrderByQueues {
+		return utils.GetVictimsQueue(ssn, nil)
+	}
+
+	BeforeEach(func() {
+		reclaimerJob, _ = createJobWithTasks(2, 100, "team-a", v1.PodPending,
+			[]v1.ResourceRequirements{requireOneGPU()})
+	})
+
+	It("skips domains that cannot free enough GPUs for the reclaimer", func() {
+		ssn = initializeTopologySession([]string{"node-1", "node-1", "node-2"})
+		domains := []node_info.NodeSet{
+			{ssn.ClusterInfo.Nodes["node-2"]},
+			{ssn.ClusterInfo.Nodes["node-1"]},
+		}
+		scenarioBuilder := NewTopologyScenarioBuilder(
+			ssn, reclaimerJob, []*podgroup_info.PodGroupInfo{}, generateVictimsQueue, domains)
+
+		numberOfGeneratedScenarios := 0
+		for sn := scenarioBuilder.GetValidScenario(); sn != nil; sn = scenarioBuilder.GetNextScenario() {
+			for _, task := range sn.PotentialVictimsTasks() {
+				Expect(task.NodeName).To(Equal("node-1"))
+			}
+			numberOfGeneratedScenarios += 1
+		}
+		Expect(numberOfGeneratedScenarios).To(Equal(1))
+	})
+
+	It("builds the scenarios of the domain with recorded victims first", func() {
+		ssn = initializeTopologySession([]string{"node-1", "node-1", "node-2", "node-2"})
+		domains := []node_info.NodeSet{
+			{ssn.ClusterInfo.Nodes["node-1"]},
+			{ssn.ClusterInfo.Nodes["node-2"]},
+		}
+		var recordedVictimsJobs []*podgroup_info.PodGroupInfo
+		for _, job := range ssn.ClusterInfo.PodGroupInfos {
+			for _, task := range job.GetAllPodsMap() {
+				if task.NodeName == "node-2" && len(recordedVictimsJobs) == 0 {
+					recordedVictimsJobs = append(recordedVictimsJobs, job)
+				}
+			}
+		}
+		scenarioBuilder := NewTopologyScenarioBuilder(
+			ssn, reclaimerJob, recordedVictimsJobs, generateVictimsQueue, domains)
+
+		var potentialVictimsNodes []string
+		for sn := scenarioBuilder.GetValidScenario(); sn != nil; sn = scenarioBuilder.GetNextScenario() {
+			Expect(len(sn.RecordedVictimsJobs())).To(Equal(1))
+			for _, task := range sn.PotentialVictimsTasks() {
+				if len(potentialVictimsNodes) == 0 || potentialVictimsNodes[len(potentialVictimsNodes)-1] != task.NodeName {
+					potentialVictimsNodes = append(potentialVictimsNodes, task.NodeName)
+				}
+			}
+		}
+		Expect(potentialVictimsNodes).To(HaveLen(2))
+		Expect(potentialVictimsNodes[0]).To(Equal("node-2"))
+	})
+
+	It("pops only the victim jobs running in the domain", func() {
+		ssn = initializeTopologySession([]string{"node-1", "node-2", "node-1"})
+		victimsQueue := newDomainVictimsQueue(generateVictimsQueue(), map[string]bool{"node-1": true})
+
+		var poppedJobs []*podgroup_info.PodGroupInfo
+		for !victimsQueue.IsEmpty() {
+			poppedJobs = append(poppedJobs, victimsQueue.PopNextJob())
+		}
+		Expect(poppedJobs).To(HaveLen(2))
+		for _, job := range poppedJobs {
+			for _, task := range job.GetAllPodsMap() {
+				Expect(task.NodeName).To(Equal("node-1"))
+			}
+		}
+		Expect(victimsQueue.PopNextJob()).To(BeNil())
+	})
+})
+
+// initializeTopologySession builds a session with a single task, single GPU job running on each of the given nodes.
+func initializeTopologySession(jobNodes []string) *framework.Session {
+	defaultQueue := createQueue("default")
+	defaultQueue.ParentQueue = ""
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{defaultQueue.UID: defaultQueue}
+	teamQueue := createQueue("team-a")
+	queues[teamQueue.UID] = teamQueue
+
+	controller := gomock.NewController(GinkgoT())
+	nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(controller)
+	nodePodAffinityInfo.EXPECT().AddPod(gomock.Any()).AnyTimes()
+	nodePodAffinityInfo.EXPECT().RemovePod(gomock.Any()).AnyTimes()
+
+	nodes := map[string]*node_info.NodeInfo{}
+	podGroupInfos := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+	for jobID, nodeName := range jobNodes {
+		node, found := nodes[nodeName]
+		if !found {
+			node = node_info.NewNodeInfo(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}, nodePodAffinityInfo)
+			nodes[nodeName] = node
+		}
+
+		queueName := fmt.Sprintf("team-%d", jobID)
+		job, jobTasks := createJobWithTasks(1, jobID, queueName, v1.PodRunning, []v1.ResourceRequirements{requireOneGPU()})
+		for _, task := range jobTasks {
+			task.NodeName = nodeName
+			task.Pod.Spec.NodeName = nodeName
+		}
+		node.Allocatable.Add(job.Allocated)
+		node.Idle.Add(job.Allocated)
+		_ = node.AddTasksToNode(jobTasks, map[common_info.PodID]*pod_info.PodInfo{})
+
+		podGroupInfos[job.UID] = job
+		queue := createQueue(queueName)
+		queues[queue.UID] = queue
+	}
+
+	return &framework.Session{
+		ClusterInfo: &api.ClusterInfo{
+			PodGroupInfos: podGroupInfos,
+			Queues:        queues,
+			Nodes:         nodes,
+		},
+	}
+}
@@ -4,6 +4,8 @@
 package consolidation
 
 import (
+	"time"
+
 	"golang.org/x/exp/maps"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
@@ -75,6 +77,27 @@ func (alloc *consolidationAction) Execute(ssn *framework.Session) {
 			smallestFailedJobs.UpdateRepresentative(job)
 		}
 	}
+
+	alloc.attemptToDefragmentTopologies(ssn)
+}
+
+func (alloc *consolidationAction) attemptToDefragmentTopologies(ssn *framework.Session) {
+	// Sessions that only evaluate scheduling decisions, such as what-if and explain requests, do not defragment
+	if !ssn.IsSchedulingCycle() {
+		return
+	}
+	interval := ssn.GetTopologyDefragmentationInterval()
+	if interval <= 0 || time.Since(ssn.SchedulingCycle.LastTopologyDefragmentation) < interval {
+		return
+	}
+	ssn.SchedulingCycle.LastTopologyDefragmentation = time.Now()
+	defragmentTopologies(ssn)
+}
+
+// AttemptToScheduleJob attempts to move running jobs to other nodes to make room for the job.
+func (alloc *consolidationAction) AttemptToScheduleJob(ssn *framework.Session, job *podgroup_info.PodGroupInfo) (
+	bool, *framework.Statement) {
+	return attemptToConsolidateForPreemptor(ssn, job)
 }
 
 func attemptToConsolidateForPreemptor(
@@ -0,0 +1,266 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package consolidation
+
+import (
+	"sort"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	commontopology "github.com/NVIDIA/KAI-scheduler/pkg/common/topology"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// topologyDomain is a snapshot of the GPU usage of a single domain of a topology level.
+type topologyDomain struct {
+	id        string
+	nodes     []*node_info.NodeInfo
+	totalGPUs float64
+	freeGPUs  float64
+	// movableTasks are the GPU tasks running in the domain, grouped by their job. Nil if any GPU task in the domain
+	// cannot be moved, which means the domain cannot be emptied.
+	movableTasks map[common_info.PodGroupID][]*pod_info.PodInfo
+}
+
+func (d *topologyDomain) usedGPUs() float64 {
+	return d.totalGPUs - d.freeGPUs
+}
+
+func (d *topologyDomain) isPartiallyUsed() bool {
+	return d.freeGPUs > 0 && d.usedGPUs() > 0
+}
+
+func (d *topologyDomain) numberOfMovableTasks() int {
+	count := 0
+	for _, tasks := range d.movableTasks {
+		count += len(tasks)
+	}
+	return count
+}
+
+// defragmentTopologies migrates preemptible workloads out of partially used topology domains into other partially
+// used domains of the same level, so that whole domains are freed for jobs that require them. The number of evicted
+// pods is bounded by the session's topology defragmentation eviction budget.
+func defragmentTopologies(ssn *framework.Session) {
+	budget := ssn.GetTopologyDefragmentationEvictionBudget()
+	if budget <= 0 {
+		return
+	}
+
+	topologies := append([]*kaiv1alpha1.Topology{}, ssn.ClusterInfo.Topologies...)
+	sort.Slice(topologies, func(i, j int) bool { return topologies[i].Name < topologies[j].Name })
+
+	stmt := ssn.Statement()
+	for _, topology := range topologies {
+		levels := topology.Spec.Levels
+		for levelIndex := len(levels) - 1; levelIndex >= 0 && budget > 0; levelIndex-- {
+			budget -= defragmentTopologyLevel(ssn, stmt, topology.Name, levels, levelIndex, budget)
+		}
+	}
+
+	if err := stmt.Commit(); err != nil {
+		log.InfraLogger.Errorf("Failed to commit topology defragmentation statement: %v", err)
+	}
+}
+
+// defragmentTopologyLevel empties partially used domains of a single level, one at a time, until no domain can be
+// emptied within the remaining budget. Returns the number of evicted pods.
+func defragmentTopologyLevel(ssn *framework.Session, stmt *framework.Statement, topologyName string,
+	levels []kaiv1alpha1.TopologyLevel, levelIndex int, budget int) int {
+	evicted := 0
+	for {
+		domains := buildTopologyDomains(ssn, levels, levelIndex)
+		logFragmentation(topologyName, levels[levelIndex].NodeLabel, domains)
+
+		movedTasks := 0
+		for _, donor := range getDonorDomains(domains, budget-evicted) {
+			if moveDomainTasks(ssn, stmt, donor, domains) {
+				movedTasks = donor.numberOfMovableTasks()
+				log.InfraLogger.V(3).Infof(
+					"Topology defragmentation freed domain <%s> of level <%s> in topology <%s> by moving <%d> pods",
+					donor.id, levels[levelIndex].NodeLabel, topologyName, movedTasks)
+				break
+			}
+		}
+		if movedTasks == 0 {
+			return evicted
+		}
+		evicted += movedTasks
+	}
+}
+
+func buildTopologyDomains(
+	ssn *framework.Session, levels []kaiv1alpha1.TopologyLevel, levelIndex int) []*topologyDomain {
+	domainsByID := map[string]*topologyDomain{}
+	for _, node := range ssn.ClusterInfo.Nodes {
+		if !commontopology.IsNodePartOfTopology(levels, node.Node.Labels) {
+			continue
+		}
+
+		domainID := commontopology.DomainID(levels, levelIndex, node.Node.Labels)
+		domain, found := domainsByID[domainID]
+		if !found {
+			domain = &topologyDomain{
+				id:           domainID,
+				movableTasks: map[common_info.PodGroupID][]*pod_info.PodInfo{},
+			}
+			domainsByID[domainID] = domain
+		}
+
+		idleGPUs, _ := node.GetSumOfIdleGPUs()
+		releasingGPUs, _ := node.GetSumOfReleasingGPUs()
+		domain.nodes = append(domain.nodes, node)
+		domain.totalGPUs += float64(node.GetNumberOfGPUsInNode())
+		domain.freeGPUs += idleGPUs + releasingGPUs
+		addNodeMovableTasks(ssn, domain, node)
+	}
+
+	domains := make([]*topologyDomain, 0, len(domainsByID))
+	for _, domain := range domainsByID {
+		sort.Slice(domain.nodes, func(i, j int) bool { return domain.nodes[i].Name < domain.nodes[j].Name })
+		domains = append(domains, domain)
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].id < domains[j].id })
+	return domains
+}
+
+func addNodeMovableTasks(ssn *framework.Session, domain *topologyDomain, node *node_info.NodeInfo) {
+	for _, task := range node.PodInfos {
+		if domain.movableTasks == nil {
+			return
+		}
+		if !pod_status.IsActiveAllocatedStatus(task.Status) || !task.IsRequireAnyKindOfGPU() {
+			continue
+		}
+		job, found := ssn.ClusterInfo.PodGroupInfos[task.Job]
+		if !found || !isTaskMovable(job, task) {
+			domain.movableTasks = nil
+			return
+		}
+		domain.movableTasks[job.UID] = append(domain.movableTasks[job.UID], task)
+	}
+}
+
+// isTaskMovable returns true for running pods of preemptible jobs that will be recreated by their owner once evicted.
+// Jobs with a topology constraint are left in place, as their placement was chosen for them.
+func isTaskMovable(job *podgroup_info.PodGroupInfo, task *pod_info.PodInfo) bool {
+	if !job.IsPreemptibleJob() || job.RootSubGroupSet.GetTopologyConstraint() != nil {
+		return false
+	}
+	if task.Status != pod_status.Running || len(task.Pod.OwnerReferences) == 0 {
+		return false
+	}
+	return true
+}
+
+// getDonorDomains returns the partially used domains that can be emptied within the budget and whose GPU usage fits
+// the free GPUs of the other partially used domains, least used first.
+func getDonorDomains(domains []*topologyDomain, budget int) []*topologyDomain {
+	var donors []*topologyDomain
+	for _, domain := range domains {
+		if !domain.isPartiallyUsed() || domain.movableTasks == nil {
+			continue
+		}
+		numberOfTasks := domain.numberOfMovableTasks()
+		if numberOfTasks == 0 || numberOfTasks > budget {
+			continue
+		}
+		if domain.usedGPUs() > recipientsFreeGPUs(domains, domain) {
+			continue
+		}
+		donors = append(donors, domain)
+	}
+	sort.SliceStable(donors, func(i, j int) bool { return donors[i].usedGPUs() < donors[j].usedGPUs() })
+	return donors
+}
+
+func recipientsFreeGPUs(domains []*topologyDomain, donor *topologyDomain) float64 {
+	freeGPUs := 0.0
+	for _, domain := range domains {
+		if domain != donor && domain.isPartiallyUsed() {
+			freeGPUs += domain.freeGPUs
+		}
+	}
+	return freeGPUs
+}
+
+// moveDomainTasks evicts the movable tasks of the donor domain and pipelines them to the other partially used domains.
+// The statement is rolled back to its state before the move if any of the tasks could not be pipelined.
+func moveDomainTasks(
+	ssn *framework.Session, stmt *framework.Statement, donor *topologyDomain, domains []*topologyDomain) bool {
+	var recipientNodes []*node_info.NodeInfo
+	for _, domain := range domains {
+		if domain != donor && domain.isPartiallyUsed() {
+			recipientNodes = append(recipientNodes, domain.nodes...)
+		}
+	}
+
+	jobIDs := make([]common_info.PodGroupID, 0, len(donor.movableTasks))
+	for jobID := range donor.movableTasks {
+		jobIDs = append(jobIDs, jobID)
+	}
+	sort.Slice(jobIDs, func(i, j int) bool { return jobIDs[i] < jobIDs[j] })
+
+	checkpoint := stmt.Checkpoint()
+	for _, jobID := range jobIDs {
+		job := ssn.ClusterInfo.PodGroupInfos[jobID]
+		tasks := donor.movableTasks[jobID]
+		if !evictAndReallocateJobTasks(ssn, stmt, job, tasks, recipientNodes) {
+			if err := stmt.Rollback(checkpoint); err != nil {
+				log.InfraLogger.Errorf("Failed to rollback topology defragmentation of domain <%s>: %v",
+					donor.id, err)
+			}
+			return false
+		}
+	}
+	return true
+}
+
+func evictAndReallocateJobTasks(ssn *framework.Session, stmt *framework.Statement, job *podgroup_info.PodGroupInfo,
+	tasks []*pod_info.PodInfo, recipientNodes []*node_info.NodeInfo) bool {
+	for _, task := range tasks {
+		err := stmt.Evict(task, api.GetConsolidateMessage(task), eviction_info.EvictionMetadata{
+			Action:           string(framework.Consolidation),
+			EvictionGangSize: len(tasks),
+		})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to evict task <%s/%s> for topology defragmentation: %v",
+				task.Namespace, task.Name, err)
+			return false
+		}
+	}
+
+	if !common.AllocateJob(ssn, stmt, recipientNodes, job, true) {
+		return false
+	}
+	for _, task := range tasks {
+		if task.Status == pod_status.Releasing {
+			return false
+		}
+	}
+	return true
+}
+
+func logFragmentation(topologyName, levelLabel string, domains []*topologyDomain) {
+	partiallyUsedDomains := 0
+	scatteredFreeGPUs, totalFreeGPUs := 0.0, 0.0
+	for _, domain := range domains {
+		totalFreeGPUs += domain.freeGPUs
+		if domain.isPartiallyUsed() {
+			partiallyUsedDomains += 1
+			scatteredFreeGPUs += domain.freeGPUs
+		}
+	}
+	log.InfraLogger.V(4).Infof(
+		"Topology <%s> level <%s>: <%d/%d> domains are partially used, holding <%v/%v> of the free GPUs",
+		topologyName, levelLabel, partiallyUsedDomains, len(domains), scatteredFreeGPUs, totalFreeGPUs)
+}
@@ -0,0 +1,364 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package consolidation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "go.uber.org/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/consolidation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+type topologyDefragmentationTestMetadata struct {
+	test_utils.TestTopologyBasic
+	interval       time.Duration
+	evictionBudget int
+}
+
+func TestTopologyDefragmentation(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	testsMetadata := getTopologyDefragmentationTestsMetadata()
+
+	for testNumber, testMetadata := range testsMetadata {
+		fmt.Printf("Running test %d/%d: %s\n", testNumber, len(testsMetadata), testMetadata.Name)
+		ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+		ssn.SchedulerParams.TopologyDefragmentationInterval = testMetadata.interval
+		ssn.SchedulerParams.TopologyDefragmentationEvictionBudget = testMetadata.evictionBudget
+		consolidationAction := consolidation.New()
+		consolidationAction.Execute(ssn)
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
+	}
+}
+
+func TestTopologyDefragmentationInterval(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	consolidationAction := consolidation.New()
+
+	testMetadata := getTopologyDefragmentationTestsMetadata()[0]
+	ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+	ssn.SchedulerParams.TopologyDefragmentationInterval = time.Hour
+	ssn.SchedulerParams.TopologyDefragmentationEvictionBudget = testMetadata.evictionBudget
+	consolidationAction.Execute(ssn)
+	test_utils.MatchExpectedAndRealTasks(t, 0, testMetadata.TestTopologyBasic, ssn)
+	schedulingCycle := ssn.SchedulingCycle
+
+	testMetadata = getTopologyDefragmentationTestsMetadata()[0]
+	testMetadata.JobExpectedResults = map[string]test_utils.TestExpectedResultBasic{
+		"running_job0": {NodeName: "node0", GPUsRequired: 1, Status: pod_status.Running},
+		"running_job1": {NodeName: "node1", GPUsRequired: 1, Status: pod_status.Running},
+	}
+	testMetadata.Mocks = &test_utils.TestMock{CacheRequirements: &test_utils.CacheMocking{}}
+	ssn = test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+	ssn.SchedulingCycle = schedulingCycle
+	ssn.SchedulerParams.TopologyDefragmentationInterval = time.Hour
+	ssn.SchedulerParams.TopologyDefragmentationEvictionBudget = testMetadata.evictionBudget
+	consolidationAction.Execute(ssn)
+	test_utils.MatchExpectedAndRealTasks(t, 1, testMetadata.TestTopologyBasic, ssn)
+}
+
+func TestTopologyDefragmentationOnlyInSchedulingCycle(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	testMetadata := getTopologyDefragmentationTestsMetadata()[0]
+	testMetadata.JobExpectedResults = map[string]test_utils.TestExpectedResultBasic{
+		"running_job0": {NodeName: "node0", GPUsRequired: 1, Status: pod_status.Running},
+		"running_job1": {NodeName: "node1", GPUsRequired: 1, Status: pod_status.Running},
+	}
+	testMetadata.Mocks = &test_utils.TestMock{CacheRequirements: &test_utils.CacheMocking{}}
+	ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+	ssn.SchedulingCycle = nil
+	ssn.SchedulerParams.TopologyDefragmentationInterval = time.Hour
+	ssn.SchedulerParams.TopologyDefragmentationEvictionBudget = testMetadata.evictionBudget
+	consolidation.New().Execute(ssn)
+	test_utils.MatchExpectedAndRealTasks(t, 0, testMetadata.TestTopologyBasic, ssn)
+
+	// A session that is not part of the scheduling cycle does not delay its defragmentation
+	testMetadata = getTopologyDefragmentationTestsMetadata()[0]
+	ssn = test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+	ssn.SchedulerParams.TopologyDefragmentationInterval = time.Hour
+	ssn.SchedulerParams.TopologyDefragmentationEvictionBudget = testMetadata.evictionBudget
+	consolidation.New().Execute(ssn)
+	test_utils.MatchExpectedAndRealTasks(t, 1, testMetadata.TestTopologyBasic, ssn)
+}
+
+func getTopologyDefragmentationTestsMetadata() []topologyDefragmentationTestMetadata {
+	rackTopology := []*kaiv1alpha1.Topology{
+		{
+			ObjectMeta: v1.ObjectMeta{
+				Name: "cluster-topology",
+			},
+			Spec: kaiv1alpha1.TopologySpec{
+				Levels: []kaiv1alpha1.TopologyLevel{
+					{
+						NodeLabel: "k8s.io/rack",
+					},
+				},
+			},
+		},
+	}
+	rackNodes := map[string]nodes_fake.TestNodeBasic{
+		"node0": {
+			GPUs:   2,
+			Labels: map[string]string{"k8s.io/rack": "rack1"},
+		},
+		"node1": {
+			GPUs:   2,
+			Labels: map[string]string{"k8s.io/rack": "rack2"},
+		},
+		"node2": {
+			GPUs:   2,
+			Labels: map[string]string{"k8s.io/rack": "rack3"},
+		},
+	}
+	queues := []test_utils.TestQueueBasic{
+		{
+			Name:         "queue0",
+			DeservedGPUs: 6,
+		},
+	}
+
+	return []topologyDefragmentationTestMetadata{
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name:       "Two partially used racks - move the job of one rack to the other",
+				Topologies: rackTopology,
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "running_job0",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                "running_job1",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node1",
+								State:    pod_status.Running,
+							},
+						},
+					},
+				},
+				Nodes:  rackNodes,
+				Queues: queues,
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"running_job0": {
+						NodeName:     "node1",
+						GPUsRequired: 1,
+						Status:       pod_status.Pipelined,
+					},
+					"running_job1": {
+						NodeName:     "node1",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions:  1,
+						NumberOfPipelineActions: 1,
+					},
+				},
+			},
+			interval:       time.Minute,
+			evictionBudget: 4,
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name:       "Topology defragmentation is disabled",
+				Topologies: rackTopology,
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "running_job0",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                "running_job1",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node1",
+								State:    pod_status.Running,
+							},
+						},
+					},
+				},
+				Nodes:  rackNodes,
+				Queues: queues,
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"running_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+					"running_job1": {
+						NodeName:     "node1",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{},
+				},
+			},
+			interval:       0,
+			evictionBudget: 4,
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name:       "Non preemptible job is not moved",
+				Topologies: rackTopology,
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "running_job0",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityBuildNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                "running_job1",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityBuildNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node1",
+								State:    pod_status.Running,
+							},
+						},
+					},
+				},
+				Nodes:  rackNodes,
+				Queues: queues,
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"running_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+					"running_job1": {
+						NodeName:     "node1",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{},
+				},
+			},
+			interval:       time.Minute,
+			evictionBudget: 4,
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name:       "Emptying a rack exceeds the eviction budget",
+				Topologies: rackTopology,
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "running_job0",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                "running_job1",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node1",
+								State:    pod_status.Running,
+							},
+							{
+								NodeName: "node1",
+								State:    pod_status.Running,
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs:   4,
+						Labels: map[string]string{"k8s.io/rack": "rack1"},
+					},
+					"node1": {
+						GPUs:   4,
+						Labels: map[string]string{"k8s.io/rack": "rack2"},
+					},
+				},
+				Queues: queues,
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"running_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 2,
+						Status:       pod_status.Running,
+					},
+					"running_job1": {
+						NodeName:     "node1",
+						GPUsRequired: 2,
+						Status:       pod_status.Running,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{},
+				},
+			},
+			interval:       time.Minute,
+			evictionBudget: 1,
+		},
+	}
+}
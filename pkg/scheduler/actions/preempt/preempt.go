@@ -96,6 +96,13 @@ func (alloc *preemptAction) Execute(ssn *framework.Session) {
 	}
 }
 
+// AttemptToScheduleJob attempts to preempt lower priority jobs of the job's queue for the job.
+func (alloc *preemptAction) AttemptToScheduleJob(ssn *framework.Session, job *podgroup_info.PodGroupInfo) (
+	bool, *framework.Statement) {
+	succeeded, statement, _ := attemptToPreemptForPreemptor(ssn, job)
+	return succeeded, statement
+}
+
 func attemptToPreemptForPreemptor(
 	ssn *framework.Session, preemptor *podgroup_info.PodGroupInfo,
 ) (bool, *framework.Statement, []string) {
@@ -99,6 +99,16 @@ func (ra *reclaimAction) Execute(ssn *framework.Session) {
 	}
 }
 
+// AttemptToScheduleJob attempts to reclaim resources for the job from other queues.
+func (ra *reclaimAction) AttemptToScheduleJob(ssn *framework.Session, job *podgroup_info.PodGroupInfo) (
+	bool, *framework.Statement) {
+	if !ssn.CanReclaimResources(job) {
+		return false, nil
+	}
+	succeeded, statement, _ := ra.attemptToReclaimForSpecificJob(ssn, job)
+	return succeeded, statement
+}
+
 func (ra *reclaimAction) attemptToReclaimForSpecificJob(
 	ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo,
 ) (bool, *framework.Statement, []string) {
@@ -0,0 +1,148 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim_test
+
+import (
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestHandleReclaimSharedGpu(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	defer gock.Off()
+
+	testsMetadata := getReclaimSharedGpuTestsMetadata()
+	for testNumber, testMetadata := range testsMetadata {
+		t.Logf("Running test number: %v, test name: %v,", testNumber, testMetadata.TestTopologyBasic.Name)
+		ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+		reclaimAction := reclaim.New()
+		reclaimAction.Execute(ssn)
+
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
+	}
+}
+
+func getReclaimSharedGpuTestsMetadata() []integration_tests_utils.TestTopologyMetadata {
+	return []integration_tests_utils.TestTopologyMetadata{
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "don't reclaim a fraction that leaves its shared gpu unusable for the reclaimer",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "q2_protected",
+						RequiredGPUsPerTask: 0.75,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue2",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:  "node0",
+								State:     pod_status.Running,
+								GPUGroups: []string{"0"},
+							},
+						},
+					},
+					{
+						Name:                "q0_low_priority",
+						RequiredGPUsPerTask: 0.25,
+						Priority:            constants.PriorityBuildNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:  "node0",
+								State:     pod_status.Running,
+								GPUGroups: []string{"0"},
+							},
+						},
+					},
+					{
+						Name:                "q0_high_priority",
+						RequiredGPUsPerTask: 0.5,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:  "node0",
+								State:     pod_status.Running,
+								GPUGroups: []string{"1"},
+							},
+						},
+					},
+					{
+						Name:                "q1_pending",
+						RequiredGPUsPerTask: 0.75,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State: pod_status.Pending,
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {GPUs: 2},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:         "queue0",
+						DeservedGPUs: 0,
+					},
+					{
+						Name:         "queue1",
+						DeservedGPUs: 1,
+					},
+					{
+						Name:         "queue2",
+						DeservedGPUs: 1,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"q2_protected": {
+						GPUsRequired: 0.75,
+						Status:       pod_status.Running,
+						NodeName:     "node0",
+						GPUGroups:    []string{"0"},
+					},
+					"q0_low_priority": {
+						GPUsRequired: 0.25,
+						Status:       pod_status.Running,
+						NodeName:     "node0",
+						GPUGroups:    []string{"0"},
+					},
+					"q0_high_priority": {
+						GPUsRequired: 0.5,
+						Status:       pod_status.Releasing,
+						NodeName:     "node0",
+						GPUGroups:    []string{"1"},
+					},
+					"q1_pending": {
+						GPUsRequired: 0.75,
+						Status:       pod_status.Pipelined,
+						NodeName:     "node0",
+						GPUGroups:    []string{"1"},
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions:  1,
+						NumberOfPipelineActions: 1,
+					},
+				},
+			},
+		},
+	}
+}
@@ -81,10 +81,15 @@ func NewBindRequestInfo(
 	}
 }
 
+// IsFailed returns true when the binder gave up on the BindRequest, and the pod should be scheduled again.
 func (bri *BindRequestInfo) IsFailed() bool {
 	if bri.BindRequest.Status.Phase != schedulingv1alpha2.BindRequestPhaseFailed {
 		return false
 	}
+	// The binder classified the failure, and sets a retry time for as long as it retries the binding
+	if bri.BindRequest.Status.FailureClass != "" {
+		return bri.BindRequest.Status.NextRetryTime == nil
+	}
 	if bri.BindRequest.Spec.BackoffLimit == nil {
 		return true
 	}
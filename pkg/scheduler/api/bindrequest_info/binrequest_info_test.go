@@ -0,0 +1,68 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package bindrequest_info
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+)
+
+func TestBindRequestInfo_IsFailed(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		spec     schedulingv1alpha2.BindRequestSpec
+		status   schedulingv1alpha2.BindRequestStatus
+		expected bool
+	}{
+		{
+			name:     "pending",
+			status:   schedulingv1alpha2.BindRequestStatus{Phase: schedulingv1alpha2.BindRequestPhasePending},
+			expected: false,
+		},
+		{
+			name:     "failed without backoff limit",
+			status:   schedulingv1alpha2.BindRequestStatus{Phase: schedulingv1alpha2.BindRequestPhaseFailed},
+			expected: true,
+		},
+		{
+			name: "failed below backoff limit",
+			spec: schedulingv1alpha2.BindRequestSpec{BackoffLimit: ptr.To(int32(3))},
+			status: schedulingv1alpha2.BindRequestStatus{
+				Phase: schedulingv1alpha2.BindRequestPhaseFailed, FailedAttempts: 1,
+			},
+			expected: false,
+		},
+		{
+			name: "binder retries the failure",
+			status: schedulingv1alpha2.BindRequestStatus{
+				Phase:         schedulingv1alpha2.BindRequestPhaseFailed,
+				FailureClass:  schedulingv1alpha2.BindFailureClassTransient,
+				NextRetryTime: ptr.To(metav1.Now()),
+			},
+			expected: false,
+		},
+		{
+			name: "binder gave up below backoff limit",
+			spec: schedulingv1alpha2.BindRequestSpec{BackoffLimit: ptr.To(int32(3))},
+			status: schedulingv1alpha2.BindRequestStatus{
+				Phase:        schedulingv1alpha2.BindRequestPhaseFailed,
+				FailureClass: schedulingv1alpha2.BindFailureClassNodeGone,
+			},
+			expected: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			bindRequestInfo := NewBindRequestInfo(&schedulingv1alpha2.BindRequest{
+				Spec:   test.spec,
+				Status: test.status,
+			})
+			assert.Equal(t, test.expected, bindRequestInfo.IsFailed())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"golang.org/x/exp/maps"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
@@ -23,6 +24,9 @@ type GpuSharingNodeInfo struct {
 	UsedSharedGPUsMemory      map[string]int64
 	ReleasingSharedGPUsMemory map[string]int64
 	AllocatedSharedGPUsMemory map[string]int64
+
+	// SharedGPUsIsolationModes counts the non-releasing shared tasks of every isolation mode on each gpu group
+	SharedGPUsIsolationModes map[string]map[resources.GpuIsolationMode]int
 }
 
 func newGpuSharingNodeInfo() *GpuSharingNodeInfo {
@@ -32,6 +36,8 @@ func newGpuSharingNodeInfo() *GpuSharingNodeInfo {
 		UsedSharedGPUsMemory:      make(map[string]int64),
 		ReleasingSharedGPUsMemory: make(map[string]int64),
 		AllocatedSharedGPUsMemory: make(map[string]int64),
+
+		SharedGPUsIsolationModes: make(map[string]map[resources.GpuIsolationMode]int),
 	}
 }
 
@@ -50,6 +56,9 @@ func (g *GpuSharingNodeInfo) Clone() *GpuSharingNodeInfo {
 	for k, v := range g.AllocatedSharedGPUsMemory {
 		gpuSharingNodeInfo.AllocatedSharedGPUsMemory[k] = v
 	}
+	for k, v := range g.SharedGPUsIsolationModes {
+		gpuSharingNodeInfo.SharedGPUsIsolationModes[k] = maps.Clone(v)
+	}
 
 	return gpuSharingNodeInfo
 }
@@ -95,6 +104,7 @@ func (ni *NodeInfo) addSharedTaskResourcesPerPodGroup(task *pod_info.PodInfo, gp
 		ni.UsedSharedGPUsMemory[gpuGroup])
 
 	ni.UsedSharedGPUsMemory[gpuGroup] += ni.GetResourceGpuMemory(task.ResReq)
+	ni.addSharedTaskIsolationMode(task, gpuGroup)
 
 	switch task.Status {
 	case pod_status.Releasing:
@@ -169,6 +179,7 @@ func (ni *NodeInfo) removeSharedTaskResourcesPerPodGroup(task *pod_info.PodInfo,
 		ni.UsedSharedGPUsMemory[gpuGroup])
 
 	ni.UsedSharedGPUsMemory[gpuGroup] -= ni.GetResourceGpuMemory(task.ResReq)
+	ni.removeSharedTaskIsolationMode(task, gpuGroup)
 
 	switch task.Status {
 	case pod_status.Releasing:
@@ -231,6 +242,35 @@ func (ni *NodeInfo) removeSharedTaskResourcesPerPodGroup(task *pod_info.PodInfo,
 		ni.UsedSharedGPUsMemory[gpuGroup])
 }
 
+// addSharedTaskIsolationMode tracks the isolation mode of the task on the gpu group. Releasing tasks are not tracked,
+// so tasks of a different isolation mode can be pipelined to a gpu group that is being released.
+func (ni *NodeInfo) addSharedTaskIsolationMode(task *pod_info.PodInfo, gpuGroup string) {
+	if task.Status == pod_status.Releasing {
+		return
+	}
+	if _, found := ni.SharedGPUsIsolationModes[gpuGroup]; !found {
+		ni.SharedGPUsIsolationModes[gpuGroup] = map[resources.GpuIsolationMode]int{}
+	}
+	ni.SharedGPUsIsolationModes[gpuGroup][task.GpuIsolationMode] += 1
+}
+
+func (ni *NodeInfo) removeSharedTaskIsolationMode(task *pod_info.PodInfo, gpuGroup string) {
+	if task.Status == pod_status.Releasing {
+		return
+	}
+	isolationModes, found := ni.SharedGPUsIsolationModes[gpuGroup]
+	if !found {
+		return
+	}
+	isolationModes[task.GpuIsolationMode] -= 1
+	if isolationModes[task.GpuIsolationMode] <= 0 {
+		delete(isolationModes, task.GpuIsolationMode)
+	}
+	if len(isolationModes) == 0 {
+		delete(ni.SharedGPUsIsolationModes, gpuGroup)
+	}
+}
+
 func (ni *NodeInfo) isPipelinedToReleasingGpu(task *pod_info.PodInfo, gpuGroup string) bool {
 	usedMemoryBeforeRemoval := ni.UsedSharedGPUsMemory[gpuGroup] + ni.GetResourceGpuMemory(task.ResReq)
 	releasingMemoryBeforeRemoval := ni.ReleasingSharedGPUsMemory[gpuGroup] - ni.GetResourceGpuMemory(task.ResReq)
@@ -333,7 +373,7 @@ func (ni *NodeInfo) getGpuMemoryFractionalOnNode(memory int64) float64 {
 func (ni *NodeInfo) fractionTaskGpusAllocatableDeviceCount(pod *pod_info.PodInfo) int64 {
 	matchingGpuGroupsCount := int64(0)
 	for gpuGroup := range ni.UsedSharedGPUsMemory {
-		if ni.IsTaskFitOnGpuGroup(pod.ResReq, gpuGroup) {
+		if ni.IsTaskFitOnGpuGroup(pod, gpuGroup) {
 			matchingGpuGroupsCount += 1
 			if matchingGpuGroupsCount >= pod.ResReq.GetNumOfGpuDevices() {
 				return matchingGpuGroupsCount
@@ -344,10 +384,22 @@ func (ni *NodeInfo) fractionTaskGpusAllocatableDeviceCount(pod *pod_info.PodInfo
 	return matchingGpuGroupsCount
 }
 
-func (ni *NodeInfo) IsTaskFitOnGpuGroup(resourceRequest *resource_info.ResourceRequirements, gpuGroup string) bool {
+func (ni *NodeInfo) IsTaskFitOnGpuGroup(task *pod_info.PodInfo, gpuGroup string) bool {
 	return ni.UsedSharedGPUsMemory[gpuGroup] != 0 &&
-		ni.enoughResourcesOnGpu(resourceRequest, gpuGroup) &&
-		!ni.isAllGpuReleased(gpuGroup)
+		ni.enoughResourcesOnGpu(task.ResReq, gpuGroup) &&
+		!ni.isAllGpuReleased(gpuGroup) &&
+		ni.isGpuGroupIsolationCompatible(task, gpuGroup)
+}
+
+// isGpuGroupIsolationCompatible checks that all the tasks on the gpu group use the isolation mode of the task,
+// as a device can't be shared between, for example, MPS clients and non-MPS processes.
+func (ni *NodeInfo) isGpuGroupIsolationCompatible(task *pod_info.PodInfo, gpuGroup string) bool {
+	for isolationMode, tasksCount := range ni.SharedGPUsIsolationModes[gpuGroup] {
+		if isolationMode != task.GpuIsolationMode && tasksCount > 0 {
+			return false
+		}
+	}
+	return true
 }
 
 func (ni *NodeInfo) EnoughIdleResourcesOnGpu(resources *resource_info.ResourceRequirements, gpuGroup string) bool {
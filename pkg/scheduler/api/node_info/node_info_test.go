@@ -36,6 +36,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -379,6 +380,9 @@ func TestAddRemovePods(t *testing.T) {
 					sharingMaps.UsedSharedGPUsMemory["1"] = 50
 					sharingMaps.UsedSharedGPUsMemory["2"] = 50
 					sharingMaps.AllocatedSharedGPUsMemory["1"] = 50
+					sharingMaps.SharedGPUsIsolationModes["2"] = map[resources.GpuIsolationMode]int{
+						resources.GpuIsolationNone: 1,
+					}
 
 					return sharingMaps
 				}(),
@@ -455,6 +459,9 @@ func TestAddRemovePods(t *testing.T) {
 					sharingMaps.ReleasingSharedGPUsMemory["1"] = 0
 					sharingMaps.UsedSharedGPUsMemory["1"] = 120
 					sharingMaps.AllocatedSharedGPUsMemory["1"] = 70
+					sharingMaps.SharedGPUsIsolationModes["1"] = map[resources.GpuIsolationMode]int{
+						resources.GpuIsolationNone: 2,
+					}
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
@@ -1404,3 +1411,81 @@ func TestResourceReservationPodConsumesMaxPods(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTaskFitOnGpuGroup_IsolationModes(t *testing.T) {
+	tests := []struct {
+		name                  string
+		allocatedIsolation    string
+		allocatedStatus       pod_status.PodStatus
+		taskIsolation         string
+		expectedFitOnGpuGroup bool
+	}{
+		{
+			name:                  "same isolation mode",
+			allocatedIsolation:    "mps",
+			allocatedStatus:       pod_status.Running,
+			taskIsolation:         "mps",
+			expectedFitOnGpuGroup: true,
+		},
+		{
+			name:                  "mps task on a gpu shared without isolation",
+			allocatedIsolation:    "",
+			allocatedStatus:       pod_status.Running,
+			taskIsolation:         "mps",
+			expectedFitOnGpuGroup: false,
+		},
+		{
+			name:                  "task without isolation on an mps gpu",
+			allocatedIsolation:    "mps",
+			allocatedStatus:       pod_status.Running,
+			taskIsolation:         "",
+			expectedFitOnGpuGroup: false,
+		},
+		{
+			name:                  "time-slicing task on an mps gpu",
+			allocatedIsolation:    "mps",
+			allocatedStatus:       pod_status.Running,
+			taskIsolation:         "time-slicing",
+			expectedFitOnGpuGroup: false,
+		},
+		{
+			name:                  "pipelined mps task on an mps gpu",
+			allocatedIsolation:    "mps",
+			allocatedStatus:       pod_status.Pipelined,
+			taskIsolation:         "mps",
+			expectedFitOnGpuGroup: true,
+		},
+	}
+
+	buildFractionPod := func(name, isolationMode string) *v1.Pod {
+		annotations := map[string]string{
+			pod_info.ReceivedResourceTypeAnnotationName: string(pod_info.ReceivedTypeFraction),
+			commonconstants.PodGroupAnnotationForPod:    common_info.FakePogGroupId,
+		}
+		if isolationMode != "" {
+			annotations[commonconstants.GpuIsolationAnnotation] = isolationMode
+		}
+		return common_info.BuildPod("c1", name, "n1", v1.PodRunning,
+			common_info.BuildResourceListWithGPU("1000m", "1G", "250m"), []metav1.OwnerReference{},
+			make(map[string]string), annotations)
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := NewController(t)
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(controller)
+			nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+
+			node := common_info.BuildNode("n1", common_info.BuildResourceListWithGPUAndPods("8000m", "10G", "2", "110"))
+			ni := NewNodeInfo(node, nodePodAffinityInfo)
+
+			allocatedTask := pod_info.NewTaskInfo(buildFractionPod("p1", test.allocatedIsolation))
+			allocatedTask.Status = test.allocatedStatus
+			allocatedTask.GPUGroups = []string{"1"}
+			assert.NoError(t, ni.AddTask(allocatedTask))
+
+			task := pod_info.NewTaskInfo(buildFractionPod("p2", test.taskIsolation))
+			assert.Equal(t, test.expectedFitOnGpuGroup, ni.IsTaskFitOnGpuGroup(task, "1"))
+		})
+	}
+}
@@ -87,6 +87,9 @@ type PodInfo struct {
 
 	GPUGroups []string
 
+	// GpuIsolationMode is the isolation the pod requires from the other pods that share its GPU devices
+	GpuIsolationMode resources.GpuIsolationMode
+
 	NodeName        string
 	Status          pod_status.PodStatus
 	IsVirtualStatus bool
@@ -194,6 +197,7 @@ func NewTaskInfoWithBindRequest(pod *v1.Pod, bindRequest *bindrequest_info.BindR
 		ResReq:                         initResreq,
 		AcceptedResource:               resource_info.EmptyResourceRequirements(),
 		GPUGroups:                      []string{},
+		GpuIsolationMode:               resources.GpuIsolationNone,
 		ResourceRequestType:            RequestTypeRegular,
 		ResourceReceivedType:           ReceivedTypeNone,
 		BindRequest:                    bindRequest,
@@ -263,6 +267,7 @@ func (pi *PodInfo) Clone() *PodInfo {
 		ResReq:               pi.ResReq.Clone(),
 		AcceptedResource:     pi.AcceptedResource.Clone(),
 		GPUGroups:            pi.GPUGroups,
+		GpuIsolationMode:     pi.GpuIsolationMode,
 		ResourceClaimInfo:    pi.ResourceClaimInfo.Clone(),
 		ResourceRequestType:  pi.ResourceRequestType,
 		ResourceReceivedType: pi.ResourceReceivedType,
@@ -456,6 +461,13 @@ func (pi *PodInfo) updatePodAdditionalFields(bindRequest *bindrequest_info.BindR
 	}
 
 	if pi.ResourceRequestType == RequestTypeFraction || pi.ResourceRequestType == RequestTypeGpuMemory {
+		gpuIsolationMode, err := resources.GetGpuIsolationMode(pi.Pod)
+		if err != nil {
+			log.InfraLogger.V(2).Warnf("Invalid GPU isolation mode for pod %s/%s, it will not be scheduled: %v",
+				pi.Namespace, pi.Name, err)
+		}
+		pi.GpuIsolationMode = gpuIsolationMode
+
 		numFractionDevicesStr, found := pi.Pod.Annotations[commonconstants.GpuFractionsNumDevices]
 		if found && numFractionDevicesStr != "" {
 			numFractionDevices, numFractionDevicesErr := strconv.ParseInt(numFractionDevicesStr, 10, 64)
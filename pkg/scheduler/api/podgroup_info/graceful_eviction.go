@@ -0,0 +1,107 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_info
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// GracefulEvictionInfo holds the state of the graceful eviction protocol of a pod group. A pod group that declares a
+// graceful eviction window is not evicted right away: the scheduler requests the preemption, and deletes the
+// requested pods only once the workload acknowledged the request or the window has passed.
+type GracefulEvictionInfo struct {
+	Window                *time.Duration
+	RequestedTimestamp    *time.Time
+	RequestedPods         []string
+	AcknowledgedTimestamp *time.Time
+}
+
+func (gei *GracefulEvictionInfo) IsEnabled() bool {
+	return gei.Window != nil && *gei.Window > 0
+}
+
+func (gei *GracefulEvictionInfo) IsPreemptionRequested() bool {
+	return gei.RequestedTimestamp != nil
+}
+
+// IsAcknowledged returns true if the workload acknowledged the current preemption request.
+func (gei *GracefulEvictionInfo) IsAcknowledged() bool {
+	return gei.IsPreemptionRequested() && gei.AcknowledgedTimestamp != nil &&
+		!gei.AcknowledgedTimestamp.Before(*gei.RequestedTimestamp)
+}
+
+// IsReadyForEviction returns true if the requested pods may be deleted: the workload acknowledged the request, or
+// the graceful eviction window has passed.
+func (gei *GracefulEvictionInfo) IsReadyForEviction(now time.Time) bool {
+	if !gei.IsPreemptionRequested() {
+		return false
+	}
+	if !gei.IsEnabled() || gei.IsAcknowledged() {
+		return true
+	}
+	return !now.Before(gei.RequestedTimestamp.Add(*gei.Window))
+}
+
+// RequestPreemption requests the preemption of the pod, starting the graceful eviction window if it was not started
+// yet. Returns true if the pod was not requested before.
+func (gei *GracefulEvictionInfo) RequestPreemption(podName string, now time.Time) bool {
+	if !gei.IsPreemptionRequested() {
+		requestTime := now.UTC().Truncate(time.Second)
+		gei.RequestedTimestamp = &requestTime
+		gei.RequestedPods = nil
+	}
+	if slices.Contains(gei.RequestedPods, podName) {
+		return false
+	}
+	gei.RequestedPods = append(gei.RequestedPods, podName)
+	slices.Sort(gei.RequestedPods)
+	return true
+}
+
+// ClearPreemptionRequest ends the current preemption request.
+func (gei *GracefulEvictionInfo) ClearPreemptionRequest() {
+	gei.RequestedTimestamp = nil
+	gei.RequestedPods = nil
+}
+
+func (pgi *PodGroupInfo) setGracefulEviction(pg *enginev2alpha2.PodGroup) {
+	if windowAnnotation := pg.Annotations[commonconstants.GracefulEvictionWindow]; windowAnnotation != "" {
+		window, err := time.ParseDuration(windowAnnotation)
+		if err != nil {
+			log.InfraLogger.V(7).Warnf("Failed to parse graceful eviction window for podgroup <%s> err: %v",
+				pgi.NamespacedName, err)
+		} else {
+			pgi.GracefulEviction.Window = &window
+		}
+	}
+
+	pgi.GracefulEviction.RequestedTimestamp = pgi.parseTimeStampAnnotation(pg,
+		commonconstants.PreemptionRequestedTimeStamp)
+	pgi.GracefulEviction.AcknowledgedTimestamp = pgi.parseTimeStampAnnotation(pg,
+		commonconstants.PreemptionAcknowledgedTimeStamp)
+	if pgi.GracefulEviction.RequestedTimestamp != nil {
+		if requestedPods := pg.Annotations[commonconstants.PreemptionRequestedPods]; requestedPods != "" {
+			pgi.GracefulEviction.RequestedPods = strings.Split(requestedPods, ",")
+		}
+	}
+}
+
+func (pgi *PodGroupInfo) parseTimeStampAnnotation(pg *enginev2alpha2.PodGroup, annotation string) *time.Time {
+	if pg.Annotations[annotation] == "" {
+		return nil
+	}
+	timeStamp, err := time.Parse(time.RFC3339, pg.Annotations[annotation])
+	if err != nil {
+		log.InfraLogger.V(7).Warnf("Failed to parse %s annotation for podgroup <%s> err: %v",
+			annotation, pgi.NamespacedName, err)
+		return nil
+	}
+	return &timeStamp
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_info
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestGracefulEvictionIsReadyForEviction(t *testing.T) {
+	requested := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name             string
+		gracefulEviction GracefulEvictionInfo
+		now              time.Time
+		expectedReady    bool
+	}{
+		{
+			name:             "no preemption request",
+			gracefulEviction: GracefulEvictionInfo{Window: ptr.To(10 * time.Minute)},
+			now:              requested,
+			expectedReady:    false,
+		},
+		{
+			name: "within the window",
+			gracefulEviction: GracefulEvictionInfo{
+				Window: ptr.To(10 * time.Minute), RequestedTimestamp: &requested,
+			},
+			now:           requested.Add(5 * time.Minute),
+			expectedReady: false,
+		},
+		{
+			name: "window has passed",
+			gracefulEviction: GracefulEvictionInfo{
+				Window: ptr.To(10 * time.Minute), RequestedTimestamp: &requested,
+			},
+			now:           requested.Add(10 * time.Minute),
+			expectedReady: true,
+		},
+		{
+			name: "acknowledged within the window",
+			gracefulEviction: GracefulEvictionInfo{
+				Window: ptr.To(10 * time.Minute), RequestedTimestamp: &requested,
+				AcknowledgedTimestamp: ptr.To(requested.Add(time.Minute)),
+			},
+			now:           requested.Add(2 * time.Minute),
+			expectedReady: true,
+		},
+		{
+			name: "acknowledgement of an earlier request",
+			gracefulEviction: GracefulEvictionInfo{
+				Window: ptr.To(10 * time.Minute), RequestedTimestamp: &requested,
+				AcknowledgedTimestamp: ptr.To(requested.Add(-time.Hour)),
+			},
+			now:           requested.Add(2 * time.Minute),
+			expectedReady: false,
+		},
+		{
+			name:             "window was removed",
+			gracefulEviction: GracefulEvictionInfo{RequestedTimestamp: &requested},
+			now:              requested,
+			expectedReady:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedReady, tt.gracefulEviction.IsReadyForEviction(tt.now))
+		})
+	}
+}
+
+func TestGracefulEvictionRequestPreemption(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 500, time.UTC)
+	gracefulEviction := GracefulEvictionInfo{Window: ptr.To(time.Minute)}
+
+	assert.True(t, gracefulEviction.RequestPreemption("pod-b", now))
+	assert.True(t, gracefulEviction.RequestPreemption("pod-a", now.Add(time.Minute)))
+	assert.False(t, gracefulEviction.RequestPreemption("pod-a", now.Add(time.Minute)))
+	assert.Equal(t, now.Truncate(time.Second), *gracefulEviction.RequestedTimestamp)
+	assert.Equal(t, []string{"pod-a", "pod-b"}, gracefulEviction.RequestedPods)
+
+	gracefulEviction.ClearPreemptionRequest()
+	assert.False(t, gracefulEviction.IsPreemptionRequested())
+	assert.Empty(t, gracefulEviction.RequestedPods)
+}
+
+func TestSetPodGroupGracefulEviction(t *testing.T) {
+	pgi := NewPodGroupInfo("pg")
+	pgi.SetPodGroup(&enginev2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pg",
+			Namespace: "ns",
+			Annotations: map[string]string{
+				commonconstants.GracefulEvictionWindow:          "15m",
+				commonconstants.PreemptionRequestedTimeStamp:    "2025-06-01T12:00:00Z",
+				commonconstants.PreemptionRequestedPods:         "pod-a,pod-b",
+				commonconstants.PreemptionAcknowledgedTimeStamp: "2025-06-01T12:05:00Z",
+			},
+		},
+	})
+
+	assert.Equal(t, 15*time.Minute, *pgi.GracefulEviction.Window)
+	assert.Equal(t, time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC), *pgi.GracefulEviction.RequestedTimestamp)
+	assert.Equal(t, []string{"pod-a", "pod-b"}, pgi.GracefulEviction.RequestedPods)
+	assert.True(t, pgi.GracefulEviction.IsAcknowledged())
+}
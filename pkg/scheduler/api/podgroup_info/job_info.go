@@ -81,6 +81,7 @@ type PodGroupInfo struct {
 
 	CreationTimestamp  metav1.Time
 	LastStartTimestamp *time.Time
+	ExpectedRuntime    *time.Duration
 	PodGroup           *enginev2alpha2.PodGroup
 	PodGroupUID        types.UID
 
@@ -88,6 +89,13 @@ type PodGroupInfo struct {
 	PodSets         map[string]*subgroup_info.PodSet
 
 	StalenessInfo
+	GracefulEviction GracefulEvictionInfo
+	// PlacedTopologyLevels are the topology levels, by sub-group name, that sub-groups with a required topology level
+	// fallback ladder were scheduled within in the current session.
+	PlacedTopologyLevels map[string]string
+	// ElasticTargetMember is the number of pods, computed in the current session, that an elastic pod group should
+	// scale to.
+	ElasticTargetMember *int32
 
 	schedulingConstraintsSignature common_info.SchedulingConstraintsSignature
 
@@ -147,6 +155,24 @@ func (pgi *PodGroupInfo) IsPreemptibleJob() bool {
 	return pgi.Preemptibility == enginev2alpha2.Preemptible
 }
 
+// GetPendingSince returns the time the pod group has been waiting to be scheduled since: its creation, or its last
+// start if it has started since.
+func (pgi *PodGroupInfo) GetPendingSince() time.Time {
+	if pgi.LastStartTimestamp != nil && pgi.LastStartTimestamp.After(pgi.CreationTimestamp.Time) {
+		return *pgi.LastStartTimestamp
+	}
+	return pgi.CreationTimestamp.Time
+}
+
+// ExpectedEndTime returns the time at which the pod group is expected to finish, if it declared an expected runtime
+// and has started running.
+func (pgi *PodGroupInfo) ExpectedEndTime() (time.Time, bool) {
+	if pgi.ExpectedRuntime == nil || pgi.LastStartTimestamp == nil {
+		return time.Time{}, false
+	}
+	return pgi.LastStartTimestamp.Add(*pgi.ExpectedRuntime), true
+}
+
 func (pgi *PodGroupInfo) SetPodGroup(pg *enginev2alpha2.PodGroup) {
 	pgi.Name = pg.Name
 	pgi.Namespace = pg.Namespace
@@ -182,6 +208,18 @@ func (pgi *PodGroupInfo) SetPodGroup(pg *enginev2alpha2.PodGroup) {
 		}
 	}
 
+	if pg.Annotations[commonconstants.ExpectedRuntime] != "" {
+		expectedRuntime, err := time.ParseDuration(pg.Annotations[commonconstants.ExpectedRuntime])
+		if err != nil {
+			log.InfraLogger.V(7).Warnf("Failed to parse expected runtime for podgroup <%s> err: %v",
+				pgi.NamespacedName, err)
+		} else {
+			pgi.ExpectedRuntime = &expectedRuntime
+		}
+	}
+
+	pgi.setGracefulEviction(pg)
+
 	log.InfraLogger.V(7).Infof(
 		"SetPodGroup. podGroupName=<%s>, PodGroupUID=<%s> pgi.PodGroupIndex=<%d>",
 		pgi.Name, pgi.PodGroupUID)
@@ -21,11 +21,7 @@ func FromPodGroup(podGroup *v2alpha2.PodGroup) (*SubGroupSet, error) {
 
 	var topologyConstraint *topology_info.TopologyConstraintInfo
 	if podGroup.Spec.TopologyConstraint.Topology != "" {
-		topologyConstraint = &topology_info.TopologyConstraintInfo{
-			Topology:       podGroup.Spec.TopologyConstraint.Topology,
-			RequiredLevel:  podGroup.Spec.TopologyConstraint.RequiredTopologyLevel,
-			PreferredLevel: podGroup.Spec.TopologyConstraint.PreferredTopologyLevel,
-		}
+		topologyConstraint = newTopologyConstraintInfo(&podGroup.Spec.TopologyConstraint)
 	}
 	root := NewSubGroupSet(RootSubGroupSetName, topologyConstraint)
 	subGroupSets := map[string]*SubGroupSet{
@@ -64,11 +60,7 @@ func createSubGroupInfos(allSubGroups map[string]*v2alpha2.SubGroup, children ma
 	for name, subGroup := range allSubGroups {
 		var topologyConstrainInfo *topology_info.TopologyConstraintInfo
 		if subGroup.TopologyConstraint != nil {
-			topologyConstrainInfo = &topology_info.TopologyConstraintInfo{
-				Topology:       subGroup.TopologyConstraint.Topology,
-				RequiredLevel:  subGroup.TopologyConstraint.RequiredTopologyLevel,
-				PreferredLevel: subGroup.TopologyConstraint.PreferredTopologyLevel,
-			}
+			topologyConstrainInfo = newTopologyConstraintInfo(subGroup.TopologyConstraint)
 		}
 		_, hasChildren := children[name]
 		if hasChildren {
@@ -79,6 +71,22 @@ func createSubGroupInfos(allSubGroups map[string]*v2alpha2.SubGroup, children ma
 	}
 }
 
+func newTopologyConstraintInfo(constraint *v2alpha2.TopologyConstraint) *topology_info.TopologyConstraintInfo {
+	topologyConstraint := &topology_info.TopologyConstraintInfo{
+		Topology:       constraint.Topology,
+		RequiredLevel:  constraint.RequiredTopologyLevel,
+		PreferredLevel: constraint.PreferredTopologyLevel,
+	}
+	for _, fallback := range constraint.RequiredTopologyLevelFallbacks {
+		topologyConstraint.RequiredLevelFallbacks = append(topologyConstraint.RequiredLevelFallbacks,
+			topology_info.RequiredLevelFallback{
+				Level:       fallback.TopologyLevel,
+				WaitTimeout: fallback.WaitTimeout.Duration,
+			})
+	}
+	return topologyConstraint
+}
+
 func addToParent(allSubGroups map[string]*v2alpha2.SubGroup, subGroupSets map[string]*SubGroupSet,
 	podSets map[string]*PodSet) error {
 	for name, subGroupSet := range subGroupSets {
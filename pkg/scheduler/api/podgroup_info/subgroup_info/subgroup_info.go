@@ -29,6 +29,10 @@ func (sgi *SubGroupInfo) GetTopologyConstraint() *topology_info.TopologyConstrai
 	return sgi.topologyConstraint
 }
 
+func (sgi *SubGroupInfo) SetTopologyConstraint(topologyConstraint *topology_info.TopologyConstraintInfo) {
+	sgi.topologyConstraint = topologyConstraint
+}
+
 func (sgi *SubGroupInfo) SetParent(parent *SubGroupSet) {
 	sgi.parent = parent
 }
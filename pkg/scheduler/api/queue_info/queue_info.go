@@ -20,8 +20,11 @@ limitations under the License.
 package queue_info
 
 import (
+	"time"
+
 	"golang.org/x/exp/slices"
 
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
@@ -40,6 +43,10 @@ type QueueInfo struct {
 	CreationTimestamp metav1.Time
 	PreemptMinRuntime *metav1.Duration
 	ReclaimMinRuntime *metav1.Duration
+	ResourceSchedules enginev2.ResourceSchedules
+	BudgetExhausted   bool
+	BudgetEnforcement enginev2.BudgetEnforcement
+	PlacementStrategy *enginev2.PlacementStrategy
 }
 
 func NewQueueInfo(queue *enginev2.Queue) *QueueInfo {
@@ -53,6 +60,11 @@ func NewQueueInfo(queue *enginev2.Queue) *QueueInfo {
 		priority = *queue.Spec.Priority
 	}
 
+	budgetEnforcement := enginev2.BudgetEnforcement("")
+	if queue.Spec.Budget != nil {
+		budgetEnforcement = queue.Spec.Budget.GetEnforcement()
+	}
+
 	return &QueueInfo{
 		UID:               common_info.QueueID(queue.Name),
 		Name:              queueName,
@@ -63,6 +75,10 @@ func NewQueueInfo(queue *enginev2.Queue) *QueueInfo {
 		CreationTimestamp: queue.CreationTimestamp,
 		PreemptMinRuntime: queue.Spec.PreemptMinRuntime,
 		ReclaimMinRuntime: queue.Spec.ReclaimMinRuntime,
+		ResourceSchedules: queue.Spec.ParseResourceSchedules(),
+		BudgetExhausted:   queue.BudgetExhausted(time.Now()),
+		BudgetEnforcement: budgetEnforcement,
+		PlacementStrategy: queue.Spec.PlacementStrategy,
 	}
 }
 
@@ -77,14 +93,33 @@ func (q *QueueInfo) AddChildQueue(queue common_info.QueueID) {
 	q.ChildQueues = append(q.ChildQueues, queue)
 }
 
+// EffectiveResources returns the quota of the queue at the given time, taking its resource schedules into account.
+func (q *QueueInfo) EffectiveResources(now time.Time) QueueQuota {
+	if schedule := q.ResourceSchedules.Active(now); schedule != nil {
+		return newQueueQuota(schedule.Resources)
+	}
+	return q.Resources
+}
+
 func getQueueQuota(queue enginev2.Queue) QueueQuota {
 	if queue.Spec.Resources == nil {
 		return QueueQuota{}
 	}
 
-	return QueueQuota{
-		GPU:    ResourceQuota(queue.Spec.Resources.GPU),
-		CPU:    ResourceQuota(queue.Spec.Resources.CPU),
-		Memory: ResourceQuota(queue.Spec.Resources.Memory),
+	return newQueueQuota(*queue.Spec.Resources)
+}
+
+func newQueueQuota(resources enginev2.QueueResources) QueueQuota {
+	quota := QueueQuota{
+		GPU:    ResourceQuota(resources.GPU),
+		CPU:    ResourceQuota(resources.CPU),
+		Memory: ResourceQuota(resources.Memory),
+	}
+	if len(resources.ExtendedResources) > 0 {
+		quota.Extended = make(map[v1.ResourceName]ResourceQuota, len(resources.ExtendedResources))
+		for resourceName, resource := range resources.ExtendedResources {
+			quota.Extended[resourceName] = ResourceQuota(resource)
+		}
 	}
+	return quota
 }
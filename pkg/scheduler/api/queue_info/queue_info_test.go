@@ -203,3 +203,61 @@ func TestNewQueueInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestQueueInfoEffectiveResources(t *testing.T) {
+	queue := &enginev2.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "queue",
+		},
+		Spec: enginev2.QueueSpec{
+			Resources: &enginev2.QueueResources{
+				GPU: enginev2.QueueResource{Quota: 1, OverQuotaWeight: 1, Limit: 2},
+			},
+			ResourceSchedules: []enginev2.QueueResourceSchedule{
+				{
+					Name:    "nights",
+					Windows: []enginev2.WeeklyWindow{{StartHour: 20, EndHour: 6}},
+					Resources: enginev2.QueueResources{
+						GPU: enginev2.QueueResource{Quota: 4, OverQuotaWeight: 2, Limit: 8},
+					},
+				},
+			},
+		},
+	}
+	queueInfo := NewQueueInfo(queue)
+
+	day := time.Date(2025, 6, 4, 12, 0, 0, 0, time.UTC)
+	assert.DeepEqual(t, ResourceQuota{Quota: 1, OverQuotaWeight: 1, Limit: 2}, queueInfo.EffectiveResources(day).GPU)
+
+	night := time.Date(2025, 6, 4, 23, 0, 0, 0, time.UTC)
+	assert.DeepEqual(t, ResourceQuota{Quota: 4, OverQuotaWeight: 2, Limit: 8}, queueInfo.EffectiveResources(night).GPU)
+}
+
+func TestNewQueueInfoBudget(t *testing.T) {
+	budget := &enginev2.QueueBudget{GPUHours: 10, Enforcement: enginev2.BudgetEnforcementNonPreemptibleOnly}
+	periodStart, err := budget.PeriodStart(time.Now())
+	assert.NilError(t, err)
+
+	queue := &enginev2.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "queue",
+		},
+		Spec: enginev2.QueueSpec{
+			Budget: budget,
+		},
+		Status: enginev2.QueueStatus{
+			Budget: &enginev2.QueueBudgetStatus{
+				PeriodStart:       metav1.NewTime(periodStart),
+				ConsumedGPUHours:  12,
+				RemainingGPUHours: 0,
+			},
+		},
+	}
+	queueInfo := NewQueueInfo(queue)
+	assert.Equal(t, true, queueInfo.BudgetExhausted)
+	assert.Equal(t, enginev2.BudgetEnforcementNonPreemptibleOnly, queueInfo.BudgetEnforcement)
+
+	// A status of an earlier budget period doesn't exhaust the budget
+	queue.Status.Budget.PeriodStart = metav1.NewTime(periodStart.AddDate(0, -1, 0))
+	assert.Equal(t, false, NewQueueInfo(queue).BudgetExhausted)
+}
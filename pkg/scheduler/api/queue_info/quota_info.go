@@ -12,6 +12,8 @@ type QueueQuota struct {
 	GPU    ResourceQuota `json:"gpu,omitempty"`
 	CPU    ResourceQuota `json:"cpu,omitempty"`
 	Memory ResourceQuota `json:"memory,omitempty"`
+	// +optional
+	Extended map[v1.ResourceName]ResourceQuota `json:"extended,omitempty"`
 }
 
 type ResourceQuota struct {
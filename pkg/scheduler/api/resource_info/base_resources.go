@@ -163,6 +163,16 @@ func (r *BaseResource) ScalarResources() map[v1.ResourceName]int64 {
 	return r.scalarResources
 }
 
+// ScalarResourceQuantity returns the quantity of a scalar resource in the units of its requests, converting the
+// resources that are kept in milli-units.
+func ScalarResourceQuantity(rName v1.ResourceName, quantity int64) float64 {
+	if IsMigResource(rName) || rName == v1.ResourcePods ||
+		rName == v1.ResourceEphemeralStorage || rName == v1.ResourceStorage {
+		return float64(quantity)
+	}
+	return float64(quantity) / 1000
+}
+
 func HumanizeResource(value float64, unitAdjustment float64) string {
 	if value == commonconstants.UnlimitedResourceQuantity {
 		return "Unlimited"
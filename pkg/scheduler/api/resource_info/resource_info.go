@@ -20,6 +20,7 @@ limitations under the License.
 package resource_info
 
 import (
+	"cmp"
 	"fmt"
 	"strconv"
 	"strings"
@@ -108,6 +109,25 @@ func (r *Resource) LessEqual(rr *Resource) bool {
 	return r.BaseResource.LessEqual(&rr.BaseResource)
 }
 
+// HasRequiredResourceTypes returns true if the resources include the main resource of the required resources: GPUs
+// if GPUs are required, and CPU otherwise.
+func HasRequiredResourceTypes(resources, required *Resource) bool {
+	if required.GPUs() > 0 {
+		return resources.GPUs() > 0
+	}
+	return resources.Cpu() > 0
+}
+
+// CompareFreeResources compares free resources for the required resources, by GPUs if GPUs are required and then by
+// CPU. It returns a positive number if a has more free resources than b, a negative number if it has less, and 0
+// otherwise.
+func CompareFreeResources(a, b, required *Resource) int {
+	if required.GPUs() > 0 && a.GPUs() != b.GPUs() {
+		return cmp.Compare(a.GPUs(), b.GPUs())
+	}
+	return cmp.Compare(a.Cpu(), b.Cpu())
+}
+
 func (r *Resource) SetMaxResource(rr *Resource) {
 	if r == nil || rr == nil {
 		return
@@ -6,6 +6,8 @@ package topology_info
 import (
 	"crypto/sha256"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 )
@@ -14,10 +16,73 @@ type TopologyConstraintInfo struct {
 	PreferredLevel string
 	RequiredLevel  string
 	Topology       string
+	// RequiredLevelFallbacks are the wider required levels, in order, that the pods may be scheduled within after
+	// waiting within the previous level. Ignored if RequiredLevel is not set.
+	RequiredLevelFallbacks []RequiredLevelFallback
+	// LadderLevels are the levels of the fallback ladder that the pods may be scheduled within once RequiredLevel has
+	// fallen back, from the original required level to RequiredLevel. Empty if RequiredLevel has not fallen back.
+	LadderLevels []string
 
 	schedulingConstraintsSignature common_info.SchedulingConstraintsSignature
 }
 
+type RequiredLevelFallback struct {
+	Level       string
+	WaitTimeout time.Duration
+}
+
+// GetRequiredLevelLadder returns the original required level followed by its fallback levels, from the narrowest to
+// the widest.
+func (tc *TopologyConstraintInfo) GetRequiredLevelLadder() []string {
+	if tc == nil || tc.RequiredLevel == "" {
+		return nil
+	}
+	requiredLevel := tc.RequiredLevel
+	if len(tc.LadderLevels) > 0 {
+		requiredLevel = tc.LadderLevels[0]
+	}
+	ladder := []string{requiredLevel}
+	for _, fallback := range tc.RequiredLevelFallbacks {
+		ladder = append(ladder, fallback.Level)
+	}
+	return ladder
+}
+
+// WithFallbacks returns the constraint to schedule the pods with after they have been pending for pendingFor. The
+// required level is widened to the widest fallback level that the pods waited for, and LadderLevels lists every level
+// of the ladder up to it, so that the topology plugin tries them in order. The original required level becomes the
+// preferred level (unless a preferred level is set) to pack the pods within each of these levels.
+func (tc *TopologyConstraintInfo) WithFallbacks(pendingFor time.Duration) *TopologyConstraintInfo {
+	if tc == nil || tc.RequiredLevel == "" || len(tc.LadderLevels) > 0 {
+		return tc
+	}
+
+	ladderLevels := []string{tc.RequiredLevel}
+	var waitedFor time.Duration
+	for _, fallback := range tc.RequiredLevelFallbacks {
+		waitedFor += fallback.WaitTimeout
+		if pendingFor < waitedFor {
+			break
+		}
+		ladderLevels = append(ladderLevels, fallback.Level)
+	}
+	if len(ladderLevels) == 1 {
+		return tc
+	}
+
+	preferredLevel := tc.PreferredLevel
+	if preferredLevel == "" {
+		preferredLevel = tc.RequiredLevel
+	}
+	return &TopologyConstraintInfo{
+		PreferredLevel:         preferredLevel,
+		RequiredLevel:          ladderLevels[len(ladderLevels)-1],
+		Topology:               tc.Topology,
+		RequiredLevelFallbacks: tc.RequiredLevelFallbacks,
+		LadderLevels:           ladderLevels,
+	}
+}
+
 func (tc *TopologyConstraintInfo) GetSchedulingConstraintsSignature() common_info.SchedulingConstraintsSignature {
 	if tc == nil {
 		return ""
@@ -33,6 +98,9 @@ func (tc *TopologyConstraintInfo) GetSchedulingConstraintsSignature() common_inf
 func (tc *TopologyConstraintInfo) generateSchedulingConstraintsSignature() common_info.SchedulingConstraintsSignature {
 	hash := sha256.New()
 	hash.Write([]byte(fmt.Sprintf("%s:%s:%s", tc.Topology, tc.RequiredLevel, tc.PreferredLevel)))
+	if len(tc.LadderLevels) > 0 {
+		hash.Write([]byte(fmt.Sprintf(":%s", strings.Join(tc.LadderLevels, ","))))
+	}
 
 	return common_info.SchedulingConstraintsSignature(fmt.Sprintf("%x", hash.Sum(nil)))
 }
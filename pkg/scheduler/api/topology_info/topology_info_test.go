@@ -5,6 +5,7 @@ package topology_info
 
 import (
 	"testing"
+	"time"
 
 	"gotest.tools/assert"
 )
@@ -57,3 +58,100 @@ func TestTopologyConstraintInfo_GetSchedulingConstraintsSignature(t *testing.T)
 		})
 	}
 }
+
+func TestTopologyConstraintInfo_WithFallbacks(t *testing.T) {
+	ladder := &TopologyConstraintInfo{
+		Topology:      "topo",
+		RequiredLevel: "rack",
+		RequiredLevelFallbacks: []RequiredLevelFallback{
+			{Level: "pod", WaitTimeout: 10 * time.Minute},
+			{Level: "zone", WaitTimeout: 20 * time.Minute},
+		},
+	}
+	tests := []struct {
+		name                   string
+		constraint             *TopologyConstraintInfo
+		pendingFor             time.Duration
+		expectedRequiredLevel  string
+		expectedPreferredLevel string
+		expectedLadderLevels   []string
+	}{
+		{
+			name:                  "no fallback waited for",
+			constraint:            ladder,
+			pendingFor:            5 * time.Minute,
+			expectedRequiredLevel: "rack",
+		},
+		{
+			name:                   "first fallback",
+			constraint:             ladder,
+			pendingFor:             10 * time.Minute,
+			expectedRequiredLevel:  "pod",
+			expectedPreferredLevel: "rack",
+			expectedLadderLevels:   []string{"rack", "pod"},
+		},
+		{
+			name:                   "wait timeouts accumulate",
+			constraint:             ladder,
+			pendingFor:             25 * time.Minute,
+			expectedRequiredLevel:  "pod",
+			expectedPreferredLevel: "rack",
+			expectedLadderLevels:   []string{"rack", "pod"},
+		},
+		{
+			name:                   "last fallback",
+			constraint:             ladder,
+			pendingFor:             time.Hour,
+			expectedRequiredLevel:  "zone",
+			expectedPreferredLevel: "rack",
+			expectedLadderLevels:   []string{"rack", "pod", "zone"},
+		},
+		{
+			name: "keep preferred level",
+			constraint: &TopologyConstraintInfo{
+				Topology:               "topo",
+				RequiredLevel:          "rack",
+				PreferredLevel:         "host",
+				RequiredLevelFallbacks: []RequiredLevelFallback{{Level: "zone"}},
+			},
+			pendingFor:             0,
+			expectedRequiredLevel:  "zone",
+			expectedPreferredLevel: "host",
+			expectedLadderLevels:   []string{"rack", "zone"},
+		},
+		{
+			name: "no required level",
+			constraint: &TopologyConstraintInfo{
+				Topology:               "topo",
+				PreferredLevel:         "rack",
+				RequiredLevelFallbacks: []RequiredLevelFallback{{Level: "zone"}},
+			},
+			pendingFor:             time.Hour,
+			expectedRequiredLevel:  "",
+			expectedPreferredLevel: "rack",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint := tt.constraint.WithFallbacks(tt.pendingFor)
+			assert.Equal(t, tt.expectedRequiredLevel, constraint.RequiredLevel)
+			assert.Equal(t, tt.expectedPreferredLevel, constraint.PreferredLevel)
+			assert.Equal(t, tt.constraint.Topology, constraint.Topology)
+			assert.DeepEqual(t, tt.expectedLadderLevels, constraint.LadderLevels)
+			assert.DeepEqual(t, tt.constraint.GetRequiredLevelLadder(), constraint.GetRequiredLevelLadder())
+		})
+	}
+}
+
+func TestTopologyConstraintInfo_GetRequiredLevelLadder(t *testing.T) {
+	constraint := &TopologyConstraintInfo{
+		Topology:               "topo",
+		RequiredLevel:          "rack",
+		RequiredLevelFallbacks: []RequiredLevelFallback{{Level: "pod"}, {Level: "zone"}},
+	}
+	assert.DeepEqual(t, []string{"rack", "pod", "zone"}, constraint.GetRequiredLevelLadder())
+
+	var nilConstraint *TopologyConstraintInfo
+	assert.Assert(t, nilConstraint.GetRequiredLevelLadder() == nil)
+}
@@ -33,6 +33,10 @@ import (
 type SubsetNodesFn func(podGroup *podgroup_info.PodGroupInfo, subGroup *subgroup_info.SubGroupInfo,
 	podSets map[string]*subgroup_info.PodSet, tasks []*pod_info.PodInfo, nodeSet node_info.NodeSet) ([]node_info.NodeSet, error)
 
+// TopologyDomainsFn returns the node sets of the topology domains that the job's required topology level confines
+// it to, or nil if the job has no required topology level.
+type TopologyDomainsFn func(job *podgroup_info.PodGroupInfo) []node_info.NodeSet
+
 // PredicateFn is used to predicate node for task.
 type PredicateFn func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo, *node_info.NodeInfo) error
 
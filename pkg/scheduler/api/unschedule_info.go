@@ -54,6 +54,18 @@ func getOverCapacityMessageDetails(queueName, resourceName string, deserved, use
 	}
 }
 
+func GetExtendedResourceOverCapacityMessageForQueue(queueName string, resourceName string,
+	deserved, used, requested float64) string {
+	return fmt.Sprintf("Non-preemptible workload is over quota. "+
+		"Workload requested %v %s, but %s quota is %v %s, while %v %s are already allocated for non-preemptible pods. "+
+		"Use a preemptible workload to go over quota.",
+		resource_info.HumanizeResource(requested, 1), resourceName,
+		queueName,
+		resource_info.HumanizeResource(deserved, 1), resourceName,
+		resource_info.HumanizeResource(used, 1), resourceName,
+	)
+}
+
 func GetJobOverMaxAllowedMessageForQueue(
 	queueName string, resourceName string, maxAllowed, used, requested float64,
 ) string {
@@ -77,11 +89,31 @@ func GetJobOverMaxAllowedMessageForQueue(
 			resource_info.HumanizeResource(maxAllowed, resource_info.MemoryToGB),
 			resource_info.HumanizeResource(used, resource_info.MemoryToGB),
 			resource_info.HumanizeResource(requested, resource_info.MemoryToGB))
+	default:
+		resourceNameStr = resourceName
+		details = fmt.Sprintf("Limit is %s %s, currently %s %s allocated and workload requested %s %s",
+			resource_info.HumanizeResource(maxAllowed, 1), resourceName,
+			resource_info.HumanizeResource(used, 1), resourceName,
+			resource_info.HumanizeResource(requested, 1), resourceName)
 	}
 	return fmt.Sprintf("%s quota has reached the allowable limit of %s. %s",
 		queueName, resourceNameStr, details)
 }
 
+func GetJobOverBudgetMessageForQueue(queueName string, deserved, used, requested float64) string {
+	return fmt.Sprintf("%s has exhausted its GPU-hours budget and cannot go over its quota until the next budget "+
+		"period. Quota is %s GPUs, currently %s GPUs allocated and workload requested %s GPUs",
+		queueName,
+		resource_info.HumanizeResource(deserved, 1),
+		resource_info.HumanizeResource(used, 1),
+		resource_info.HumanizeResource(requested, 1))
+}
+
+func GetPreemptibleJobOverBudgetMessageForQueue(queueName string) string {
+	return fmt.Sprintf("%s has exhausted its GPU-hours budget and can only run non-preemptible workloads until the "+
+		"next budget period", queueName)
+}
+
 func GetGangEvictionMessage(task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo) string {
 	if len(job.GetSubGroups()) == 1 {
 		if defaultSubgroup, found := job.GetSubGroups()[podgroup_info.DefaultSubGroup]; found {
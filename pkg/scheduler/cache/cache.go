@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -44,6 +45,7 @@ import (
 	enginelisters "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/listers/scheduling/v2alpha2"
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	featuregates "github.com/NVIDIA/KAI-scheduler/pkg/common/feature_gates"
 	draversionawareclient "github.com/NVIDIA/KAI-scheduler/pkg/common/resources/dra_version_aware_client"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
@@ -190,6 +192,7 @@ func (sc *SchedulerCache) Snapshot() (*api.ClusterInfo, error) {
 		log.InfraLogger.V(2).Warnf("Failed to clean stale bind requests: %v", cleanErr)
 		err = multierr.Append(err, cleanErr)
 	}
+	sc.handleGracefulEvictions(snapshot)
 
 	return snapshot, err
 }
@@ -230,6 +233,11 @@ func (sc *SchedulerCache) Evict(evictedPod *v1.Pod, evictedPodGroup *podgroup_in
 		return fmt.Errorf("received an eviction attempt for a terminated task: <%v/%v>", pod.Namespace, pod.Name)
 	}
 
+	if shouldEvictGracefully(evictedPodGroup, evictionMetadata) {
+		sc.requestPreemption(pod, evictedPodGroup, podGroup, evictionMetadata, message)
+		return nil
+	}
+
 	sc.evict(pod, podGroup, evictionMetadata, message)
 	return nil
 }
@@ -264,7 +272,8 @@ func (sc *SchedulerCache) WaitForWorkers(stopCh <-chan struct{}) {
 }
 
 // Bind binds task to the target host.
-func (sc *SchedulerCache) Bind(taskInfo *pod_info.PodInfo, hostname string, bindRequestAnnotations map[string]string) error {
+func (sc *SchedulerCache) Bind(taskInfo *pod_info.PodInfo, hostname string, gang *schedulingv1alpha2.GangBinding,
+	bindRequestAnnotations map[string]string) error {
 	startTime := time.Now()
 	defer metrics.UpdateTaskBindDuration(startTime)
 	sc.StatusUpdater.PreBind(taskInfo.Pod)
@@ -272,7 +281,7 @@ func (sc *SchedulerCache) Bind(taskInfo *pod_info.PodInfo, hostname string, bind
 	log.InfraLogger.V(3).Infof(
 		"Creating bind request for task <%v/%v> to node <%v> gpuGroup: <%v>, requires: <%v> GPUs",
 		taskInfo.Namespace, taskInfo.Name, hostname, taskInfo.GPUGroups, taskInfo.ResReq)
-	if bindRequestError := sc.createBindRequest(taskInfo, hostname, bindRequestAnnotations); bindRequestError != nil {
+	if bindRequestError := sc.createBindRequest(taskInfo, hostname, gang, bindRequestAnnotations); bindRequestError != nil {
 		return sc.StatusUpdater.Bound(taskInfo.Pod, hostname, bindRequestError, sc.getNodPoolName())
 	}
 
@@ -287,7 +296,8 @@ func (sc *SchedulerCache) Bind(taskInfo *pod_info.PodInfo, hostname string, bind
 // +kubebuilder:rbac:groups="scheduling.run.ai",resources=bindrequests,verbs=create;update;patch
 // +kubebuilder:rbac:groups="",resources=pods/finalizers,verbs=create;delete;update;patch;get;list
 
-func (sc *SchedulerCache) createBindRequest(podInfo *pod_info.PodInfo, nodeName string, bindRequestAnnotations map[string]string) error {
+func (sc *SchedulerCache) createBindRequest(podInfo *pod_info.PodInfo, nodeName string,
+	gang *schedulingv1alpha2.GangBinding, bindRequestAnnotations map[string]string) error {
 	labels := map[string]string{
 		"selected-node": nodeName,
 	}
@@ -297,6 +307,14 @@ func (sc *SchedulerCache) createBindRequest(podInfo *pod_info.PodInfo, nodeName
 		labels[k] = v
 	}
 
+	// The binder lists the members of a gang by its ID. PodGroup names that are not valid label values are left out.
+	if gang != nil {
+		labels[constants.GangIDLabelKey] = gang.ID
+		if len(validation.IsValidLabelValue(gang.PodGroupName)) == 0 {
+			labels[constants.GangPodGroupLabelKey] = gang.PodGroupName
+		}
+	}
+
 	bindRequest := &schedulingv1alpha2.BindRequest{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      podInfo.Pod.Name,
@@ -320,6 +338,7 @@ func (sc *SchedulerCache) createBindRequest(podInfo *pod_info.PodInfo, nodeName
 				Portion: fmt.Sprintf("%.2f", podInfo.AcceptedResource.GpuFractionalPortion()),
 			},
 			ResourceClaimAllocations: podInfo.ResourceClaimInfo.ToSlice(),
+			Gang:                     gang,
 		},
 	}
 
@@ -12,6 +12,7 @@ package cache
 import (
 	reflect "reflect"
 
+	v1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	api "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	eviction_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	pod_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -50,17 +51,17 @@ func (m *MockCache) EXPECT() *MockCacheMockRecorder {
 }
 
 // Bind mocks base method.
-func (m *MockCache) Bind(podInfo *pod_info.PodInfo, hostname string, bindRequestAnnotations map[string]string) error {
+func (m *MockCache) Bind(podInfo *pod_info.PodInfo, hostname string, gang *v1alpha2.GangBinding, bindRequestAnnotations map[string]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Bind", podInfo, hostname, bindRequestAnnotations)
+	ret := m.ctrl.Call(m, "Bind", podInfo, hostname, gang, bindRequestAnnotations)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Bind indicates an expected call of Bind.
-func (mr *MockCacheMockRecorder) Bind(podInfo, hostname, bindRequestAnnotations any) *gomock.Call {
+func (mr *MockCacheMockRecorder) Bind(podInfo, hostname, gang, bindRequestAnnotations any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bind", reflect.TypeOf((*MockCache)(nil).Bind), podInfo, hostname, bindRequestAnnotations)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bind", reflect.TypeOf((*MockCache)(nil).Bind), podInfo, hostname, gang, bindRequestAnnotations)
 }
 
 // Evict mocks base method.
@@ -45,6 +45,7 @@ import (
 	kubeaischedulerfake "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/fake"
 	fakeschedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v1alpha2/fake"
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 )
@@ -140,10 +141,34 @@ var _ = Describe("Cache", func() {
 
 				taskInfo := pod_info.NewTaskInfo(pod)
 
-				err := cache.Bind(taskInfo, "node-1", map[string]string{})
+				err := cache.Bind(taskInfo, "node-1", nil, map[string]string{})
 				Expect(err).To(HaveOccurred())
 			})
 		})
+
+		Context("gang bind requests", func() {
+			It("should label the bind request with the gang", func() {
+				cache, stopCh := setupCacheWithObjects(true, []runtime.Object{})
+				defer close(stopCh)
+
+				pod := &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod-1",
+						Namespace: "namespace-1",
+						UID:       types.UID("pod-uid"),
+					},
+				}
+				gang := &schedulingv1alpha2.GangBinding{PodGroupName: "pg-1", ID: "gang-id", MinMember: 2}
+				err := cache.(*SchedulerCache).createBindRequest(pod_info.NewTaskInfo(pod), "node-1", gang, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				bindRequest, err := cache.(*SchedulerCache).kubeAiSchedulerClient.SchedulingV1alpha2().BindRequests(
+					"namespace-1").Get(context.TODO(), "pod-1", metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bindRequest.Labels).To(HaveKeyWithValue(constants.GangIDLabelKey, "gang-id"))
+				Expect(bindRequest.Labels).To(HaveKeyWithValue(constants.GangPodGroupLabelKey, "pg-1"))
+			})
+		})
 	})
 
 	Describe("Stale BindRequests Cleanup", func() {
@@ -0,0 +1,116 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// shouldEvictGracefully returns true if the pod group asked to be notified before its pods are preempted.
+// Evictions that do not make room for another pod group are carried out right away.
+func shouldEvictGracefully(evictedPodGroup *podgroup_info.PodGroupInfo,
+	evictionMetadata eviction_info.EvictionMetadata) bool {
+	return evictionMetadata.Preemptor != nil && evictedPodGroup.GracefulEviction.IsEnabled()
+}
+
+// requestPreemption records the preemption request of the pod on the session's pod group. The request is persisted
+// on the pod group when the session closes, and the pod is deleted by a later snapshot once the workload
+// acknowledged the request or the graceful eviction window has passed.
+func (sc *SchedulerCache) requestPreemption(evictedPod *v1.Pod, evictedPodGroup *podgroup_info.PodGroupInfo,
+	podGroup *enginev2alpha2.PodGroup, evictionMetadata eviction_info.EvictionMetadata, message string) {
+	if !evictedPodGroup.GracefulEviction.RequestPreemption(evictedPod.Name, time.Now()) {
+		return
+	}
+
+	log.InfraLogger.V(6).Infof("Requested preemption of pod %v/%v, graceful eviction window: %v, message: %v",
+		evictedPod.Namespace, evictedPod.Name, *evictedPodGroup.GracefulEviction.Window, message)
+	if len(message) == 0 {
+		return
+	}
+	sc.workersWaitGroup.Add(1)
+	go func() {
+		defer sc.workersWaitGroup.Done()
+		sc.StatusUpdater.Evicted(podGroup, evictionMetadata, message)
+	}()
+}
+
+// handleGracefulEvictions deletes the requested pods of pod groups that are ready for eviction, and marks the
+// requested pods of the other pod groups as releasing, so that the pod groups that reclaim their resources
+// remain pipelined while the workloads checkpoint. A preemption request is cleared only once none of its pods is
+// active anymore, so that pods whose eviction failed are evicted again by the next snapshot.
+func (sc *SchedulerCache) handleGracefulEvictions(snapshot *api.ClusterInfo) {
+	now := time.Now()
+	for _, job := range snapshot.PodGroupInfos {
+		gracefulEviction := &job.GracefulEviction
+		if !gracefulEviction.IsPreemptionRequested() {
+			continue
+		}
+
+		requestedPods := getPreemptionRequestedPods(job)
+		if len(requestedPods) == 0 {
+			// The requested pods were evicted, or ended on their own
+			gracefulEviction.ClearPreemptionRequest()
+			continue
+		}
+
+		if gracefulEviction.IsReadyForEviction(now) {
+			message := fmt.Sprintf("Graceful eviction window of %s has passed", job.NamespacedName)
+			if gracefulEviction.IsAcknowledged() {
+				message = fmt.Sprintf("%s acknowledged the preemption request", job.NamespacedName)
+			}
+			for _, pod := range requestedPods {
+				sc.evictRequestedPod(pod.Pod, message)
+			}
+		}
+
+		for _, pod := range requestedPods {
+			if err := job.UpdateTaskStatus(pod, pod_status.Releasing); err != nil {
+				log.InfraLogger.Errorf("Failed to update task <%v/%v> status to %v: %v",
+					pod.Namespace, pod.Name, pod_status.Releasing, err)
+				continue
+			}
+			if node, found := snapshot.Nodes[pod.NodeName]; found {
+				if err := node.UpdateTask(pod); err != nil {
+					log.InfraLogger.Errorf("Failed to update task <%v/%v> on node %v: %v",
+						pod.Namespace, pod.Name, pod.NodeName, err)
+				}
+			}
+		}
+	}
+}
+
+func getPreemptionRequestedPods(job *podgroup_info.PodGroupInfo) []*pod_info.PodInfo {
+	var requestedPods []*pod_info.PodInfo
+	for _, podName := range job.GracefulEviction.RequestedPods {
+		for _, pod := range job.GetAllPodsMap() {
+			if pod.Name == podName && pod_status.IsActiveAllocatedStatus(pod.Status) {
+				requestedPods = append(requestedPods, pod)
+			}
+		}
+	}
+	return requestedPods
+}
+
+func (sc *SchedulerCache) evictRequestedPod(evictedPod *v1.Pod, message string) {
+	sc.workersWaitGroup.Add(1)
+	go func() {
+		defer sc.workersWaitGroup.Done()
+		log.InfraLogger.V(6).Infof("Evicting pod %v/%v after preemption request, message: %v",
+			evictedPod.Namespace, evictedPod.Name, message)
+		if err := sc.Evictor.Evict(evictedPod, message); err != nil {
+			log.InfraLogger.Errorf("Failed to evict pod: %v/%v, error: %v", evictedPod.Namespace, evictedPod.Name, err)
+		}
+	}()
+}
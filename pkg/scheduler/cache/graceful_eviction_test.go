@@ -0,0 +1,177 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+)
+
+var _ = Describe("Graceful eviction", func() {
+	var (
+		node     *v1.Node
+		pod      *v1.Pod
+		queue    *enginev2.Queue
+		podGroup *enginev2alpha2.PodGroup
+		metadata eviction_info.EvictionMetadata
+	)
+
+	BeforeEach(func() {
+		node = &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+		pod = &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod-1",
+				Namespace: "namespace-1",
+				UID:       "pod-1-uid",
+				Annotations: map[string]string{
+					commonconstants.PodGroupAnnotationForPod: "pg-1",
+				},
+			},
+			Spec:   v1.PodSpec{NodeName: "node-1"},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+		queue = &enginev2.Queue{ObjectMeta: metav1.ObjectMeta{Name: "queue-1"}}
+		podGroup = &enginev2alpha2.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pg-1",
+				Namespace: "namespace-1",
+				Annotations: map[string]string{
+					commonconstants.GracefulEvictionWindow: "10m",
+				},
+			},
+			Spec: enginev2alpha2.PodGroupSpec{Queue: "queue-1", MinMember: 1},
+		}
+		metadata = eviction_info.EvictionMetadata{
+			Action:           "reclaim",
+			EvictionGangSize: 1,
+			Preemptor:        &types.NamespacedName{Namespace: "namespace-2", Name: "preemptor"},
+		}
+	})
+
+	getPod := func(cache Cache) error {
+		_, err := cache.(*SchedulerCache).kubeClient.CoreV1().Pods(pod.Namespace).Get(
+			context.TODO(), pod.Name, metav1.GetOptions{})
+		return err
+	}
+
+	It("requests the preemption instead of deleting the pod", func() {
+		cache, stopCh := setupCacheWithObjects(false, []runtime.Object{node, pod}, queue, podGroup)
+		defer close(stopCh)
+
+		snapshot, err := cache.Snapshot()
+		Expect(err).NotTo(HaveOccurred())
+		job := snapshot.PodGroupInfos[common_info.PodGroupID(podGroup.Name)]
+		Expect(job).NotTo(BeNil())
+
+		Expect(cache.Evict(pod, job, metadata, "reclaimed")).To(Succeed())
+		cache.WaitForWorkers(stopCh)
+
+		Expect(getPod(cache)).To(Succeed())
+		Expect(job.GracefulEviction.IsPreemptionRequested()).To(BeTrue())
+		Expect(job.GracefulEviction.RequestedPods).To(Equal([]string{pod.Name}))
+	})
+
+	It("deletes the pod right away for evictions without a preemptor", func() {
+		cache, stopCh := setupCacheWithObjects(false, []runtime.Object{node, pod}, queue, podGroup)
+		defer close(stopCh)
+
+		snapshot, err := cache.Snapshot()
+		Expect(err).NotTo(HaveOccurred())
+		job := snapshot.PodGroupInfos[common_info.PodGroupID(podGroup.Name)]
+
+		metadata.Preemptor = nil
+		Expect(cache.Evict(pod, job, metadata, "stale")).To(Succeed())
+		cache.WaitForWorkers(stopCh)
+
+		Expect(errors.IsNotFound(getPod(cache))).To(BeTrue())
+		Expect(job.GracefulEviction.IsPreemptionRequested()).To(BeFalse())
+	})
+
+	DescribeTable("snapshot of a pod group with a preemption request",
+		func(requestedAgo time.Duration, acknowledged bool, expectEvicted bool) {
+			requested := time.Now().Add(-requestedAgo).UTC()
+			podGroup.Annotations[commonconstants.PreemptionRequestedTimeStamp] = requested.Format(time.RFC3339)
+			podGroup.Annotations[commonconstants.PreemptionRequestedPods] = pod.Name
+			if acknowledged {
+				podGroup.Annotations[commonconstants.PreemptionAcknowledgedTimeStamp] =
+					requested.Add(time.Second).Format(time.RFC3339)
+			}
+			cache, stopCh := setupCacheWithObjects(false, []runtime.Object{node, pod}, queue, podGroup)
+			defer close(stopCh)
+
+			snapshot, err := cache.Snapshot()
+			Expect(err).NotTo(HaveOccurred())
+			cache.WaitForWorkers(stopCh)
+
+			job := snapshot.PodGroupInfos[common_info.PodGroupID(podGroup.Name)]
+			task := job.GetAllPodsMap()[common_info.PodID(pod.UID)]
+			Expect(task.Status).To(Equal(pod_status.Releasing))
+			Expect(snapshot.Nodes[node.Name].PodInfos[pod_info.PodKey(pod)].Status).To(
+				Equal(pod_status.Releasing))
+
+			Expect(errors.IsNotFound(getPod(cache))).To(Equal(expectEvicted))
+			Expect(job.GracefulEviction.IsPreemptionRequested()).To(BeTrue())
+			if expectEvicted {
+				// The request is cleared once the evicted pod is gone
+				Eventually(func() bool {
+					snapshot, err := cache.Snapshot()
+					Expect(err).NotTo(HaveOccurred())
+					cache.WaitForWorkers(stopCh)
+					job := snapshot.PodGroupInfos[common_info.PodGroupID(podGroup.Name)]
+					return job.GracefulEviction.IsPreemptionRequested()
+				}).Should(BeFalse())
+			}
+		},
+		Entry("waits for the workload within the window", time.Minute, false, false),
+		Entry("evicts once the workload acknowledged", time.Minute, true, true),
+		Entry("evicts once the window has passed", time.Hour, false, true),
+	)
+
+	It("keeps the preemption request when the eviction fails", func() {
+		requested := time.Now().Add(-time.Hour).UTC()
+		podGroup.Annotations[commonconstants.PreemptionRequestedTimeStamp] = requested.Format(time.RFC3339)
+		podGroup.Annotations[commonconstants.PreemptionRequestedPods] = pod.Name
+		cache, stopCh := setupCacheWithObjects(false, []runtime.Object{node, pod}, queue, podGroup)
+		defer close(stopCh)
+		cache.(*SchedulerCache).Evictor = &failingEvictor{}
+
+		for range 2 {
+			snapshot, err := cache.Snapshot()
+			Expect(err).NotTo(HaveOccurred())
+			cache.WaitForWorkers(stopCh)
+
+			job := snapshot.PodGroupInfos[common_info.PodGroupID(podGroup.Name)]
+			Expect(job.GracefulEviction.IsPreemptionRequested()).To(BeTrue())
+			Expect(getPod(cache)).To(Succeed())
+		}
+		Expect(cache.(*SchedulerCache).Evictor.(*failingEvictor).attempts.Load()).To(Equal(int32(2)))
+	})
+})
+
+type failingEvictor struct {
+	attempts atomic.Int32
+}
+
+func (fe *failingEvictor) Evict(_ *v1.Pod, _ string) error {
+	fe.attempts.Add(1)
+	return fmt.Errorf("eviction failed")
+}
@@ -7,10 +7,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"golang.org/x/exp/maps"
 	"gomodules.xyz/jsonpatch/v2"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,6 +28,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
@@ -217,6 +221,12 @@ func (su *defaultStatusUpdater) RecordJobStatusEvent(job *podgroup_info.PodGroup
 		}
 		updatePodgroupStatus = su.recordUnschedulablePodGroup(job)
 	}
+	if len(job.PlacedTopologyLevels) > 0 {
+		updatePodgroupStatus = su.recordPlacedTopologyLevels(job) || updatePodgroupStatus
+	}
+	if job.ElasticTargetMember != nil {
+		updatePodgroupStatus = setPodGroupElasticTarget(job.PodGroup, *job.ElasticTargetMember) || updatePodgroupStatus
+	}
 
 	if len(patchData) > 0 || updatePodgroupStatus {
 		su.pushToUpdateQueue(
@@ -315,6 +325,28 @@ func (su *defaultStatusUpdater) markPodGroupUnschedulable(job *podgroup_info.Pod
 	})
 }
 
+func (su *defaultStatusUpdater) recordPlacedTopologyLevels(job *podgroup_info.PodGroupInfo) bool {
+	subGroupNames := maps.Keys(job.PlacedTopologyLevels)
+	slices.Sort(subGroupNames)
+	messages := make([]string, 0, len(subGroupNames))
+	for _, subGroupName := range subGroupNames {
+		level := job.PlacedTopologyLevels[subGroupName]
+		if subGroupName == subgroup_info.RootSubGroupSetName || subGroupName == podgroup_info.DefaultSubGroup {
+			messages = append(messages, fmt.Sprintf("Scheduled within topology level %s", level))
+		} else {
+			messages = append(messages, fmt.Sprintf("SubGroup %s scheduled within topology level %s", subGroupName, level))
+		}
+	}
+
+	return su.updatePodGroupSchedulingCondition(job.PodGroup, &enginev2alpha2.SchedulingCondition{
+		Type:     enginev2alpha2.ScheduledOnTopologyLevel,
+		NodePool: utils.GetNodePoolNameFromLabels(job.PodGroup.Labels, su.nodePoolLabelKey),
+		Reason:   enginev2alpha2.PodGroupReasonScheduled,
+		Message:  strings.Join(messages, ". "),
+		Status:   v1.ConditionTrue,
+	})
+}
+
 func (su *defaultStatusUpdater) updatePodCondition(pod *v1.Pod, condition *v1.PodCondition) error {
 	log.InfraLogger.V(6).Infof(
 		"Updating pod condition for %s/%s to (%s==%s)",
@@ -378,7 +410,8 @@ func (su *defaultStatusUpdater) updatePodGroupAnnotations(job *podgroup_info.Pod
 	old := job.PodGroup.DeepCopy()
 	updatedStaleTime := setPodGroupStaleTimeStamp(job.PodGroup, job.StalenessInfo.TimeStamp)
 	updatedStartTime := setPodGroupLastStartTimeStamp(job.PodGroup, job.LastStartTimestamp)
-	if !updatedStaleTime && !updatedStartTime {
+	updatedPreemptionRequest := setPodGroupPreemptionRequest(job.PodGroup, &job.GracefulEviction)
+	if !updatedStaleTime && !updatedStartTime && !updatedPreemptionRequest {
 		return nil, nil
 	}
 
@@ -485,6 +518,53 @@ func setPodGroupLastStartTimeStamp(podGroup *enginev2alpha2.PodGroup, startTimeS
 	return true
 }
 
+func setPodGroupPreemptionRequest(podGroup *enginev2alpha2.PodGroup,
+	gracefulEviction *podgroup_info.GracefulEvictionInfo) bool {
+	if !gracefulEviction.IsPreemptionRequested() {
+		return deletePodGroupAnnotations(podGroup,
+			commonconstants.PreemptionRequestedTimeStamp, commonconstants.PreemptionRequestedPods)
+	}
+
+	updated := false
+	expectedAnnotations := map[string]string{
+		commonconstants.PreemptionRequestedTimeStamp: gracefulEviction.RequestedTimestamp.UTC().Format(time.RFC3339),
+		commonconstants.PreemptionRequestedPods:      strings.Join(gracefulEviction.RequestedPods, ","),
+	}
+	for key, value := range expectedAnnotations {
+		if podGroup.Annotations[key] == value {
+			continue
+		}
+		if podGroup.Annotations == nil {
+			podGroup.Annotations = make(map[string]string)
+		}
+		podGroup.Annotations[key] = value
+		updated = true
+	}
+	return updated
+}
+
+func setPodGroupElasticTarget(podGroup *enginev2alpha2.PodGroup, targetMember int32) bool {
+	if podGroup.Status.Elastic != nil && podGroup.Status.Elastic.TargetMember == targetMember {
+		return false
+	}
+	podGroup.Status.Elastic = &enginev2alpha2.ElasticStatus{
+		TargetMember:       targetMember,
+		LastTransitionTime: metav1.Now(),
+	}
+	return true
+}
+
+func deletePodGroupAnnotations(podGroup *enginev2alpha2.PodGroup, keys ...string) bool {
+	updated := false
+	for _, key := range keys {
+		if _, found := podGroup.Annotations[key]; found {
+			delete(podGroup.Annotations, key)
+			updated = true
+		}
+	}
+	return updated
+}
+
 func setPodGroupSchedulingCondition(podGroup *enginev2alpha2.PodGroup, schedulingCondition *enginev2alpha2.SchedulingCondition) bool {
 	currentSchedulingConditionIndex := utils.GetSchedulingConditionIndex(podGroup, schedulingCondition.NodePool)
 	lastSchedulingCondition := utils.GetLastSchedulingCondition(podGroup)
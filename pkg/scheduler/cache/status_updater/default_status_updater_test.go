@@ -29,6 +29,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/utils"
 )
 
 type UpdatePodGroupConditionTest struct {
@@ -510,9 +511,11 @@ func TestDefaultStatusUpdater_RecordJobStatusEvent(t *testing.T) {
 	tests := []struct {
 		name                          string
 		job                           jobs_fake.TestJobBasic
+		placedTopologyLevels          map[string]string
 		numPodGroupStatusUpdateCalled int
 		expectedEventActions          []string
 		expectedInFlightPodGroups     int
+		expectedSchedulingCondition   *enginev2alpha2.SchedulingCondition
 	}{
 		{
 			name: "Running job",
@@ -602,6 +605,30 @@ func TestDefaultStatusUpdater_RecordJobStatusEvent(t *testing.T) {
 			expectedEventActions:          []string{"Warning Unschedulable Unable to schedule pod", "Normal Unschedulable Unable to schedule podgroup"},
 			expectedInFlightPodGroups:     1,
 		},
+		{
+			name: "Job scheduled with a topology level fallback ladder",
+			job: jobs_fake.TestJobBasic{
+				Name:      "test-job",
+				Namespace: "test-ns",
+				QueueName: "test-queue",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						Name:  "test-task",
+						State: pod_status.Allocated,
+					},
+				},
+			},
+			placedTopologyLevels:          map[string]string{"": "zone", "workers": "rack"},
+			numPodGroupStatusUpdateCalled: 1,
+			expectedEventActions:          []string{},
+			expectedInFlightPodGroups:     1,
+			expectedSchedulingCondition: &enginev2alpha2.SchedulingCondition{
+				Type:    enginev2alpha2.ScheduledOnTopologyLevel,
+				Reason:  enginev2alpha2.PodGroupReasonScheduled,
+				Message: "Scheduled within topology level zone. SubGroup workers scheduled within topology level rack",
+				Status:  v1.ConditionTrue,
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -639,6 +666,7 @@ func TestDefaultStatusUpdater_RecordJobStatusEvent(t *testing.T) {
 			stopCh := make(chan struct{})
 			statusUpdater.Run(stopCh)
 
+			jobInfos["test-job"].PlacedTopologyLevels = test.placedTopologyLevels
 			statusUpdater.RecordJobStatusEvent(jobInfos["test-job"])
 
 			events := []string{}
@@ -653,6 +681,13 @@ func TestDefaultStatusUpdater_RecordJobStatusEvent(t *testing.T) {
 				return true
 			})
 			assert.Equal(t, test.expectedInFlightPodGroups, inFlightPodGroups)
+			if test.expectedSchedulingCondition != nil {
+				lastCondition := utils.GetLastSchedulingCondition(jobInfos["test-job"].PodGroup)
+				assert.Equal(t, test.expectedSchedulingCondition.Type, lastCondition.Type)
+				assert.Equal(t, test.expectedSchedulingCondition.Reason, lastCondition.Reason)
+				assert.Equal(t, test.expectedSchedulingCondition.Message, lastCondition.Message)
+				assert.Equal(t, test.expectedSchedulingCondition.Status, lastCondition.Status)
+			}
 
 			close(finishUpdatesChan)
 			wg.Wait()
@@ -819,3 +854,160 @@ func waitForIncrease(callCount *int) error {
 	}
 	return errors.New("update calls did not increase")
 }
+
+func TestSetPodGroupPreemptionRequest(t *testing.T) {
+	for _, test := range []struct {
+		name                string
+		annotations         map[string]string
+		gracefulEviction    podgroup_info.GracefulEvictionInfo
+		expectedAnnotations map[string]string
+		expectedUpdated     bool
+	}{
+		{
+			name:                "No preemption request",
+			annotations:         map[string]string{},
+			expectedAnnotations: map[string]string{},
+			expectedUpdated:     false,
+		},
+		{
+			name: "New preemption request",
+			gracefulEviction: podgroup_info.GracefulEvictionInfo{
+				RequestedTimestamp: getTimePointer("2021-01-01T00:00:00Z"),
+				RequestedPods:      []string{"pod-a", "pod-b"},
+			},
+			expectedAnnotations: map[string]string{
+				commonconstants.PreemptionRequestedTimeStamp: "2021-01-01T00:00:00Z",
+				commonconstants.PreemptionRequestedPods:      "pod-a,pod-b",
+			},
+			expectedUpdated: true,
+		},
+		{
+			name: "Existing preemption request",
+			annotations: map[string]string{
+				commonconstants.PreemptionRequestedTimeStamp: "2021-01-01T00:00:00Z",
+				commonconstants.PreemptionRequestedPods:      "pod-a",
+			},
+			gracefulEviction: podgroup_info.GracefulEvictionInfo{
+				RequestedTimestamp: getTimePointer("2021-01-01T00:00:00Z"),
+				RequestedPods:      []string{"pod-a"},
+			},
+			expectedAnnotations: map[string]string{
+				commonconstants.PreemptionRequestedTimeStamp: "2021-01-01T00:00:00Z",
+				commonconstants.PreemptionRequestedPods:      "pod-a",
+			},
+			expectedUpdated: false,
+		},
+		{
+			name: "Cleared preemption request",
+			annotations: map[string]string{
+				commonconstants.PreemptionRequestedTimeStamp:    "2021-01-01T00:00:00Z",
+				commonconstants.PreemptionRequestedPods:         "pod-a",
+				commonconstants.PreemptionAcknowledgedTimeStamp: "2021-01-01T00:01:00Z",
+			},
+			expectedAnnotations: map[string]string{
+				commonconstants.PreemptionAcknowledgedTimeStamp: "2021-01-01T00:01:00Z",
+			},
+			expectedUpdated: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}}
+			updated := setPodGroupPreemptionRequest(podGroup, &test.gracefulEviction)
+
+			assert.Equal(t, test.expectedUpdated, updated)
+			assert.Equal(t, test.expectedAnnotations, podGroup.Annotations)
+		})
+	}
+}
+
+func TestSetPodGroupElasticTarget(t *testing.T) {
+	for _, test := range []struct {
+		name                 string
+		elastic              *enginev2alpha2.ElasticStatus
+		targetMember         int32
+		expectedUpdated      bool
+		expectedTransitioned bool
+	}{
+		{
+			name:                 "New elastic target",
+			targetMember:         3,
+			expectedUpdated:      true,
+			expectedTransitioned: true,
+		},
+		{
+			name: "Same elastic target",
+			elastic: &enginev2alpha2.ElasticStatus{
+				TargetMember:       3,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+			targetMember:    3,
+			expectedUpdated: false,
+		},
+		{
+			name: "Changed elastic target",
+			elastic: &enginev2alpha2.ElasticStatus{
+				TargetMember:       3,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+			targetMember:         2,
+			expectedUpdated:      true,
+			expectedTransitioned: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{Status: enginev2alpha2.PodGroupStatus{Elastic: test.elastic}}
+			updated := setPodGroupElasticTarget(podGroup, test.targetMember)
+
+			assert.Equal(t, test.expectedUpdated, updated)
+			assert.Equal(t, test.targetMember, podGroup.Status.Elastic.TargetMember)
+			assert.Equal(t, test.expectedTransitioned,
+				time.Since(podGroup.Status.Elastic.LastTransitionTime.Time) < time.Minute)
+		})
+	}
+}
+
+func TestSyncPodGroupElasticStatus(t *testing.T) {
+	for _, test := range []struct {
+		name            string
+		inFlight        *enginev2alpha2.ElasticStatus
+		snapshot        *enginev2alpha2.ElasticStatus
+		expected        *enginev2alpha2.ElasticStatus
+		expectedUpdated bool
+	}{
+		{
+			name:            "No in-flight elastic status",
+			snapshot:        &enginev2alpha2.ElasticStatus{TargetMember: 2},
+			expected:        &enginev2alpha2.ElasticStatus{TargetMember: 2},
+			expectedUpdated: true,
+		},
+		{
+			name:            "Snapshot has the in-flight target",
+			inFlight:        &enginev2alpha2.ElasticStatus{TargetMember: 2},
+			snapshot:        &enginev2alpha2.ElasticStatus{TargetMember: 2},
+			expected:        &enginev2alpha2.ElasticStatus{TargetMember: 2},
+			expectedUpdated: true,
+		},
+		{
+			name:            "Snapshot has an older target",
+			inFlight:        &enginev2alpha2.ElasticStatus{TargetMember: 3},
+			snapshot:        &enginev2alpha2.ElasticStatus{TargetMember: 2},
+			expected:        &enginev2alpha2.ElasticStatus{TargetMember: 3},
+			expectedUpdated: false,
+		},
+		{
+			name:            "Snapshot has no target",
+			inFlight:        &enginev2alpha2.ElasticStatus{TargetMember: 3},
+			expected:        &enginev2alpha2.ElasticStatus{TargetMember: 3},
+			expectedUpdated: false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			inFlightPodGroup := &enginev2alpha2.PodGroup{Status: enginev2alpha2.PodGroupStatus{Elastic: test.inFlight}}
+			snapshotPodGroup := &enginev2alpha2.PodGroup{Status: enginev2alpha2.PodGroupStatus{Elastic: test.snapshot}}
+			updated := syncPodGroupElasticStatus(inFlightPodGroup, snapshotPodGroup)
+
+			assert.Equal(t, test.expectedUpdated, updated)
+			assert.Equal(t, test.expected, snapshotPodGroup.Status.Elastic)
+		})
+	}
+}
@@ -19,6 +19,14 @@ const (
 	updateRequestIsOlder  podGroupStatusSyncResult = "updateRequestIsOlder"
 )
 
+// syncedPodGroupAnnotations are the pod group annotations that the scheduler updates
+var syncedPodGroupAnnotations = []string{
+	commonconstants.StalePodgroupTimeStamp,
+	commonconstants.LastStartTimeStamp,
+	commonconstants.PreemptionRequestedTimeStamp,
+	commonconstants.PreemptionRequestedPods,
+}
+
 func (su *defaultStatusUpdater) SyncPodGroupsWithPendingUpdates(podGroups []*enginev2alpha2.PodGroup) {
 	usedKeys := make(map[updatePayloadKey]bool, len(podGroups))
 	for i := range podGroups {
@@ -70,23 +78,15 @@ func (su *defaultStatusUpdater) cleanUpdatesForNonSeenPodGroups(usedKeys map[upd
 }
 
 func (su *defaultStatusUpdater) syncPodGroup(inFlightPodGroup, snapshotPodGroup *enginev2alpha2.PodGroup) podGroupStatusSyncResult {
-	staleTimeStampUpdated := false
-	if snapshotPodGroup.Annotations[commonconstants.StalePodgroupTimeStamp] == inFlightPodGroup.Annotations[commonconstants.StalePodgroupTimeStamp] {
-		staleTimeStampUpdated = true
-	} else {
-		if snapshotPodGroup.Annotations == nil {
-			snapshotPodGroup.Annotations = make(map[string]string)
+	syncedFieldsUpdated := true
+	for _, key := range syncedPodGroupAnnotations {
+		if !syncPodGroupAnnotation(inFlightPodGroup, snapshotPodGroup, key) {
+			syncedFieldsUpdated = false
 		}
-		snapshotPodGroup.Annotations[commonconstants.StalePodgroupTimeStamp] = inFlightPodGroup.Annotations[commonconstants.StalePodgroupTimeStamp]
 	}
-	lastStartTimestampUpdated := false
-	if snapshotPodGroup.Annotations[commonconstants.LastStartTimeStamp] == inFlightPodGroup.Annotations[commonconstants.LastStartTimeStamp] {
-		lastStartTimestampUpdated = true
-	} else {
-		if snapshotPodGroup.Annotations == nil {
-			snapshotPodGroup.Annotations = make(map[string]string)
-		}
-		snapshotPodGroup.Annotations[commonconstants.LastStartTimeStamp] = inFlightPodGroup.Annotations[commonconstants.LastStartTimeStamp]
+
+	if !syncPodGroupElasticStatus(inFlightPodGroup, snapshotPodGroup) {
+		syncedFieldsUpdated = false
 	}
 
 	statusComparison := compareSchedulingConditions(inFlightPodGroup, snapshotPodGroup)
@@ -94,13 +94,41 @@ func (su *defaultStatusUpdater) syncPodGroup(inFlightPodGroup, snapshotPodGroup
 	if statusComparison == equalStatuses || statusComparison == snapshotStatusIsOlder {
 		snapshotPodGroup.Status.SchedulingConditions = inFlightPodGroup.Status.SchedulingConditions
 	}
-	if statusComparison == equalStatuses && (!lastStartTimestampUpdated || !staleTimeStampUpdated) {
+	if statusComparison == equalStatuses && !syncedFieldsUpdated {
 		statusComparison = snapshotStatusIsOlder
 	}
 
 	return statusComparison
 }
 
+// syncPodGroupAnnotation sets the in-flight value of the annotation on the snapshot pod group. Returns true if the
+// snapshot pod group already had the in-flight value.
+func syncPodGroupAnnotation(inFlightPodGroup, snapshotPodGroup *enginev2alpha2.PodGroup, key string) bool {
+	if snapshotPodGroup.Annotations[key] == inFlightPodGroup.Annotations[key] {
+		return true
+	}
+	if snapshotPodGroup.Annotations == nil {
+		snapshotPodGroup.Annotations = make(map[string]string)
+	}
+	snapshotPodGroup.Annotations[key] = inFlightPodGroup.Annotations[key]
+	return false
+}
+
+// syncPodGroupElasticStatus sets the in-flight elastic status on the snapshot pod group. Returns true if the snapshot
+// pod group already had the in-flight target.
+func syncPodGroupElasticStatus(inFlightPodGroup, snapshotPodGroup *enginev2alpha2.PodGroup) bool {
+	inFlightElastic := inFlightPodGroup.Status.Elastic
+	if inFlightElastic == nil {
+		return true
+	}
+	snapshotElastic := snapshotPodGroup.Status.Elastic
+	if snapshotElastic != nil && snapshotElastic.TargetMember == inFlightElastic.TargetMember {
+		return true
+	}
+	snapshotPodGroup.Status.Elastic = inFlightElastic.DeepCopy()
+	return false
+}
+
 func compareSchedulingConditions(inFlightPodGroup, snapshotPodGroup *enginev2alpha2.PodGroup) podGroupStatusSyncResult {
 	lastSchedulingCondition := utils.GetLastSchedulingCondition(inFlightPodGroup)
 	currentLastSchedulingCondition := utils.GetLastSchedulingCondition(snapshotPodGroup)
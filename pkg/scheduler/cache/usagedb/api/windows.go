@@ -0,0 +1,53 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"time"
+
+	"github.com/aptible/supercronic/cronexpr"
+)
+
+// LatestCronWindowReset returns the latest occurrence of the cron expression that is not after now.
+func LatestCronWindowReset(cronExpression *cronexpr.Expression, now time.Time) time.Time {
+	// Calculate a duration that we know is going to be bigger then the duration
+	// between now and the closest previous occurrence of the cron expression.
+	thirdNext := cronExpression.NextN(now, 3)[2]
+	intervalToThirdOccurrence := thirdNext.Sub(now)
+
+	// Start looking for the closest previous occurrence from the time
+	// that is bigger then a single cron expression interval.
+	startTime := now.Add(-intervalToThirdOccurrence)
+
+	previousResetTime := startTime
+	currentResetTime := cronExpression.Next(startTime)
+
+	// Keep finding the next reset time until it's after or equal to the current time
+	for currentResetTime.Before(now) {
+		previousResetTime = currentResetTime
+		currentResetTime = cronExpression.Next(currentResetTime)
+	}
+
+	return previousResetTime
+}
+
+// LatestTumblingWindowReset returns the start of the tumbling window that contains now, for windows of the given size
+// that start at startTime.
+func LatestTumblingWindowReset(startTime time.Time, windowSize time.Duration, now time.Time) time.Time {
+	if startTime.After(now) {
+		// If the start time is in the future, return the current time. The tumbling window in this case will have size 0.
+		return now
+	}
+
+	previousResetTime := startTime
+	currentResetTime := startTime.Add(windowSize)
+
+	// Keep finding the next reset time until it's after or equal to the current time
+	for currentResetTime.Before(now) {
+		previousResetTime = currentResetTime
+		currentResetTime = currentResetTime.Add(windowSize)
+	}
+
+	return previousResetTime
+}
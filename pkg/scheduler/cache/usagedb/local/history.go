@@ -0,0 +1,124 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"math"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+// History is the allocation history of the cluster, sampled periodically by the scheduler.
+type History struct {
+	Samples []Sample `json:"samples"`
+}
+
+// Sample holds the resources allocated to each queue and the capacity of the cluster at a point in time.
+type Sample struct {
+	Time      time.Time                                     `json:"time"`
+	Capacity  map[v1.ResourceName]float64                   `json:"capacity"`
+	Allocated map[common_info.QueueID]queue_info.QueueUsage `json:"allocated"`
+}
+
+// prune removes the samples that were taken before the given time.
+func (h *History) prune(since time.Time) {
+	for i, sample := range h.Samples {
+		if !sample.Time.Before(since) {
+			h.Samples = h.Samples[i:]
+			return
+		}
+	}
+	h.Samples = nil
+}
+
+// compact halves the number of samples by merging every two consecutive samples, from the oldest, into one at the
+// time of the later sample. The allocations and capacity of the merged samples are summed, so the share of each queue
+// over them is kept. The latest sample is never merged, so that the sample interval is still measured from it.
+func (h *History) compact() {
+	if len(h.Samples) < 3 {
+		return
+	}
+	latest := h.Samples[len(h.Samples)-1]
+	samples := h.Samples[:len(h.Samples)-1]
+
+	compacted := make([]Sample, 0, len(samples)/2+2)
+	for i := 0; i+1 < len(samples); i += 2 {
+		compacted = append(compacted, mergeSamples(samples[i], samples[i+1]))
+	}
+	if len(samples)%2 == 1 {
+		compacted = append(compacted, samples[len(samples)-1])
+	}
+	h.Samples = append(compacted, latest)
+}
+
+func mergeSamples(older, newer Sample) Sample {
+	merged := Sample{
+		Time:      newer.Time,
+		Capacity:  map[v1.ResourceName]float64{},
+		Allocated: map[common_info.QueueID]queue_info.QueueUsage{},
+	}
+	for _, sample := range []Sample{older, newer} {
+		for resource, quantity := range sample.Capacity {
+			merged.Capacity[resource] += quantity
+		}
+		for queueID, queueAllocated := range sample.Allocated {
+			if _, found := merged.Allocated[queueID]; !found {
+				merged.Allocated[queueID] = queue_info.QueueUsage{}
+			}
+			for resource, quantity := range queueAllocated {
+				merged.Allocated[queueID][resource] += quantity
+			}
+		}
+	}
+	return merged
+}
+
+// usage returns the decayed allocations of the queues since the given time, normalized by the decayed capacity of
+// the cluster over the same samples.
+func (h *History) usage(since, now time.Time, halfLifePeriod *metav1.Duration) *queue_info.ClusterUsage {
+	capacity := map[v1.ResourceName]float64{}
+	allocated := map[common_info.QueueID]queue_info.QueueUsage{}
+	for _, sample := range h.Samples {
+		if sample.Time.Before(since) || sample.Time.After(now) {
+			continue
+		}
+
+		decay := getDecay(now.Sub(sample.Time), halfLifePeriod)
+		for resource, quantity := range sample.Capacity {
+			capacity[resource] += quantity * decay
+		}
+		for queueID, queueAllocated := range sample.Allocated {
+			if _, found := allocated[queueID]; !found {
+				allocated[queueID] = queue_info.QueueUsage{}
+			}
+			for resource, quantity := range queueAllocated {
+				allocated[queueID][resource] += quantity * decay
+			}
+		}
+	}
+
+	usage := queue_info.NewClusterUsage()
+	for queueID, queueAllocated := range allocated {
+		usage.Queues[queueID] = queue_info.QueueUsage{}
+		for resource, quantity := range queueAllocated {
+			if capacity[resource] <= 0 {
+				continue
+			}
+			usage.Queues[queueID][resource] = quantity / capacity[resource]
+		}
+	}
+	return usage
+}
+
+func getDecay(age time.Duration, halfLifePeriod *metav1.Duration) float64 {
+	if halfLifePeriod == nil || halfLifePeriod.Duration <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, age.Seconds()/halfLifePeriod.Duration.Seconds())
+}
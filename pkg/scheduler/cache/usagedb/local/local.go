@@ -0,0 +1,144 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aptible/supercronic/cronexpr"
+	"k8s.io/client-go/kubernetes"
+
+	kubeaischedulerver "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	defaultSampleInterval = 5 * time.Minute
+	defaultStoreTimeout   = 10 * time.Second
+)
+
+var _ api.Interface = &LocalClient{}
+
+// LocalClient computes the usage of the queues from an allocation history that it samples and persists itself,
+// without depending on an external time-series database.
+type LocalClient struct {
+	usageParams *api.UsageParams
+	store       Store
+	source      allocationSource
+	now         func() time.Time
+
+	// Extra params
+	sampleInterval time.Duration
+	storeTimeout   time.Duration
+
+	cronWindowExpression *cronexpr.Expression
+
+	history      *History
+	historyMutex sync.Mutex
+}
+
+// NewLocalClientFn returns a function that creates local usage db clients, which sample the cluster with the given
+// clients.
+func NewLocalClientFn(
+	kubeClient kubernetes.Interface, kaiClient kubeaischedulerver.Interface,
+) func(connectionString string, params *api.UsageParams) (api.Interface, error) {
+	return func(connectionString string, params *api.UsageParams) (api.Interface, error) {
+		store, err := NewStore(connectionString, kubeClient)
+		if err != nil {
+			return nil, err
+		}
+		return newLocalClient(store, &clusterAllocationSource{kubeClient: kubeClient, kaiClient: kaiClient}, params)
+	}
+}
+
+func newLocalClient(store Store, source allocationSource, params *api.UsageParams) (*LocalClient, error) {
+	client := &LocalClient{
+		usageParams:    params,
+		store:          store,
+		source:         source,
+		now:            time.Now,
+		sampleInterval: params.GetExtraDurationParamOrDefault("sampleInterval", defaultSampleInterval),
+		storeTimeout:   params.GetExtraDurationParamOrDefault("storeTimeout", defaultStoreTimeout),
+	}
+
+	if params.WindowType == nil {
+		return nil, fmt.Errorf("window type is not set in usage params")
+	}
+	switch *params.WindowType {
+	case api.TumblingWindow:
+		if params.TumblingWindowStartTime == nil {
+			return nil, fmt.Errorf("local client window type is set as 'tumbling', but the tumblingWindowStartTime is null")
+		}
+	case api.CronWindow:
+		cronExpression, err := cronexpr.Parse(params.CronString)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing cron string '%s' for usage tumbling window: %v", params.CronString, err)
+		}
+		client.cronWindowExpression = cronExpression
+	}
+
+	return client, nil
+}
+
+// GetResourceUsage samples the cluster if the last sample is older than the sample interval, and returns the usage
+// of the queues over the current window.
+func (c *LocalClient) GetResourceUsage() (*queue_info.ClusterUsage, error) {
+	c.historyMutex.Lock()
+	defer c.historyMutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.storeTimeout)
+	defer cancel()
+
+	now := c.now()
+	if c.history == nil {
+		history, err := c.store.Load(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error loading usage history: %v", err)
+		}
+		c.history = history
+	}
+
+	windowStart := c.getWindowStart(now)
+	if err := c.recordSample(ctx, now, windowStart); err != nil {
+		log.InfraLogger.Errorf("Failed to record usage sample: %v", err)
+	}
+
+	return c.history.usage(windowStart, now, c.usageParams.HalfLifePeriod), nil
+}
+
+func (c *LocalClient) recordSample(ctx context.Context, now, windowStart time.Time) error {
+	if len(c.history.Samples) > 0 {
+		lastSample := c.history.Samples[len(c.history.Samples)-1]
+		if now.Sub(lastSample.Time) < c.sampleInterval {
+			return nil
+		}
+	}
+
+	sample, err := c.source.Sample(ctx, now)
+	if err != nil {
+		return err
+	}
+	c.history.Samples = append(c.history.Samples, *sample)
+	// Windows only move forward, so samples from before the current window are never counted again
+	c.history.prune(windowStart)
+
+	return c.store.Save(ctx, c.history)
+}
+
+func (c *LocalClient) getWindowStart(now time.Time) time.Time {
+	switch *c.usageParams.WindowType {
+	case api.TumblingWindow:
+		return api.LatestTumblingWindowReset(
+			c.usageParams.TumblingWindowStartTime.Time, c.usageParams.WindowSize.Duration, now)
+	case api.CronWindow:
+		return api.LatestCronWindowReset(c.cronWindowExpression, now)
+	default:
+		return now.Add(-c.usageParams.WindowSize.Duration)
+	}
+}
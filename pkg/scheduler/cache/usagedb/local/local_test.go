@@ -0,0 +1,152 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb/api"
+)
+
+type fakeAllocationSource struct {
+	allocated map[common_info.QueueID]float64
+	capacity  float64
+	samples   int
+}
+
+func (s *fakeAllocationSource) Sample(_ context.Context, now time.Time) (*Sample, error) {
+	s.samples++
+	sample := &Sample{
+		Time:      now,
+		Capacity:  map[v1.ResourceName]float64{commonconstants.NvidiaGpuResource: s.capacity},
+		Allocated: map[common_info.QueueID]queue_info.QueueUsage{},
+	}
+	for queueID, gpus := range s.allocated {
+		sample.Allocated[queueID] = queue_info.QueueUsage{commonconstants.NvidiaGpuResource: gpus}
+	}
+	return sample, nil
+}
+
+func TestLocalClientGetResourceUsage(t *testing.T) {
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	slidingWindow := api.SlidingWindow
+	tumblingWindow := api.TumblingWindow
+
+	tests := []struct {
+		name          string
+		params        api.UsageParams
+		expectedUsage float64
+	}{
+		{
+			name: "sliding window",
+			params: api.UsageParams{
+				WindowType: &slidingWindow,
+				WindowSize: &metav1.Duration{Duration: 2 * time.Hour},
+			},
+			expectedUsage: (2.0 + 8.0 + 8.0) / 30.0,
+		},
+		{
+			name: "tumbling window",
+			params: api.UsageParams{
+				WindowType:              &tumblingWindow,
+				WindowSize:              &metav1.Duration{Duration: 3 * time.Hour},
+				TumblingWindowStartTime: &metav1.Time{Time: start.Add(-time.Hour)},
+			},
+			expectedUsage: (2.0 + 8.0 + 8.0) / 30.0,
+		},
+		{
+			name: "tumbling window that started before all samples",
+			params: api.UsageParams{
+				WindowType:              &tumblingWindow,
+				WindowSize:              &metav1.Duration{Duration: 5 * time.Hour},
+				TumblingWindowStartTime: &metav1.Time{Time: start},
+			},
+			expectedUsage: (2.0 + 2.0 + 2.0 + 8.0 + 8.0) / 50.0,
+		},
+		{
+			name: "half life period",
+			params: api.UsageParams{
+				WindowType:     &slidingWindow,
+				WindowSize:     &metav1.Duration{Duration: 24 * time.Hour},
+				HalfLifePeriod: &metav1.Duration{Duration: time.Hour},
+			},
+			expectedUsage: (2.0/16 + 2.0/8 + 2.0/4 + 8.0/2 + 8.0) / (10.0/16 + 10.0/8 + 10.0/4 + 10.0/2 + 10.0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.SetDefaults()
+			source := &fakeAllocationSource{allocated: map[common_info.QueueID]float64{"queue-a": 2}, capacity: 10}
+			store := &fileStore{path: filepath.Join(t.TempDir(), "history.json")}
+			client, err := newLocalClient(store, source, &tt.params)
+			require.NoError(t, err)
+
+			// Hourly samples of 2 GPUs from 00:00, that grow to 8 GPUs at 03:00
+			end := start.Add(4 * time.Hour)
+			var usage *queue_info.ClusterUsage
+			for now := start; !now.After(end); now = now.Add(time.Hour) {
+				if now.Equal(start.Add(3 * time.Hour)) {
+					source.allocated["queue-a"] = 8
+				}
+				client.now = func() time.Time { return now }
+				usage, err = client.GetResourceUsage()
+				require.NoError(t, err)
+			}
+			assert.InDelta(t, tt.expectedUsage, usage.Queues["queue-a"][commonconstants.NvidiaGpuResource], 1e-9)
+
+			// A restarted client continues from the stored history
+			restartedClient, err := newLocalClient(store, source, &tt.params)
+			require.NoError(t, err)
+			restartedClient.now = func() time.Time { return end.Add(time.Minute) }
+			_, err = restartedClient.GetResourceUsage()
+			require.NoError(t, err)
+			assert.Equal(t, 5, source.samples, "no sample is taken within the sample interval")
+
+			restartedClient.now = func() time.Time { return end }
+			usage, err = restartedClient.GetResourceUsage()
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expectedUsage, usage.Queues["queue-a"][commonconstants.NvidiaGpuResource], 1e-9)
+		})
+	}
+}
+
+func TestNewLocalClientValidation(t *testing.T) {
+	tumblingWindow := api.TumblingWindow
+	cronWindow := api.CronWindow
+
+	tests := []struct {
+		name   string
+		params api.UsageParams
+	}{
+		{
+			name:   "tumbling window without start time",
+			params: api.UsageParams{WindowType: &tumblingWindow},
+		},
+		{
+			name:   "cron window with invalid cron string",
+			params: api.UsageParams{WindowType: &cronWindow, CronString: "not a cron"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.SetDefaults()
+			_, err := newLocalClient(&fileStore{path: filepath.Join(t.TempDir(), "history.json")},
+				&fakeAllocationSource{}, &tt.params)
+			assert.Error(t, err)
+		})
+	}
+}
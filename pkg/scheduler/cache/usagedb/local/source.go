@@ -0,0 +1,69 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	kubeaischedulerver "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+const gpuResourceNameSuffix = "/gpu"
+
+type allocationSource interface {
+	Sample(ctx context.Context, now time.Time) (*Sample, error)
+}
+
+// clusterAllocationSource samples the resources allocated to the queues, as reported on their status, and the
+// allocatable resources of the nodes.
+type clusterAllocationSource struct {
+	kubeClient kubernetes.Interface
+	kaiClient  kubeaischedulerver.Interface
+}
+
+func (s *clusterAllocationSource) Sample(ctx context.Context, now time.Time) (*Sample, error) {
+	nodes, err := s.kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	queues, err := s.kaiClient.SchedulingV2().Queues("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	sample := &Sample{
+		Time:      now,
+		Capacity:  map[v1.ResourceName]float64{},
+		Allocated: map[common_info.QueueID]queue_info.QueueUsage{},
+	}
+	for _, node := range nodes.Items {
+		addResourceList(sample.Capacity, node.Status.Allocatable)
+	}
+	for _, queue := range queues.Items {
+		allocated := queue_info.QueueUsage{}
+		addResourceList(allocated, queue.Status.Allocated)
+		sample.Allocated[common_info.QueueID(queue.Name)] = allocated
+	}
+	return sample, nil
+}
+
+// addResourceList adds the resources to the quantities, counting the GPUs of all vendors as GPUs.
+func addResourceList(quantities map[v1.ResourceName]float64, resources v1.ResourceList) {
+	for resourceName, quantity := range resources {
+		if strings.HasSuffix(string(resourceName), gpuResourceNameSuffix) {
+			resourceName = commonconstants.NvidiaGpuResource
+		}
+		quantities[resourceName] += quantity.AsApproximateFloat64()
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	configMapScheme = "configmap://"
+	fileScheme      = "file://"
+	historyDataKey  = "history.json"
+
+	// maxConfigMapHistorySize keeps the history well within the 1MiB size limit of config maps
+	maxConfigMapHistorySize = 960 * 1024
+)
+
+// Store persists the allocation history between scheduler restarts.
+type Store interface {
+	Load(ctx context.Context) (*History, error)
+	Save(ctx context.Context, history *History) error
+}
+
+// NewStore returns the store for the connection string, which is either configmap://<namespace>/<name> or
+// file://<path>.
+func NewStore(connectionString string, kubeClient kubernetes.Interface) (Store, error) {
+	switch {
+	case strings.HasPrefix(connectionString, configMapScheme):
+		namespace, name, found := strings.Cut(strings.TrimPrefix(connectionString, configMapScheme), "/")
+		if !found || len(namespace) == 0 || len(name) == 0 {
+			return nil, fmt.Errorf("invalid config map connection string %s, expected %s<namespace>/<name>",
+				connectionString, configMapScheme)
+		}
+		if kubeClient == nil {
+			return nil, fmt.Errorf("a kubernetes client is required for the config map store")
+		}
+		return &configMapStore{kubeClient: kubeClient, namespace: namespace, name: name}, nil
+	case strings.HasPrefix(connectionString, fileScheme):
+		path := strings.TrimPrefix(connectionString, fileScheme)
+		if len(path) == 0 {
+			return nil, fmt.Errorf("invalid file connection string %s, expected %s<path>", connectionString, fileScheme)
+		}
+		return &fileStore{path: path}, nil
+	}
+	return nil, fmt.Errorf("unsupported connection string %s for the local usage db, expected %s<namespace>/<name> "+
+		"or %s<path>", connectionString, configMapScheme, fileScheme)
+}
+
+type configMapStore struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	name       string
+}
+
+func (s *configMapStore) Load(ctx context.Context) (*History, error) {
+	configMap, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return &History{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config map %s/%s: %w", s.namespace, s.name, err)
+	}
+	return unmarshalHistory([]byte(configMap.Data[historyDataKey]))
+}
+
+// Save compacts the history until it fits in the config map, merging its oldest samples first.
+func (s *configMapStore) Save(ctx context.Context, history *History) error {
+	data, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	for len(data) > maxConfigMapHistorySize {
+		samplesCount := len(history.Samples)
+		history.compact()
+		if len(history.Samples) == samplesCount {
+			return fmt.Errorf("usage history of %d bytes does not fit in config map %s/%s",
+				len(data), s.namespace, s.name)
+		}
+		if data, err = json.Marshal(history); err != nil {
+			return err
+		}
+	}
+
+	configMaps := s.kubeClient.CoreV1().ConfigMaps(s.namespace)
+	configMap, err := configMaps.Get(ctx, s.name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		configMap = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: s.namespace},
+			Data:       map[string]string{historyDataKey: string(data)},
+		}
+		_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get config map %s/%s: %w", s.namespace, s.name, err)
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[historyDataKey] = string(data)
+	_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+	return err
+}
+
+type fileStore struct {
+	path string
+}
+
+func (s *fileStore) Load(_ context.Context) (*History, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return &History{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalHistory(data)
+}
+
+// Save writes the history to a temporary file and renames it, so that a crash never leaves a partial history behind.
+func (s *fileStore) Save(_ context.Context, history *History) error {
+	data, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	if _, err = tempFile.Write(data); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err = tempFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tempFile.Name(), s.path)
+}
+
+func unmarshalHistory(data []byte) (*History, error) {
+	history := &History{}
+	if len(data) == 0 {
+		return history, nil
+	}
+	if err := json.Unmarshal(data, history); err != nil {
+		return nil, fmt.Errorf("failed to parse usage history: %w", err)
+	}
+	return history, nil
+}
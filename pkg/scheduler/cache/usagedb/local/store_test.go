@@ -0,0 +1,159 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+func TestNewStore(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	tests := []struct {
+		name             string
+		connectionString string
+		expectedStore    Store
+		expectError      bool
+	}{
+		{
+			name:             "config map",
+			connectionString: "configmap://kai-scheduler/usage-history",
+			expectedStore:    &configMapStore{kubeClient: kubeClient, namespace: "kai-scheduler", name: "usage-history"},
+		},
+		{
+			name:             "config map without name",
+			connectionString: "configmap://kai-scheduler",
+			expectError:      true,
+		},
+		{
+			name:             "file",
+			connectionString: "file:///var/lib/kai/history.json",
+			expectedStore:    &fileStore{path: "/var/lib/kai/history.json"},
+		},
+		{
+			name:             "unsupported scheme",
+			connectionString: "http://localhost:9090",
+			expectError:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := NewStore(tt.connectionString, kubeClient)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStore, store)
+		})
+	}
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		store Store
+	}{
+		{
+			name:  "config map",
+			store: &configMapStore{kubeClient: fake.NewSimpleClientset(), namespace: "kai-scheduler", name: "history"},
+		},
+		{
+			name:  "file",
+			store: &fileStore{path: filepath.Join(t.TempDir(), "history.json")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			history, err := tt.store.Load(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, history.Samples)
+
+			// The first save creates the backing object, the second one updates it
+			for i := 1; i <= 2; i++ {
+				history.Samples = append(history.Samples, Sample{
+					Time:     time.Date(2025, 6, 2, i, 0, 0, 0, time.UTC),
+					Capacity: map[v1.ResourceName]float64{commonconstants.NvidiaGpuResource: 10},
+					Allocated: map[common_info.QueueID]queue_info.QueueUsage{
+						"queue-a": {commonconstants.NvidiaGpuResource: float64(i)},
+					},
+				})
+				require.NoError(t, tt.store.Save(ctx, history))
+
+				loaded, err := tt.store.Load(ctx)
+				require.NoError(t, err)
+				require.Len(t, loaded.Samples, i)
+				for j := range history.Samples {
+					assert.True(t, history.Samples[j].Time.Equal(loaded.Samples[j].Time))
+					assert.Equal(t, history.Samples[j].Allocated, loaded.Samples[j].Allocated)
+					assert.Equal(t, history.Samples[j].Capacity, loaded.Samples[j].Capacity)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigMapStoreLoadInvalidHistory(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "history", Namespace: "kai-scheduler"},
+		Data:       map[string]string{historyDataKey: "not json"},
+	})
+	store := &configMapStore{kubeClient: kubeClient, namespace: "kai-scheduler", name: "history"}
+	_, err := store.Load(context.Background())
+	assert.Error(t, err)
+}
+
+func TestConfigMapStoreCompactsLargeHistory(t *testing.T) {
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	history := &History{}
+	for i := 0; i < 2000; i++ {
+		sample := Sample{
+			Time:      start.Add(time.Duration(i) * time.Minute),
+			Capacity:  map[v1.ResourceName]float64{commonconstants.NvidiaGpuResource: 100},
+			Allocated: map[common_info.QueueID]queue_info.QueueUsage{},
+		}
+		for queue := 0; queue < 20; queue++ {
+			queueID := common_info.QueueID(fmt.Sprintf("queue-%d", queue))
+			sample.Allocated[queueID] = queue_info.QueueUsage{commonconstants.NvidiaGpuResource: float64(i % (queue + 1))}
+		}
+		history.Samples = append(history.Samples, sample)
+	}
+	latestSampleTime := history.Samples[len(history.Samples)-1].Time
+	now := latestSampleTime.Add(time.Minute)
+	expectedUsage := history.usage(start, now, nil)
+
+	store := &configMapStore{kubeClient: fake.NewSimpleClientset(), namespace: "kai-scheduler", name: "history"}
+	require.NoError(t, store.Save(context.Background(), history))
+
+	configMap, err := store.kubeClient.CoreV1().ConfigMaps("kai-scheduler").Get(
+		context.Background(), "history", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(configMap.Data[historyDataKey]), maxConfigMapHistorySize)
+
+	loaded, err := store.Load(context.Background())
+	require.NoError(t, err)
+	assert.Less(t, len(loaded.Samples), 2000)
+	assert.True(t, loaded.Samples[len(loaded.Samples)-1].Time.Equal(latestSampleTime))
+	loadedUsage := loaded.usage(start, now, nil)
+	for queueID, queueUsage := range expectedUsage.Queues {
+		assert.InDelta(t, queueUsage[commonconstants.NvidiaGpuResource],
+			loadedUsage.Queues[queueID][commonconstants.NvidiaGpuResource], 1e-9, queueID)
+	}
+}
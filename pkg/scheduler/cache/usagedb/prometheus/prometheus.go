@@ -247,44 +247,11 @@ func (p *PrometheusClient) createTumblingWindowQueryFunction(getLatestUsageReset
 }
 
 func (p *PrometheusClient) getLatestUsageResetTime_CronWindow(now time.Time) time.Time {
-	// Calculate a duration that we know is going to be bigger then the duration
-	// between now and the closest previous occurrence of the cron expression.
-	thirdNext := p.cronWindowExpression.NextN(now, 3)[2]
-	intervalToThirdOccurrence := thirdNext.Sub(now)
-
-	// Start looking for the closest previous occurrence from the time
-	// that is bigger then a single cron expression interval.
-	startTime := now.Add(-intervalToThirdOccurrence)
-
-	previousResetTime := startTime
-	currentResetTime := p.cronWindowExpression.Next(startTime)
-
-	// Keep finding the next reset time until it's after or equal to the current time
-	for currentResetTime.Before(now) {
-		previousResetTime = currentResetTime
-		currentResetTime = p.cronWindowExpression.Next(currentResetTime)
-	}
-
-	return previousResetTime
+	return api.LatestCronWindowReset(p.cronWindowExpression, now)
 }
 
 func (p *PrometheusClient) getLatestUsageResetTime_TumblingWindow(now time.Time) time.Time {
-	startTime := p.tumblingWindowStartTime.Time
-	if startTime.After(now) {
-		// If the start time is in the future, return the current time. The tumbling window in this case will have size 0.
-		return now
-	}
-
-	previousResetTime := startTime
-	currentResetTime := startTime.Add(p.usageParams.WindowSize.Duration)
-
-	// Keep finding the next reset time until it's after or equal to the current time
-	for currentResetTime.Before(now) {
-		previousResetTime = currentResetTime
-		currentResetTime = currentResetTime.Add(p.usageParams.WindowSize.Duration)
-	}
-
-	return previousResetTime
+	return api.LatestTumblingWindowReset(p.tumblingWindowStartTime.Time, p.usageParams.WindowSize.Duration, now)
 }
 
 func getExponentialDecayQuery(halfLifePeriod *metav1.Duration) string {
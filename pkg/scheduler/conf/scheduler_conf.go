@@ -29,20 +29,24 @@ import (
 )
 
 type SchedulerParams struct {
-	SchedulerName                     string                    `json:"schedulerName,omitempty"`
-	RestrictSchedulingNodes           bool                      `json:"restrictSchedulingNodes,omitempty"`
-	PartitionParams                   *SchedulingNodePoolParams `json:"partitionParams,omitempty"`
-	MaxNumberConsolidationPreemptees  int                       `json:"maxNumberConsolidationPreemptees,omitempty"`
-	ScheduleCSIStorage                bool                      `json:"scheduleCSIStorage,omitempty"`
-	UseSchedulingSignatures           bool                      `json:"useSchedulingSignatures,omitempty"`
-	FullHierarchyFairness             bool                      `json:"fullHierarchyFairness,omitempty"`
-	AllowConsolidatingReclaim         bool                      `json:"allowConsolidatingReclaim,omitempty"`
-	NumOfStatusRecordingWorkers       int                       `json:"numOfStatusRecordingWorkers,omitempty"`
-	GlobalDefaultStalenessGracePeriod time.Duration             `json:"globalDefaultStalenessGracePeriod,omitempty"`
-	SchedulePeriod                    time.Duration             `json:"schedulePeriod,omitempty"`
-	DetailedFitErrors                 bool                      `json:"detailedFitErrors,omitempty"`
-	UpdatePodEvictionCondition        bool                      `json:"updatePodEvictionCondition,omitempty"`
-	QueueLabelKey                     string                    `json:"queueLabelKey,omitempty"`
+	SchedulerName                         string                    `json:"schedulerName,omitempty"`
+	RestrictSchedulingNodes               bool                      `json:"restrictSchedulingNodes,omitempty"`
+	PartitionParams                       *SchedulingNodePoolParams `json:"partitionParams,omitempty"`
+	MaxNumberConsolidationPreemptees      int                       `json:"maxNumberConsolidationPreemptees,omitempty"`
+	ScheduleCSIStorage                    bool                      `json:"scheduleCSIStorage,omitempty"`
+	UseSchedulingSignatures               bool                      `json:"useSchedulingSignatures,omitempty"`
+	FullHierarchyFairness                 bool                      `json:"fullHierarchyFairness,omitempty"`
+	AllowConsolidatingReclaim             bool                      `json:"allowConsolidatingReclaim,omitempty"`
+	Backfill                              bool                      `json:"backfill,omitempty"`
+	StarvationThreshold                   time.Duration             `json:"starvationThreshold,omitempty"`
+	TopologyDefragmentationInterval       time.Duration             `json:"topologyDefragmentationInterval,omitempty"`
+	TopologyDefragmentationEvictionBudget int                       `json:"topologyDefragmentationEvictionBudget,omitempty"`
+	NumOfStatusRecordingWorkers           int                       `json:"numOfStatusRecordingWorkers,omitempty"`
+	GlobalDefaultStalenessGracePeriod     time.Duration             `json:"globalDefaultStalenessGracePeriod,omitempty"`
+	SchedulePeriod                        time.Duration             `json:"schedulePeriod,omitempty"`
+	DetailedFitErrors                     bool                      `json:"detailedFitErrors,omitempty"`
+	UpdatePodEvictionCondition            bool                      `json:"updatePodEvictionCondition,omitempty"`
+	QueueLabelKey                         string                    `json:"queueLabelKey,omitempty"`
 }
 
 // SchedulerConfiguration defines the configuration of scheduler.
@@ -29,12 +29,15 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
 )
 
+// OpenSession opens a session on the cache. schedulingCycle is the state of the scheduling cycle for its sessions,
+// and nil for sessions that only evaluate scheduling decisions.
 func OpenSession(cache cache.Cache, config *conf.SchedulerConfiguration,
-	schedulerParams *conf.SchedulerParams, sessionId string, mux *http.ServeMux) (*Session, error) {
+	schedulerParams *conf.SchedulerParams, sessionId string, mux *http.ServeMux,
+	schedulingCycle *SchedulingCycleState) (*Session, error) {
 	openSessionStart := time.Now()
 	defer metrics.UpdateOpenSessionDuration(openSessionStart)
 
-	if server == nil {
+	if server == nil && mux != nil {
 		server = newPluginServer(mux)
 	}
 
@@ -43,6 +46,7 @@ func OpenSession(cache cache.Cache, config *conf.SchedulerConfiguration,
 		return nil, err
 	}
 	ssn.Config = config
+	ssn.SchedulingCycle = schedulingCycle
 
 	for _, tier := range config.Tiers {
 		for _, pluginOption := range tier.Plugins {
@@ -56,7 +60,9 @@ func OpenSession(cache cache.Cache, config *conf.SchedulerConfiguration,
 			ssn.plugins[plugin.Name()] = plugin
 
 			onSessionOpenPluginStart := time.Now()
+			ssn.registeringPlugin = plugin.Name()
 			plugin.OnSessionOpen(ssn)
+			ssn.registeringPlugin = ""
 			metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionOpen, metrics.Duration(onSessionOpenPluginStart))
 		}
 	}
@@ -25,6 +25,8 @@ import (
 	"time"
 
 	"github.com/xhit/go-str2duration/v2"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 )
 
 type ActionType string
@@ -46,6 +48,15 @@ type Action interface {
 	Execute(ssn *Session)
 }
 
+// JobAction is implemented by actions that can attempt to schedule a single job outside of Execute.
+type JobAction interface {
+	Action
+
+	// AttemptToScheduleJob returns true if the action found a way to schedule the job, along with the statement
+	// that holds the job's allocation and its victims' evictions. The statement is neither committed nor discarded.
+	AttemptToScheduleJob(ssn *Session, job *podgroup_info.PodGroupInfo) (bool, *Statement)
+}
+
 type Plugin interface {
 	// The unique name of Plugin.
 	Name() string
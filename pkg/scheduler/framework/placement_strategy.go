@@ -0,0 +1,48 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+)
+
+// TaskPlacementStrategy returns the placement strategy that overrides the scheduling shard one for the given task and
+// resource type (constants.GPUResource or constants.CPUResource). The PodGroup of the task takes precedence over its
+// queue, and the queue over its ancestor queues. An empty string is returned when nothing overrides the strategy.
+func (ssn *Session) TaskPlacementStrategy(task *pod_info.PodInfo, resourceType string) string {
+	if task == nil {
+		return ""
+	}
+	job, found := ssn.ClusterInfo.PodGroupInfos[task.Job]
+	if !found {
+		return ""
+	}
+
+	if job.PodGroup != nil && job.PodGroup.Spec.PlacementStrategy != nil {
+		strategy := job.PodGroup.Spec.PlacementStrategy
+		if value := placementStrategyForResource(strategy.GPU, strategy.CPU, resourceType); value != "" {
+			return value
+		}
+	}
+
+	queue := ssn.ClusterInfo.Queues[job.Queue]
+	// The number of visited queues is bounded to guard against cycles in the queue hierarchy
+	for visited := 0; queue != nil && visited < len(ssn.ClusterInfo.Queues); visited++ {
+		if strategy := queue.PlacementStrategy; strategy != nil {
+			if value := placementStrategyForResource(strategy.GPU, strategy.CPU, resourceType); value != "" {
+				return value
+			}
+		}
+		queue = ssn.ClusterInfo.Queues[queue.ParentQueue]
+	}
+	return ""
+}
+
+func placementStrategyForResource(gpuStrategy, cpuStrategy, resourceType string) string {
+	if resourceType == constants.CPUResource {
+		return cpuStrategy
+	}
+	return gpuStrategy
+}
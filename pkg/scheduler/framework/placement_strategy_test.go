@@ -0,0 +1,97 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+)
+
+func TestTaskPlacementStrategy(t *testing.T) {
+	tests := []struct {
+		name                   string
+		podGroupStrategy       *enginev2alpha2.PlacementStrategy
+		queueStrategy          *enginev2.PlacementStrategy
+		parentQueueStrategy    *enginev2.PlacementStrategy
+		cyclicQueues           bool
+		resourceType           string
+		expectedPlacementValue string
+	}{
+		{
+			name:                   "no overrides",
+			resourceType:           constants.GPUResource,
+			expectedPlacementValue: "",
+		},
+		{
+			name:                   "podgroup override",
+			podGroupStrategy:       &enginev2alpha2.PlacementStrategy{GPU: constants.SpreadStrategy},
+			queueStrategy:          &enginev2.PlacementStrategy{GPU: constants.BinpackStrategy},
+			resourceType:           constants.GPUResource,
+			expectedPlacementValue: constants.SpreadStrategy,
+		},
+		{
+			name:                   "queue override for a resource type the podgroup doesn't set",
+			podGroupStrategy:       &enginev2alpha2.PlacementStrategy{GPU: constants.SpreadStrategy},
+			queueStrategy:          &enginev2.PlacementStrategy{CPU: constants.SpreadStrategy},
+			resourceType:           constants.CPUResource,
+			expectedPlacementValue: constants.SpreadStrategy,
+		},
+		{
+			name:                   "inherited from the parent queue",
+			parentQueueStrategy:    &enginev2.PlacementStrategy{GPU: constants.SpreadStrategy},
+			resourceType:           constants.GPUResource,
+			expectedPlacementValue: constants.SpreadStrategy,
+		},
+		{
+			name:                   "queue overrides the parent queue",
+			queueStrategy:          &enginev2.PlacementStrategy{GPU: constants.BinpackStrategy},
+			parentQueueStrategy:    &enginev2.PlacementStrategy{GPU: constants.SpreadStrategy},
+			resourceType:           constants.GPUResource,
+			expectedPlacementValue: constants.BinpackStrategy,
+		},
+		{
+			name:                   "cyclic queue hierarchy",
+			cyclicQueues:           true,
+			resourceType:           constants.GPUResource,
+			expectedPlacementValue: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := &pod_info.PodInfo{UID: "task", Job: "job"}
+			job := podgroup_info.NewPodGroupInfo("job", task)
+			job.Queue = "queue"
+			job.PodGroup = &enginev2alpha2.PodGroup{
+				Spec: enginev2alpha2.PodGroupSpec{PlacementStrategy: test.podGroupStrategy},
+			}
+			parentOfParent := common_info.QueueID("")
+			if test.cyclicQueues {
+				parentOfParent = "queue"
+			}
+			ssn := &Session{
+				ClusterInfo: &api.ClusterInfo{
+					PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job},
+					Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+						"queue": {UID: "queue", ParentQueue: "parent", PlacementStrategy: test.queueStrategy},
+						"parent": {UID: "parent", ParentQueue: parentOfParent,
+							PlacementStrategy: test.parentQueueStrategy},
+					},
+				},
+			}
+
+			assert.Equal(t, test.expectedPlacementValue, ssn.TaskPlacementStrategy(task, test.resourceType))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+type extensionPoint string
+
+const (
+	prePredicateExtensionPoint             extensionPoint = "prePredicate"
+	predicateExtensionPoint                extensionPoint = "predicate"
+	subsetNodesExtensionPoint              extensionPoint = "subsetNodes"
+	reclaimScenarioValidatorExtensionPoint extensionPoint = "reclaimScenarioValidator"
+	preemptScenarioValidatorExtensionPoint extensionPoint = "preemptScenarioValidator"
+
+	// UnknownPlugin names the owner of functions that were not registered from a plugin's OnSessionOpen.
+	UnknownPlugin = "unknown"
+)
+
+// PluginFn is a session function along with the name of the plugin that registered it.
+type PluginFn[T any] struct {
+	Plugin string
+	Fn     T
+}
+
+func (ssn *Session) PrePredicateFnsByPlugin() []PluginFn[api.PrePredicateFn] {
+	return pluginFns(ssn.PrePredicateFns, ssn.fnPlugins[prePredicateExtensionPoint])
+}
+
+func (ssn *Session) PredicateFnsByPlugin() []PluginFn[api.PredicateFn] {
+	return pluginFns(ssn.PredicateFns, ssn.fnPlugins[predicateExtensionPoint])
+}
+
+func (ssn *Session) SubsetNodesFnsByPlugin() []PluginFn[api.SubsetNodesFn] {
+	return pluginFns(ssn.SubsetNodesFns, ssn.fnPlugins[subsetNodesExtensionPoint])
+}
+
+func (ssn *Session) ReclaimScenarioValidatorFnsByPlugin() []PluginFn[api.ScenarioValidatorFn] {
+	return pluginFns(ssn.ReclaimScenarioValidatorFns, ssn.fnPlugins[reclaimScenarioValidatorExtensionPoint])
+}
+
+func (ssn *Session) PreemptScenarioValidatorFnsByPlugin() []PluginFn[api.ScenarioValidatorFn] {
+	return pluginFns(ssn.PreemptScenarioValidatorFns, ssn.fnPlugins[preemptScenarioValidatorExtensionPoint])
+}
+
+// NodeResourcesFitError returns the reason the node lacks the idle or releasing resources the task requires, or nil
+// if the task fits the node's resources.
+func (ssn *Session) NodeResourcesFitError(task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo,
+	node *node_info.NodeInfo) error {
+	allocatable, fitError := ssn.isTaskAllocatableOnNode(task, job, node, true)
+	if allocatable {
+		return nil
+	}
+	if fitError != nil {
+		return fitError
+	}
+	return fmt.Errorf("node %s does not have enough idle or releasing resources for the task", node.Name)
+}
+
+func (ssn *Session) recordRegisteringPlugin(point extensionPoint) {
+	if ssn.fnPlugins == nil {
+		ssn.fnPlugins = map[extensionPoint][]string{}
+	}
+	ssn.fnPlugins[point] = append(ssn.fnPlugins[point], ssn.registeringPlugin)
+}
+
+func pluginFns[T any](fns []T, plugins []string) []PluginFn[T] {
+	result := make([]PluginFn[T], 0, len(fns))
+	for index, fn := range fns {
+		plugin := UnknownPlugin
+		if index < len(plugins) && len(plugins[index]) > 0 {
+			plugin = plugins[index]
+		}
+		result = append(result, PluginFn[T]{Plugin: plugin, Fn: fn})
+	}
+	return result
+}
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	ksf "k8s.io/kube-scheduler/framework"
 
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
@@ -74,6 +75,7 @@ type Session struct {
 	IsJobOverCapacityFns                  []api.IsJobOverCapacityFn
 	IsTaskAllocationOnNodeOverCapacityFns []api.IsTaskAllocationOverCapacityFn
 	SubsetNodesFns                        []api.SubsetNodesFn
+	TopologyDomainsFns                    []api.TopologyDomainsFn
 	PrePredicateFns                       []api.PrePredicateFn
 	PredicateFns                          []api.PredicateFn
 	BindRequestMutateFns                  []api.BindRequestMutateFn
@@ -85,9 +87,28 @@ type Session struct {
 	SchedulerParams conf.SchedulerParams
 	mux             *http.ServeMux
 
+	// SchedulingCycle is the state the scheduling cycle keeps across its sessions. It is nil for the sessions that
+	// only evaluate scheduling decisions, such as what-if and explain requests.
+	SchedulingCycle *SchedulingCycleState
+
+	starvationReservationsOnce sync.Once
+	starvationReservations     *starvationReservations
+
+	// registeringPlugin is the plugin whose OnSessionOpen is running. fnPlugins records it for every function that
+	// is registered on an extension point that may be explained per plugin.
+	registeringPlugin string
+	fnPlugins         map[extensionPoint][]string
+
 	k8sResourceStateCache sync.Map
 }
 
+// SchedulingCycleState is the state the scheduling cycle keeps across its sessions. The sessions of the scheduling
+// cycle run one after the other, so the state is not guarded.
+type SchedulingCycleState struct {
+	// LastTopologyDefragmentation is the time the consolidation action last defragmented the topologies at.
+	LastTopologyDefragmentation time.Time
+}
+
 func (ssn *Session) Statement() *Statement {
 	return &Statement{ssn: ssn, sessionID: ssn.ID}
 }
@@ -107,9 +128,9 @@ func (ssn *Session) GetNodes() []ksf.NodeInfo {
 	return nodes
 }
 
-func (ssn *Session) BindPod(pod *pod_info.PodInfo) error {
+func (ssn *Session) BindPod(pod *pod_info.PodInfo, gang *schedulingv1alpha2.GangBinding) error {
 	bindRequestAnnotations := ssn.MutateBindRequestAnnotations(pod, pod.NodeName)
-	if err := ssn.Cache.Bind(pod, pod.NodeName, bindRequestAnnotations); err != nil {
+	if err := ssn.Cache.Bind(pod, pod.NodeName, gang, bindRequestAnnotations); err != nil {
 		return err
 	}
 
@@ -169,7 +190,7 @@ func (ssn *Session) FittingGPUs(node *node_info.NodeInfo, pod *pod_info.PodInfo)
 func filterGpusByEnoughResources(node *node_info.NodeInfo, pod *pod_info.PodInfo) []string {
 	filteredGPUs := []string{}
 	for gpuIdx := range node.UsedSharedGPUsMemory {
-		if node.IsTaskFitOnGpuGroup(pod.ResReq, gpuIdx) {
+		if node.IsTaskFitOnGpuGroup(pod, gpuIdx) {
 			filteredGPUs = append(filteredGPUs, gpuIdx)
 		}
 	}
@@ -227,6 +248,17 @@ func (ssn *Session) FittingNode(task *pod_info.PodInfo, node *node_info.NodeInfo
 		}
 		return false
 	}
+
+	// Checked last, so that the reservation is reported only for nodes the task would otherwise fit on
+	if err := ssn.checkStarvationReservation(job, node); err != nil {
+		log.InfraLogger.V(6).Infof("Task <%s/%s> may not use node <%s>: %v",
+			task.Namespace, task.Name, node.Name, err)
+		if writeFittingDelta {
+			fitErrors.SetNodeError(node.Name, err)
+			job.AddTaskFitErrors(task, fitErrors)
+		}
+		return false
+	}
 	return true
 }
 
@@ -390,6 +422,12 @@ func (ssn *Session) OverrideMaxNumberConsolidationPreemptees(maxPreemptees int)
 	ssn.SchedulerParams.MaxNumberConsolidationPreemptees = maxPreemptees
 }
 
+// IsSchedulingCycle returns true if the session was opened by the scheduling cycle. Sessions that only evaluate
+// scheduling decisions do not update the state that is shared with the scheduling cycle, nor its metrics.
+func (ssn *Session) IsSchedulingCycle() bool {
+	return ssn.SchedulingCycle != nil
+}
+
 func (ssn *Session) UseSchedulingSignatures() bool {
 	return ssn.SchedulerParams.UseSchedulingSignatures
 }
@@ -427,6 +465,24 @@ func (ssn *Session) AllowConsolidatingReclaim() bool {
 	return ssn.SchedulerParams.AllowConsolidatingReclaim
 }
 
+func (ssn *Session) UseBackfill() bool {
+	return ssn.SchedulerParams.Backfill
+}
+
+func (ssn *Session) GetStarvationThreshold() time.Duration {
+	return ssn.SchedulerParams.StarvationThreshold
+}
+
+// GetTopologyDefragmentationInterval returns the minimal period between topology defragmentation runs of the
+// consolidation action. Zero means topology defragmentation is disabled.
+func (ssn *Session) GetTopologyDefragmentationInterval() time.Duration {
+	return ssn.SchedulerParams.TopologyDefragmentationInterval
+}
+
+func (ssn *Session) GetTopologyDefragmentationEvictionBudget() int {
+	return ssn.SchedulerParams.TopologyDefragmentationEvictionBudget
+}
+
 func (ssn *Session) GetGlobalDefaultStalenessGracePeriod() time.Duration {
 	return ssn.SchedulerParams.GlobalDefaultStalenessGracePeriod
 }
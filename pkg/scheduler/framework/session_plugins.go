@@ -48,14 +48,21 @@ func (ssn *Session) AddNodeOrderFn(nof api.NodeOrderFn) {
 
 func (ssn *Session) AddPrePredicateFn(pf api.PrePredicateFn) {
 	ssn.PrePredicateFns = append(ssn.PrePredicateFns, pf)
+	ssn.recordRegisteringPlugin(prePredicateExtensionPoint)
 }
 
 func (ssn *Session) AddSubsetNodesFn(snf api.SubsetNodesFn) {
 	ssn.SubsetNodesFns = append(ssn.SubsetNodesFns, snf)
+	ssn.recordRegisteringPlugin(subsetNodesExtensionPoint)
+}
+
+func (ssn *Session) AddTopologyDomainsFn(tdf api.TopologyDomainsFn) {
+	ssn.TopologyDomainsFns = append(ssn.TopologyDomainsFns, tdf)
 }
 
 func (ssn *Session) AddPredicateFn(pf api.PredicateFn) {
 	ssn.PredicateFns = append(ssn.PredicateFns, pf)
+	ssn.recordRegisteringPlugin(predicateExtensionPoint)
 }
 
 func (ssn *Session) AddJobOrderFn(jof common_info.CompareFn) {
@@ -96,10 +103,12 @@ func (ssn *Session) AddCanReclaimResourcesFn(crf api.CanReclaimResourcesFn) {
 
 func (ssn *Session) AddReclaimScenarioValidatorFn(rf api.ScenarioValidatorFn) {
 	ssn.ReclaimScenarioValidatorFns = append(ssn.ReclaimScenarioValidatorFns, rf)
+	ssn.recordRegisteringPlugin(reclaimScenarioValidatorExtensionPoint)
 }
 
 func (ssn *Session) AddPreemptScenarioValidatorFn(rf api.ScenarioValidatorFn) {
 	ssn.PreemptScenarioValidatorFns = append(ssn.PreemptScenarioValidatorFns, rf)
+	ssn.recordRegisteringPlugin(preemptScenarioValidatorExtensionPoint)
 }
 
 func (ssn *Session) AddReclaimVictimFilterFn(rf api.VictimFilterFn) {
@@ -164,8 +173,10 @@ func (ssn *Session) PreemptScenarioValidator(
 	return true
 }
 
+// AddHttpHandler registers a handler on the plugin server. Sessions that are opened without a mux, such as the
+// sessions that evaluate hypothetical scheduling decisions, do not register handlers.
 func (ssn *Session) AddHttpHandler(path string, handler func(http.ResponseWriter, *http.Request)) {
-	if server == nil {
+	if server == nil || ssn.mux == nil {
 		return
 	}
 	err := server.registerPlugin(path, handler)
@@ -378,6 +389,15 @@ func logNodeSetsPluginResult(subsetNodesFn api.SubsetNodesFn, podGroup *podgroup
 		"Result of plugin func <%v> on podGroup <%s/%s> is %v", subsetNodesFn, podGroup.Namespace, podGroup.Namespace, nodeSetsByNames)
 }
 
+func (ssn *Session) TopologyDomains(job *podgroup_info.PodGroupInfo) []node_info.NodeSet {
+	for _, topologyDomainsFn := range ssn.TopologyDomainsFns {
+		if domains := topologyDomainsFn(job); domains != nil {
+			return domains
+		}
+	}
+	return nil
+}
+
 func (ssn *Session) PrePredicateFn(task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo) error {
 	for _, prePredicate := range ssn.PrePredicateFns {
 		err := prePredicate(task, job)
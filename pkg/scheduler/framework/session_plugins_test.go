@@ -162,3 +162,19 @@ func TestPartitionMultiImplementation(t *testing.T) {
 	assert.Equal(t, partitions[3][0].Name, "cluster1rack1-1")
 	assert.Equal(t, partitions[3][1].Name, "cluster1rack1-2")
 }
+
+func TestPredicateFnsByPlugin(t *testing.T) {
+	ssn := &Session{}
+	ssn.registeringPlugin = "first"
+	ssn.AddPredicateFn(func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo, *node_info.NodeInfo) error { return nil })
+	ssn.registeringPlugin = ""
+	ssn.AddPredicateFn(func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo, *node_info.NodeInfo) error { return nil })
+	ssn.PredicateFns = append(ssn.PredicateFns,
+		func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo, *node_info.NodeInfo) error { return nil })
+
+	var plugins []string
+	for _, predicate := range ssn.PredicateFnsByPlugin() {
+		plugins = append(plugins, predicate.Plugin)
+	}
+	assert.Equal(t, []string{"first", UnknownPlugin, UnknownPlugin}, plugins)
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// starvationReservations holds the nodes that best fit pod groups that have been pending for longer than the
+// starvation threshold, so that jobs of the same or lower priority do not keep taking the resources that are
+// released on them.
+type starvationReservations struct {
+	threshold   time.Duration
+	reservedFor map[string]*podgroup_info.PodGroupInfo
+}
+
+// checkStarvationReservation returns an error if the node is held for a starving pod group that the job may not
+// take the node from. The nodes are held from the first check of the session, before any allocation changed them.
+func (ssn *Session) checkStarvationReservation(job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo) error {
+	ssn.starvationReservationsOnce.Do(func() {
+		ssn.starvationReservations = newStarvationReservations(ssn, time.Now())
+	})
+	if ssn.starvationReservations == nil {
+		return nil
+	}
+
+	reservedFor, found := ssn.starvationReservations.reservedFor[node.Name]
+	if !found || reservedFor.UID == job.UID || job.Priority > reservedFor.Priority {
+		return nil
+	}
+	return fmt.Errorf("node is held for podgroup %s/%s, which has been pending for more than %s",
+		reservedFor.Namespace, reservedFor.Name, ssn.starvationReservations.threshold)
+}
+
+func newStarvationReservations(ssn *Session, now time.Time) *starvationReservations {
+	threshold := ssn.GetStarvationThreshold()
+	if threshold <= 0 {
+		return nil
+	}
+
+	var starvingJobs []*podgroup_info.PodGroupInfo
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if isStarving(ssn, job, now, threshold) {
+			starvingJobs = append(starvingJobs, job)
+		}
+	}
+	if len(starvingJobs) == 0 {
+		return nil
+	}
+	sort.SliceStable(starvingJobs, func(i, j int) bool {
+		if starvingJobs[i].Priority != starvingJobs[j].Priority {
+			return starvingJobs[i].Priority > starvingJobs[j].Priority
+		}
+		return starvingJobs[i].GetPendingSince().Before(starvingJobs[j].GetPendingSince())
+	})
+
+	reservations := &starvationReservations{
+		threshold:   threshold,
+		reservedFor: map[string]*podgroup_info.PodGroupInfo{},
+	}
+	for _, job := range starvingJobs {
+		reservedNodes := reservations.reserveNodes(ssn, job)
+		if len(reservedNodes) == 0 {
+			continue
+		}
+		log.InfraLogger.V(3).Infof("Reserved nodes %v for starving job <%s/%s>", reservedNodes, job.Namespace,
+			job.Name)
+		job.AddSimpleJobFitError(enginev2alpha2.NodesReserved, fmt.Sprintf(
+			"The podgroup has been pending for more than %s, holding nodes %s for it",
+			threshold, strings.Join(reservedNodes, ", ")))
+	}
+	return reservations
+}
+
+// reserveNodes holds the nodes that the job may run on and that are closest to having enough free resources for it,
+// until their capacity covers the job's request. Returns the names of the reserved nodes.
+func (r *starvationReservations) reserveNodes(ssn *Session, job *podgroup_info.PodGroupInfo) []string {
+	tasks := podgroup_info.GetTasksToAllocate(job, ssn.PodSetOrderFn, ssn.TaskOrderFn, true)
+	for _, task := range tasks {
+		if err := ssn.PrePredicateFn(task, job); err != nil {
+			return nil
+		}
+	}
+	required := podgroup_info.GetTasksToAllocateInitResource(job, ssn.PodSetOrderFn, ssn.TaskOrderFn, true,
+		ssn.ClusterInfo.MinNodeGPUMemory)
+
+	var candidates []*node_info.NodeInfo
+	for _, node := range ssn.ClusterInfo.Nodes {
+		if _, found := r.reservedFor[node.Name]; found {
+			continue
+		}
+		if !resource_info.HasRequiredResourceTypes(node.Allocatable, required) || !canRunOnNode(ssn, job, tasks, node) {
+			continue
+		}
+		candidates = append(candidates, node)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		compared := resource_info.CompareFreeResources(
+			candidates[i].NonAllocatedResources(), candidates[j].NonAllocatedResources(), required)
+		if compared != 0 {
+			return compared > 0
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	capacity := resource_info.EmptyResource()
+	var reservedNodes []string
+	for _, node := range candidates {
+		capacity.Add(node.Allocatable)
+		reservedNodes = append(reservedNodes, node.Name)
+		if required.LessEqual(capacity) {
+			for _, nodeName := range reservedNodes {
+				r.reservedFor[nodeName] = job
+			}
+			return reservedNodes
+		}
+	}
+	return nil
+}
+
+// canRunOnNode returns true if one of the tasks passes the predicates on the node, such as its node selector, affinity
+// and tolerations. The free resources of the node are not checked, the node is held until they are released.
+func canRunOnNode(ssn *Session, job *podgroup_info.PodGroupInfo, tasks []*pod_info.PodInfo,
+	node *node_info.NodeInfo) bool {
+	for _, task := range tasks {
+		if err := ssn.PredicateFn(task, job, node); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isStarving(ssn *Session, job *podgroup_info.PodGroupInfo, now time.Time, threshold time.Duration) bool {
+	if job.GetNumPendingTasks() == 0 || !job.IsReadyForScheduling() || job.IsGangSatisfied() {
+		return false
+	}
+	if now.Sub(job.GetPendingSince()) < threshold {
+		return false
+	}
+
+	// Holding nodes for a job that its queue does not allow to run would only leave them idle
+	tasksToAllocate := podgroup_info.GetTasksToAllocate(job, ssn.PodSetOrderFn, ssn.TaskOrderFn, true)
+	return len(tasksToAllocate) > 0 && ssn.IsJobOverQueueCapacityFn(job, tasksToAllocate).IsSchedulable
+}
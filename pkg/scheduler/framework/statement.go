@@ -23,7 +23,9 @@ import (
 	"fmt"
 
 	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/util/uuid"
 
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/bindrequest_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
@@ -357,7 +359,7 @@ func (s *Statement) Allocate(task *pod_info.PodInfo, hostname string) error {
 	return nil
 }
 
-func (s *Statement) commitAllocate(task *pod_info.PodInfo) error {
+func (s *Statement) commitAllocate(task *pod_info.PodInfo, gang *schedulingv1alpha2.GangBinding) error {
 	hostname := task.NodeName
 	node, found := s.ssn.ClusterInfo.Nodes[hostname]
 	if !found {
@@ -380,7 +382,7 @@ func (s *Statement) commitAllocate(task *pod_info.PodInfo) error {
 		}
 	}
 
-	if err = s.ssn.BindPod(task); err != nil {
+	if err = s.ssn.BindPod(task, gang); err != nil {
 		log.InfraLogger.Errorf("Failed to bind task <%v/%v>. Error: %v",
 			task.Namespace, task.Name, err)
 	}
@@ -542,6 +544,7 @@ func (s *Statement) Commit() error {
 	var err error
 
 	log.InfraLogger.V(4).Infof("Committing operations ...")
+	gangs := s.gangBindings()
 	for i, op := range s.operations {
 		if !s.operationValid(i) {
 			continue
@@ -561,7 +564,7 @@ func (s *Statement) Commit() error {
 			s.commitPipeline(taskInfo, op.(pipelineOperation).message)
 		case allocate:
 			log.InfraLogger.V(4).Infof("Allocating task: %v/%v", taskInfo.Namespace, taskInfo.Name)
-			err = s.commitAllocate(taskInfo)
+			err = s.commitAllocate(taskInfo, gangs[taskInfo.Job])
 			if err != nil {
 				log.InfraLogger.Errorf("Failed to allocate task. error: %s", err.Error())
 				s.clearOperations()
@@ -575,6 +578,46 @@ func (s *Statement) Commit() error {
 	return err
 }
 
+// gangBindings returns the gang of every job that the statement allocates at least two of the tasks it needs to reach
+// its minimum, so that the binder binds all of these tasks or none of them.
+func (s *Statement) gangBindings() map[common_info.PodGroupID]*schedulingv1alpha2.GangBinding {
+	allocatedTasksCount := map[common_info.PodGroupID]int32{}
+	for i, op := range s.operations {
+		if s.operationValid(i) && op.Name() == allocate {
+			allocatedTasksCount[op.TaskInfo().Job]++
+		}
+	}
+
+	gangs := map[common_info.PodGroupID]*schedulingv1alpha2.GangBinding{}
+	for jobID, allocatedCount := range allocatedTasksCount {
+		job, found := s.ssn.ClusterInfo.PodGroupInfos[jobID]
+		if !found {
+			continue
+		}
+
+		minMember := int32(0)
+		for _, podSet := range job.PodSets {
+			minMember += podSet.GetMinAvailable()
+		}
+		for _, task := range job.GetAllPodsMap() {
+			if task.Status == pod_status.Bound || task.Status == pod_status.Running {
+				minMember--
+			}
+		}
+
+		gangSize := min(allocatedCount, minMember)
+		if gangSize < 2 {
+			continue
+		}
+		gangs[jobID] = &schedulingv1alpha2.GangBinding{
+			PodGroupName: job.Name,
+			ID:           string(uuid.NewUUID()),
+			MinMember:    gangSize,
+		}
+	}
+	return gangs
+}
+
 // undoEarliestValidOperation will undo the earliest valid operation of the given type
 func (s *Statement) undoEarliestValidOperation(taskToUndo *pod_info.PodInfo, opName string) error {
 	for index, op := range s.operations {
@@ -1271,3 +1271,93 @@ func TestStatement_Allocate_Undo_Undo(t *testing.T) {
 		})
 	}
 }
+
+func TestStatement_GangBindings(t *testing.T) {
+	tests := []struct {
+		name              string
+		job               *jobs_fake.TestJobBasic
+		tasksToAllocate   int
+		expectedMinMember int32
+	}{
+		{
+			name: "allocating the whole gang",
+			job: &jobs_fake.TestJobBasic{
+				Name: "job0", QueueName: "queue0", RequiredGPUsPerTask: 1,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending}, {State: pod_status.Pending}, {State: pod_status.Pending},
+				},
+			},
+			tasksToAllocate:   3,
+			expectedMinMember: 3,
+		},
+		{
+			name: "running tasks are not part of the gang",
+			job: &jobs_fake.TestJobBasic{
+				Name: "job0", QueueName: "queue0", RequiredGPUsPerTask: 1,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Running, NodeName: "node0"}, {State: pod_status.Pending},
+					{State: pod_status.Pending},
+				},
+			},
+			tasksToAllocate:   2,
+			expectedMinMember: 2,
+		},
+		{
+			name: "tasks over min available are not part of the gang",
+			job: &jobs_fake.TestJobBasic{
+				Name: "job0", QueueName: "queue0", RequiredGPUsPerTask: 1,
+				RootSubGroupSet: jobs_fake.DefaultSubGroup(2),
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending}, {State: pod_status.Pending}, {State: pod_status.Pending},
+				},
+			},
+			tasksToAllocate:   3,
+			expectedMinMember: 2,
+		},
+		{
+			name: "a single task is not a gang",
+			job: &jobs_fake.TestJobBasic{
+				Name: "job0", QueueName: "queue0", RequiredGPUsPerTask: 1,
+				RootSubGroupSet: jobs_fake.DefaultSubGroup(1),
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending}, {State: pod_status.Pending},
+				},
+			},
+			tasksToAllocate: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{tt.job})
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 8},
+			}, tasksToNodeMap, nil)
+
+			s := &Statement{
+				operations: []Operation{},
+				ssn: &Session{
+					ClusterInfo: &api.ClusterInfo{
+						PodGroupInfos: jobsInfoMap,
+						Nodes:         nodesInfoMap,
+					},
+				},
+				sessionID: "1234",
+			}
+
+			job := jobsInfoMap[common_info.PodGroupID(tt.job.Name)]
+			for i := 0; i < tt.tasksToAllocate; i++ {
+				task := job.GetAllPodsMap()[common_info.PodID(fmt.Sprintf("%s-%d", tt.job.Name, len(tt.job.Tasks)-1-i))]
+				assert.NoError(t, s.Allocate(task, "node0"))
+			}
+
+			gang := s.gangBindings()[job.UID]
+			if tt.expectedMinMember == 0 {
+				assert.Nil(t, gang)
+				return
+			}
+			assert.Equal(t, tt.job.Name, gang.PodGroupName)
+			assert.NotEmpty(t, gang.ID)
+			assert.Equal(t, tt.expectedMinMember, gang.MinMember)
+		})
+	}
+}
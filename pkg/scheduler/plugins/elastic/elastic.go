@@ -4,11 +4,17 @@
 package elastic
 
 import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 )
 
-type elasticPlugin struct{}
+type elasticPlugin struct {
+	// queueFairShares are the fair shares of the leaf queues, taken on session open as the proportion plugin clears
+	// them on session close.
+	queueFairShares map[common_info.QueueID]*resource_info.ResourceRequirements
+}
 
 func New(_ framework.PluginArguments) framework.Plugin {
 	return &elasticPlugin{}
@@ -20,6 +26,16 @@ func (pp *elasticPlugin) Name() string {
 
 func (pp *elasticPlugin) OnSessionOpen(ssn *framework.Session) {
 	ssn.AddJobOrderFn(JobOrderFn)
+
+	pp.queueFairShares = map[common_info.QueueID]*resource_info.ResourceRequirements{}
+	for _, queue := range ssn.ClusterInfo.Queues {
+		if !queue.IsLeafQueue() {
+			continue
+		}
+		if fairShare := ssn.QueueFairShare(queue); fairShare != nil {
+			pp.queueFairShares[queue.UID] = fairShare
+		}
+	}
 }
 
 func JobOrderFn(l, r interface{}) int {
@@ -64,4 +80,7 @@ func minAvailableState(pgi *podgroup_info.PodGroupInfo) (bool, bool, bool) {
 	return false, !exactlyAtMinAvailable, exactlyAtMinAvailable
 }
 
-func (pp *elasticPlugin) OnSessionClose(_ *framework.Session) {}
+func (pp *elasticPlugin) OnSessionClose(ssn *framework.Session) {
+	setElasticTargets(ssn.ClusterInfo.PodGroupInfos, pp.queueFairShares)
+	pp.queueFairShares = nil
+}
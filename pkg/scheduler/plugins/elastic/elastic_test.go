@@ -6,6 +6,7 @@ package elastic
 import (
 	"testing"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
@@ -517,7 +518,7 @@ func Test_elasticPlugin_OnSessionOpen(t *testing.T) {
 				pluginArguments: map[string]string{},
 			},
 			args: args{
-				ssn: &framework.Session{},
+				ssn: &framework.Session{ClusterInfo: &api.ClusterInfo{}},
 			},
 			jobOrderSetup: map[string]common_info.CompareFn{
 				"elastic": JobOrderFn,
@@ -0,0 +1,153 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package elastic
+
+import (
+	"math"
+	"sort"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// resourceVector holds the amounts of the resources that elastic targets are computed by.
+type resourceVector struct {
+	gpus   float64
+	cpu    float64
+	memory float64
+}
+
+func newResourceVector(resReq *resource_info.ResourceRequirements) resourceVector {
+	return resourceVector{gpus: resReq.GetGpusQuota(), cpu: resReq.Cpu(), memory: resReq.Memory()}
+}
+
+func (v *resourceVector) add(other resourceVector, times float64) {
+	v.gpus += other.gpus * times
+	v.cpu += other.cpu * times
+	v.memory += other.memory * times
+}
+
+// fittingPods returns the number of pods of the given size that fit the vector, rounded down. A negative result is
+// the number of pods that has to be removed for the vector to stop being negative. GPU pods are only counted by their
+// GPUs, as the fair share of the other resources is rarely what limits them.
+func (v resourceVector) fittingPods(podSize resourceVector) int32 {
+	amountsPairs := [][2]float64{{v.cpu, podSize.cpu}, {v.memory, podSize.memory}}
+	if podSize.gpus > 0 {
+		amountsPairs = [][2]float64{{v.gpus, podSize.gpus}}
+	}
+
+	pods := math.Inf(1)
+	for _, amounts := range amountsPairs {
+		if amounts[1] > 0 {
+			pods = math.Min(pods, math.Floor(amounts[0]/amounts[1]))
+		}
+	}
+	if math.IsInf(pods, 1) || pods > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(pods)
+}
+
+// setElasticTargets computes the target number of pods of every elastic pod group, one that sets MaxMember. The fair
+// share of each queue that is not used by its pod groups is handed to its elastic pod groups in whole pods. When the
+// queue is over its fair share, the elastic pod groups that come last in order scale down first, up to MinMember.
+func setElasticTargets(
+	jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo,
+	queueFairShares map[common_info.QueueID]*resource_info.ResourceRequirements,
+) {
+	queueHeadrooms := map[common_info.QueueID]*resourceVector{}
+	elasticJobsByQueue := map[common_info.QueueID][]*podgroup_info.PodGroupInfo{}
+	for _, job := range jobs {
+		fairShare, found := queueFairShares[job.Queue]
+		if !found {
+			continue
+		}
+		headroom, found := queueHeadrooms[job.Queue]
+		if !found {
+			headroom = ptr.To(newResourceVector(fairShare))
+			queueHeadrooms[job.Queue] = headroom
+		}
+		for _, task := range job.GetAllPodsMap() {
+			if pod_status.IsActiveAllocatedStatus(task.Status) {
+				headroom.add(newResourceVector(task.ResReq), -1)
+			}
+		}
+		if isElasticJob(job) {
+			elasticJobsByQueue[job.Queue] = append(elasticJobsByQueue[job.Queue], job)
+		}
+	}
+
+	for queueID, elasticJobs := range elasticJobsByQueue {
+		sort.Slice(elasticJobs, func(i, j int) bool {
+			return elasticJobLess(elasticJobs[i], elasticJobs[j])
+		})
+		setQueueElasticTargets(elasticJobs, queueHeadrooms[queueID])
+	}
+}
+
+// setQueueElasticTargets splits the headroom of a queue between its elastic pod groups, sorted by order.
+func setQueueElasticTargets(elasticJobs []*podgroup_info.PodGroupInfo, headroom *resourceVector) {
+	targets := make([]int32, len(elasticJobs))
+	podSizes := make([]resourceVector, len(elasticJobs))
+	for i, job := range elasticJobs {
+		podSizes[i] = getElasticPodSize(job)
+		activePods := int32(job.GetActiveAllocatedTasksCount())
+		targets[i] = min(max(activePods, job.PodGroup.Spec.MinMember), *job.PodGroup.Spec.MaxMember)
+		headroom.add(podSizes[i], float64(targets[i]-activePods))
+	}
+
+	for i := len(elasticJobs) - 1; i >= 0; i-- {
+		fittingPods := headroom.fittingPods(podSizes[i])
+		if fittingPods >= 0 {
+			continue
+		}
+		scaleDown := min(-fittingPods, targets[i]-elasticJobs[i].PodGroup.Spec.MinMember)
+		targets[i] -= scaleDown
+		headroom.add(podSizes[i], float64(scaleDown))
+	}
+
+	for i, job := range elasticJobs {
+		scaleUp := min(max(headroom.fittingPods(podSizes[i]), 0), *job.PodGroup.Spec.MaxMember-targets[i])
+		targets[i] += scaleUp
+		headroom.add(podSizes[i], -float64(scaleUp))
+
+		log.InfraLogger.V(4).Infof("Elastic pod group <%s/%s> target member: %d (min: %d, max: %d)",
+			job.Namespace, job.Name, targets[i], job.PodGroup.Spec.MinMember, *job.PodGroup.Spec.MaxMember)
+		job.ElasticTargetMember = ptr.To(targets[i])
+	}
+}
+
+func isElasticJob(job *podgroup_info.PodGroupInfo) bool {
+	return job.PodGroup != nil && job.PodGroup.Spec.MaxMember != nil &&
+		*job.PodGroup.Spec.MaxMember >= job.PodGroup.Spec.MinMember
+}
+
+// elasticJobLess orders elastic pod groups by priority, and then by creation, for handing out the queue headroom.
+func elasticJobLess(l, r *podgroup_info.PodGroupInfo) bool {
+	if l.Priority != r.Priority {
+		return l.Priority > r.Priority
+	}
+	if !l.CreationTimestamp.Equal(&r.CreationTimestamp) {
+		return l.CreationTimestamp.Before(&r.CreationTimestamp)
+	}
+	return l.UID < r.UID
+}
+
+// getElasticPodSize returns the resources of a single pod of an elastic pod group. The pods are expected to be
+// replicas of each other, so the largest request of every resource is used.
+func getElasticPodSize(job *podgroup_info.PodGroupInfo) resourceVector {
+	podSize := resourceVector{}
+	for _, task := range job.GetAllPodsMap() {
+		taskSize := newResourceVector(task.ResReq)
+		podSize.gpus = max(podSize.gpus, taskSize.gpus)
+		podSize.cpu = max(podSize.cpu, taskSize.cpu)
+		podSize.memory = max(podSize.memory, taskSize.memory)
+	}
+	return podSize
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package elastic
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+type testElasticJob struct {
+	name         string
+	queue        common_info.QueueID
+	priority     int32
+	age          time.Duration
+	minMember    int32
+	maxMember    *int32
+	gpusPerPod   float64
+	runningPods  int
+	pendingPods  int
+	expectTarget *int32
+}
+
+func TestSetElasticTargets(t *testing.T) {
+	for _, test := range []struct {
+		name            string
+		queueFairShares map[common_info.QueueID]float64
+		jobs            []testElasticJob
+	}{
+		{
+			name:            "scale up to the fair share of the queue",
+			queueFairShares: map[common_info.QueueID]float64{"q1": 6},
+			jobs: []testElasticJob{
+				{name: "elastic", queue: "q1", minMember: 1, maxMember: ptr.To(int32(8)), gpusPerPod: 2,
+					runningPods: 1, pendingPods: 7, expectTarget: ptr.To(int32(3))},
+			},
+		},
+		{
+			name:            "up to max member",
+			queueFairShares: map[common_info.QueueID]float64{"q1": 10},
+			jobs: []testElasticJob{
+				{name: "elastic", queue: "q1", minMember: 1, maxMember: ptr.To(int32(3)), gpusPerPod: 1,
+					runningPods: 2, pendingPods: 1, expectTarget: ptr.To(int32(3))},
+			},
+		},
+		{
+			name:            "usage of other jobs in the queue counts",
+			queueFairShares: map[common_info.QueueID]float64{"q1": 4, "q2": 10},
+			jobs: []testElasticJob{
+				{name: "rigid", queue: "q1", minMember: 2, gpusPerPod: 1, runningPods: 2},
+				{name: "other-queue", queue: "q2", minMember: 2, gpusPerPod: 1, runningPods: 8},
+				{name: "elastic", queue: "q1", minMember: 1, maxMember: ptr.To(int32(4)), gpusPerPod: 1,
+					runningPods: 1, pendingPods: 3, expectTarget: ptr.To(int32(2))},
+			},
+		},
+		{
+			name:            "scale down over the fair share, newer jobs first",
+			queueFairShares: map[common_info.QueueID]float64{"q1": 4},
+			jobs: []testElasticJob{
+				{name: "old", queue: "q1", age: time.Hour, minMember: 1, maxMember: ptr.To(int32(4)),
+					gpusPerPod: 1, runningPods: 3, expectTarget: ptr.To(int32(3))},
+				{name: "new", queue: "q1", minMember: 1, maxMember: ptr.To(int32(4)),
+					gpusPerPod: 1, runningPods: 3, expectTarget: ptr.To(int32(1))},
+			},
+		},
+		{
+			name:            "not below min member",
+			queueFairShares: map[common_info.QueueID]float64{"q1": 1},
+			jobs: []testElasticJob{
+				{name: "elastic", queue: "q1", minMember: 2, maxMember: ptr.To(int32(4)), gpusPerPod: 1,
+					runningPods: 3, expectTarget: ptr.To(int32(2))},
+			},
+		},
+		{
+			name:            "higher priority jobs get the headroom first",
+			queueFairShares: map[common_info.QueueID]float64{"q1": 3},
+			jobs: []testElasticJob{
+				{name: "low", queue: "q1", age: time.Hour, minMember: 1, maxMember: ptr.To(int32(4)),
+					gpusPerPod: 1, runningPods: 1, pendingPods: 3, expectTarget: ptr.To(int32(1))},
+				{name: "high", queue: "q1", priority: 100, minMember: 1, maxMember: ptr.To(int32(4)),
+					gpusPerPod: 1, runningPods: 1, pendingPods: 3, expectTarget: ptr.To(int32(2))},
+			},
+		},
+		{
+			name:            "no fair share for the queue",
+			queueFairShares: map[common_info.QueueID]float64{},
+			jobs: []testElasticJob{
+				{name: "elastic", queue: "q1", minMember: 1, maxMember: ptr.To(int32(4)), gpusPerPod: 1,
+					runningPods: 1},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			queueFairShares := map[common_info.QueueID]*resource_info.ResourceRequirements{}
+			for queueID, gpus := range test.queueFairShares {
+				queueFairShares[queueID] = resource_info.NewResourceRequirementsWithGpus(gpus)
+			}
+			jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+			for _, testJob := range test.jobs {
+				job := buildTestElasticJob(testJob)
+				jobs[job.UID] = job
+			}
+
+			setElasticTargets(jobs, queueFairShares)
+
+			for _, testJob := range test.jobs {
+				assert.Equal(t, testJob.expectTarget, jobs[common_info.PodGroupID(testJob.name)].ElasticTargetMember,
+					"job %s", testJob.name)
+			}
+		})
+	}
+}
+
+func buildTestElasticJob(testJob testElasticJob) *podgroup_info.PodGroupInfo {
+	var tasks []*pod_info.PodInfo
+	for i := 0; i < testJob.runningPods+testJob.pendingPods; i++ {
+		status := pod_status.Running
+		if i >= testJob.runningPods {
+			status = pod_status.Pending
+		}
+		tasks = append(tasks, &pod_info.PodInfo{
+			UID:    common_info.PodID(fmt.Sprintf("%s-%d", testJob.name, i)),
+			Name:   fmt.Sprintf("%s-%d", testJob.name, i),
+			Status: status,
+			ResReq: resource_info.NewResourceRequirementsWithGpus(testJob.gpusPerPod),
+		})
+	}
+
+	job := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(testJob.name), tasks...)
+	job.Name = testJob.name
+	job.Queue = testJob.queue
+	job.Priority = testJob.priority
+	job.CreationTimestamp = metav1.NewTime(time.Now().Add(-testJob.age))
+	job.PodGroup = &enginev2alpha2.PodGroup{
+		Spec: enginev2alpha2.PodGroupSpec{
+			MinMember: testJob.minMember,
+			MaxMember: testJob.maxMember,
+		},
+	}
+	return job
+}
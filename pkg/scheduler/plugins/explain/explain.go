@@ -0,0 +1,153 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package explain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/snapshot"
+)
+
+const (
+	explainPath      = "/explain"
+	explainSessionID = "explain"
+)
+
+// supportedActions are the configured actions that attempt to schedule the PodGroup in an explain session, to collect
+// the reclaim and preempt scenarios that were considered for it.
+var supportedActions = []framework.ActionType{
+	framework.Allocate,
+	framework.Consolidation,
+	framework.Reclaim,
+	framework.Preempt,
+}
+
+var errPodGroupNotFound = errors.New("podGroup not found")
+
+type explainPlugin struct {
+	session *framework.Session
+	// explainMutex allows a single explanation at a time, each explanation builds its own cache.
+	explainMutex sync.Mutex
+}
+
+func New(_ framework.PluginArguments) framework.Plugin {
+	return &explainPlugin{}
+}
+
+func (ep *explainPlugin) Name() string {
+	return "explain"
+}
+
+func (ep *explainPlugin) OnSessionOpen(ssn *framework.Session) {
+	ep.session = ssn
+	log.InfraLogger.V(3).Info("Explain plugin registering explain")
+	ssn.AddHttpHandler(explainPath, ep.serveExplain)
+}
+
+func (ep *explainPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (ep *explainPlugin) serveExplain(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, "explain requests must use GET", http.StatusMethodNotAllowed)
+		return
+	}
+
+	namespace := request.URL.Query().Get("namespace")
+	if len(namespace) == 0 {
+		namespace = metav1.NamespaceDefault
+	}
+	name := request.URL.Query().Get("name")
+	if len(name) == 0 {
+		http.Error(writer, "explain requests must specify the podGroup name", http.StatusBadRequest)
+		return
+	}
+
+	clusterSnapshot := snapshot.TakeSnapshot(request.Context(), ep.session)
+
+	ep.explainMutex.Lock()
+	result, err := Explain(clusterSnapshot, namespace, name)
+	ep.explainMutex.Unlock()
+	if errors.Is(err, errPodGroupNotFound) {
+		http.Error(writer, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(writer)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(result); err != nil {
+		http.Error(writer, "Failed to encode explain result", http.StatusInternalServerError)
+	}
+}
+
+// Explain evaluates the PodGroup in a session built from a cache of the snapshot, so the actions never reach the
+// cluster: it runs the predicates, node subsetting and queue capacity checks for the PodGroup's pending pods, and then
+// attempts to schedule it with the configured actions in a statement that is discarded, recording the reclaim and
+// preempt scenarios that reached the scenario validators.
+func Explain(clusterSnapshot *snapshot.Snapshot, namespace, name string) (*Result, error) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	ssn, err := framework.OpenSession(
+		snapshot.NewCache(clusterSnapshot, stopCh), clusterSnapshot.Config, clusterSnapshot.SchedulerParams,
+		explainSessionID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open explain session: %w", err)
+	}
+	defer framework.CloseSession(ssn)
+
+	job := findJob(ssn, namespace, name)
+	if job == nil {
+		return nil, fmt.Errorf("%w: %s/%s", errPodGroupNotFound, namespace, name)
+	}
+
+	result := explainPlacement(ssn, job)
+
+	recorder := newScenarioRecorder(job)
+	recorder.wrapScenarioValidators(ssn)
+	result.JobEvaluation = *common.EvaluateJob(ssn, job, actionsToRun(ssn))
+	result.Scenarios = recorder.scenarios
+	if len(result.Action) == 0 {
+		result.UnschedulableReasons = common.UnschedulableReasons(job)
+	}
+
+	return result, nil
+}
+
+func actionsToRun(ssn *framework.Session) []framework.JobAction {
+	var actions []framework.JobAction
+	for _, actionName := range strings.Split(ssn.Config.Actions, ",") {
+		actionType := framework.ActionType(strings.TrimSpace(actionName))
+		if !slices.Contains(supportedActions, actionType) {
+			continue
+		}
+		action, found := framework.GetAction(string(actionType))
+		if !found {
+			log.InfraLogger.Errorf("Failed to find action %s", actionType)
+			continue
+		}
+		jobAction, ok := action.(framework.JobAction)
+		if !ok {
+			log.InfraLogger.Errorf("Action %s cannot explain a single podGroup", actionType)
+			continue
+		}
+		actions = append(actions, jobAction)
+	}
+	return actions
+}
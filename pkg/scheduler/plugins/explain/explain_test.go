@@ -0,0 +1,351 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package explain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	kubeaischedulerver "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/fake"
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/allocate"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/predicates"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion"
+)
+
+const schedulerName = "test-scheduler"
+
+func TestExplain(t *testing.T) {
+	framework.RegisterAction(allocate.New())
+	framework.RegisterPluginBuilder("predicates", predicates.New)
+	framework.RegisterPluginBuilder("proportion", proportion.New)
+	framework.RegisterPluginBuilder("explain", New)
+
+	session := newTestSession(t,
+		newPod("fits-0", "fits", 1, nil),
+		newPod("too-big-0", "too-big", 8, nil),
+		newPod("selector-0", "selector", 1, map[string]string{"zone": "other"}),
+	)
+	plugin := New(nil).(*explainPlugin)
+	plugin.OnSessionOpen(session)
+
+	tests := []struct {
+		name                 string
+		method               string
+		query                string
+		expectedStatusCode   int
+		expectedScheduled    bool
+		expectedFeasible     bool
+		expectedResources    bool
+		expectedPrePredicate string
+		expectedPredicate    string
+	}{
+		{
+			name:               "podgroup fits",
+			method:             http.MethodGet,
+			query:              "namespace=default&name=fits",
+			expectedStatusCode: http.StatusOK,
+			expectedScheduled:  true,
+			expectedFeasible:   true,
+		},
+		{
+			name:                 "podgroup exceeds the node resources",
+			method:               http.MethodGet,
+			query:                "namespace=default&name=too-big",
+			expectedStatusCode:   http.StatusOK,
+			expectedResources:    true,
+			expectedPrePredicate: "MaxNodePoolResources",
+		},
+		{
+			name:               "podgroup fails the node selector predicate",
+			method:             http.MethodGet,
+			query:              "name=selector",
+			expectedStatusCode: http.StatusOK,
+			expectedPredicate:  "node(s) didn't match Pod's node affinity/selector",
+		},
+		{
+			name:               "unknown podgroup",
+			method:             http.MethodGet,
+			query:              "namespace=default&name=unknown",
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "missing podgroup name",
+			method:             http.MethodGet,
+			query:              "namespace=default",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "post request",
+			method:             http.MethodPost,
+			query:              "namespace=default&name=fits",
+			expectedStatusCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, explainPath+"?"+tt.query, nil)
+			w := httptest.NewRecorder()
+			plugin.serveExplain(w, req)
+
+			require.Equal(t, tt.expectedStatusCode, w.Code, w.Body.String())
+			if tt.expectedStatusCode != http.StatusOK {
+				return
+			}
+
+			result := &Result{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), result))
+			assert.Equal(t, "queue-0", result.Queue)
+			assert.Equal(t, tt.expectedScheduled, result.Scheduled)
+			if tt.expectedScheduled {
+				assert.Equal(t, framework.Allocate, result.Action)
+				assert.Len(t, result.Placements, 1)
+			}
+			require.NotNil(t, result.QueueCapacity)
+			assert.True(t, result.QueueCapacity.Schedulable)
+
+			require.Len(t, result.Tasks, 1)
+			task := result.Tasks[0]
+			require.Len(t, task.PrePredicates, 1)
+			assert.Equal(t, "predicates", task.PrePredicates[0].Plugin)
+			assert.Equal(t, len(tt.expectedPrePredicate) == 0, task.PrePredicates[0].Passed)
+			assert.Contains(t, task.PrePredicates[0].Reason, tt.expectedPrePredicate)
+			require.Len(t, task.Nodes, 1)
+			node := task.Nodes[0]
+			assert.Equal(t, "node-0", node.Name)
+			assert.Equal(t, tt.expectedFeasible, node.Feasible)
+			assert.Equal(t, tt.expectedResources, len(node.Resources) > 0, node.Resources)
+			require.Len(t, node.Predicates, 1)
+			assert.Equal(t, "predicates", node.Predicates[0].Plugin)
+			assert.Equal(t, len(tt.expectedPredicate) == 0, node.Predicates[0].Passed)
+			assert.Contains(t, node.Predicates[0].Reason, tt.expectedPredicate)
+			if !tt.expectedScheduled {
+				assert.NotEmpty(t, result.UnschedulableReasons)
+			}
+		})
+	}
+}
+
+type fakeScenario struct {
+	preemptor *podgroup_info.PodGroupInfo
+	victims   map[common_info.PodGroupID]*api.VictimInfo
+}
+
+func (s *fakeScenario) GetPreemptor() *podgroup_info.PodGroupInfo {
+	return s.preemptor
+}
+
+func (s *fakeScenario) GetVictims() map[common_info.PodGroupID]*api.VictimInfo {
+	return s.victims
+}
+
+func TestRecordingValidators(t *testing.T) {
+	job := podgroup_info.NewPodGroupInfo("pending")
+	otherJob := podgroup_info.NewPodGroupInfo("other")
+	victimJob := podgroup_info.NewPodGroupInfo("victim")
+	victimJob.NamespacedName = "team-b/victim"
+	victimJob.Queue = "queue-b"
+	victims := map[common_info.PodGroupID]*api.VictimInfo{
+		victimJob.UID: {
+			Job: victimJob,
+			Tasks: []*pod_info.PodInfo{
+				{Namespace: "team-b", Name: "victim-1"},
+				{Namespace: "team-b", Name: "victim-0"},
+			},
+		},
+	}
+	expectedVictims := []ScenarioVictim{
+		{PodGroup: "team-b/victim", Queue: "queue-b", Pods: []string{"team-b/victim-0", "team-b/victim-1"}},
+	}
+
+	tests := []struct {
+		name              string
+		validatorVerdicts []bool
+		preemptor         *podgroup_info.PodGroupInfo
+		expectedValid     bool
+		expectedScenarios []ScenarioExplanation
+	}{
+		{
+			name:              "valid scenario",
+			validatorVerdicts: []bool{true, true},
+			preemptor:         job,
+			expectedValid:     true,
+			expectedScenarios: []ScenarioExplanation{
+				{Action: framework.Reclaim, Victims: expectedVictims, Valid: true},
+			},
+		},
+		{
+			name:              "scenario rejected by the second validator",
+			validatorVerdicts: []bool{true, false},
+			preemptor:         job,
+			expectedValid:     false,
+			expectedScenarios: []ScenarioExplanation{
+				{Action: framework.Reclaim, Victims: expectedVictims, Valid: false, RejectedBy: "second"},
+			},
+		},
+		{
+			name:              "scenario of another podgroup",
+			validatorVerdicts: []bool{false},
+			preemptor:         otherJob,
+			expectedValid:     false,
+			expectedScenarios: []ScenarioExplanation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pluginNames := []string{"first", "second"}
+			var validators []framework.PluginFn[api.ScenarioValidatorFn]
+			for index, verdict := range tt.validatorVerdicts {
+				validators = append(validators, framework.PluginFn[api.ScenarioValidatorFn]{
+					Plugin: pluginNames[index],
+					Fn:     func(api.ScenarioInfo) bool { return verdict },
+				})
+			}
+
+			recorder := newScenarioRecorder(job)
+			session := &framework.Session{
+				ReclaimScenarioValidatorFns: recorder.recordingValidators(framework.Reclaim, validators),
+			}
+			valid := session.ReclaimScenarioValidatorFn(&fakeScenario{preemptor: tt.preemptor, victims: victims})
+
+			assert.Equal(t, tt.expectedValid, valid)
+			assert.Equal(t, tt.expectedScenarios, recorder.scenarios)
+		})
+	}
+}
+
+func newTestSession(t *testing.T, pods ...*v1.Pod) *framework.Session {
+	kubeClient := fake.NewSimpleClientset()
+	kaiClient := kubeaischedulerver.NewSimpleClientset()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-0", Labels: map[string]string{"zone": "zone-0"}},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:              resource.MustParse("8"),
+				v1.ResourceMemory:           resource.MustParse("32Gi"),
+				v1.ResourcePods:             resource.MustParse("110"),
+				constants.NvidiaGpuResource: resource.MustParse("4"),
+			},
+		},
+	}
+	node.Status.Capacity = node.Status.Allocatable
+	_, err := kubeClient.CoreV1().Nodes().Create(ctx, node, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	queue := &enginev2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "queue-0"},
+		Spec: enginev2.QueueSpec{
+			Resources: &enginev2.QueueResources{
+				GPU:    enginev2.QueueResource{Quota: -1, Limit: -1, OverQuotaWeight: 1},
+				CPU:    enginev2.QueueResource{Quota: -1, Limit: -1, OverQuotaWeight: 1},
+				Memory: enginev2.QueueResource{Quota: -1, Limit: -1, OverQuotaWeight: 1},
+			},
+		},
+	}
+	_, err = kaiClient.SchedulingV2().Queues("").Create(ctx, queue, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	for _, pod := range pods {
+		_, err = kubeClient.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		podGroupName := pod.Annotations[constants.PodGroupAnnotationForPod]
+		podGroup := &enginev2alpha2.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: podGroupName, Namespace: pod.Namespace, UID: types.UID(podGroupName + "-uid"),
+			},
+			Spec: enginev2alpha2.PodGroupSpec{MinMember: 1, Queue: "queue-0"},
+		}
+		_, err = kaiClient.SchedulingV2alpha2().PodGroups(pod.Namespace).Create(ctx, podGroup, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	schedulerCache := cache.New(&cache.SchedulerCacheParams{
+		KubeClient:                  kubeClient,
+		KAISchedulerClient:          kaiClient,
+		SchedulerName:               schedulerName,
+		NodePoolParams:              &conf.SchedulingNodePoolParams{},
+		FullHierarchyFairness:       true,
+		NumOfStatusRecordingWorkers: 1,
+		DiscoveryClient:             kubeClient.Discovery(),
+	})
+	schedulerCache.Run(ctx.Done())
+	schedulerCache.WaitForCacheSync(ctx.Done())
+
+	return &framework.Session{
+		Config: &conf.SchedulerConfiguration{
+			Actions: "allocate",
+			Tiers: []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{Name: "predicates"},
+						{Name: "proportion"},
+						{Name: "explain"},
+					},
+				},
+			},
+		},
+		SchedulerParams: conf.SchedulerParams{
+			SchedulerName:               schedulerName,
+			PartitionParams:             &conf.SchedulingNodePoolParams{},
+			FullHierarchyFairness:       true,
+			NumOfStatusRecordingWorkers: 1,
+		},
+		Cache: schedulerCache,
+	}
+}
+
+func newPod(name, podGroupName string, gpus int64, nodeSelector map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   metav1.NamespaceDefault,
+			UID:         types.UID(name + "-uid"),
+			Annotations: map[string]string{constants.PodGroupAnnotationForPod: podGroupName},
+		},
+		Spec: v1.PodSpec{
+			SchedulerName: schedulerName,
+			NodeSelector:  nodeSelector,
+			Containers: []v1.Container{
+				{
+					Name: "worker",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							constants.NvidiaGpuResource: *resource.NewQuantity(gpus, resource.DecimalSI),
+						},
+						Limits: v1.ResourceList{
+							constants.NvidiaGpuResource: *resource.NewQuantity(gpus, resource.DecimalSI),
+						},
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{Phase: v1.PodPending},
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package explain
+
+import (
+	"sort"
+
+	"golang.org/x/exp/maps"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+// Result explains why a PodGroup is, or is not, scheduled.
+type Result struct {
+	PodGroup string `json:"podGroup"`
+	Queue    string `json:"queue"`
+	// QueueCapacity is the result of the queue capacity check for the PodGroup's pending pods.
+	QueueCapacity *CapacityResult `json:"queueCapacity,omitempty"`
+	// NodeSets are the node sets each node subsetting plugin (e.g. topology) left for the PodGroup, in plugin order.
+	NodeSets []NodeSetsResult `json:"nodeSets,omitempty"`
+	// Tasks explain the placement of one pending pod of each of the PodGroup's sub-groups.
+	Tasks []TaskExplanation `json:"tasks"`
+	// Scenarios are the reclaim and preempt scenarios for the PodGroup that reached the scenario validators.
+	Scenarios []ScenarioExplanation `json:"scenarios"`
+	// JobEvaluation is the action that would schedule the PodGroup, with its placements and victims.
+	common.JobEvaluation
+	UnschedulableReasons []string `json:"unschedulableReasons,omitempty"`
+}
+
+type CapacityResult struct {
+	Schedulable bool                               `json:"schedulable"`
+	Reason      enginev2alpha2.UnschedulableReason `json:"reason,omitempty"`
+	Message     string                             `json:"message,omitempty"`
+}
+
+type NodeSetsResult struct {
+	Plugin   string     `json:"plugin"`
+	NodeSets [][]string `json:"nodeSets"`
+	Error    string     `json:"error,omitempty"`
+}
+
+type TaskExplanation struct {
+	Name          string            `json:"name"`
+	SubGroup      string            `json:"subGroup"`
+	PrePredicates []PredicateResult `json:"prePredicates"`
+	Nodes         []NodeExplanation `json:"nodes"`
+}
+
+type NodeExplanation struct {
+	Name string `json:"name"`
+	// Feasible is true if the node has the resources for the pod and passes all its predicates.
+	Feasible   bool              `json:"feasible"`
+	Resources  string            `json:"resources,omitempty"`
+	Predicates []PredicateResult `json:"predicates"`
+}
+
+type PredicateResult struct {
+	Plugin string `json:"plugin"`
+	Passed bool   `json:"passed"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func findJob(ssn *framework.Session, namespace, name string) *podgroup_info.PodGroupInfo {
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if job.Namespace == namespace && job.Name == name {
+			return job
+		}
+	}
+	return nil
+}
+
+func explainPlacement(ssn *framework.Session, job *podgroup_info.PodGroupInfo) *Result {
+	result := &Result{
+		PodGroup: job.NamespacedName,
+		Queue:    string(job.Queue),
+		Tasks:    []TaskExplanation{},
+	}
+
+	tasksToAllocate := podgroup_info.GetTasksToAllocate(job, ssn.PodSetOrderFn, ssn.TaskOrderFn, true)
+	if len(tasksToAllocate) == 0 {
+		return result
+	}
+
+	capacityResult := ssn.IsJobOverQueueCapacityFn(job, tasksToAllocate)
+	result.QueueCapacity = &CapacityResult{
+		Schedulable: capacityResult.IsSchedulable,
+		Reason:      capacityResult.Reason,
+		Message:     capacityResult.Message,
+	}
+
+	nodes := sortedNodes(ssn)
+	ssn.PreJobAllocation(job)
+	result.NodeSets = explainNodeSets(ssn, job, tasksToAllocate, nodes)
+	for _, task := range subGroupRepresentatives(tasksToAllocate) {
+		result.Tasks = append(result.Tasks, explainTask(ssn, job, task, nodes))
+	}
+	return result
+}
+
+// explainNodeSets runs the node subsetting plugins one after the other on the PodGroup's root sub-group set, like
+// the allocation does, and reports the node sets after each plugin.
+func explainNodeSets(ssn *framework.Session, job *podgroup_info.PodGroupInfo, tasksToAllocate []*pod_info.PodInfo,
+	nodes []*node_info.NodeInfo) []NodeSetsResult {
+	var results []NodeSetsResult
+	nodeSets := []node_info.NodeSet{nodes}
+	for _, subsetNodesFn := range ssn.SubsetNodesFnsByPlugin() {
+		pluginResult := NodeSetsResult{Plugin: subsetNodesFn.Plugin, NodeSets: [][]string{}}
+		var newNodeSets []node_info.NodeSet
+		for _, nodeSet := range nodeSets {
+			nodeSubsets, err := subsetNodesFn.Fn(job, &job.RootSubGroupSet.SubGroupInfo,
+				job.RootSubGroupSet.GetAllPodSets(), tasksToAllocate, nodeSet)
+			if err != nil {
+				pluginResult.Error = err.Error()
+				return append(results, pluginResult)
+			}
+			newNodeSets = append(newNodeSets, nodeSubsets...)
+		}
+		nodeSets = newNodeSets
+
+		for _, nodeSet := range nodeSets {
+			nodeNames := make([]string, 0, len(nodeSet))
+			for _, node := range nodeSet {
+				nodeNames = append(nodeNames, node.Name)
+			}
+			sort.Strings(nodeNames)
+			pluginResult.NodeSets = append(pluginResult.NodeSets, nodeNames)
+		}
+		results = append(results, pluginResult)
+	}
+	return results
+}
+
+func explainTask(ssn *framework.Session, job *podgroup_info.PodGroupInfo, task *pod_info.PodInfo,
+	nodes []*node_info.NodeInfo) TaskExplanation {
+	explanation := TaskExplanation{
+		Name:          task.Name,
+		SubGroup:      task.SubGroupName,
+		PrePredicates: []PredicateResult{},
+		Nodes:         []NodeExplanation{},
+	}
+	if len(explanation.SubGroup) == 0 {
+		explanation.SubGroup = podgroup_info.DefaultSubGroup
+	}
+
+	prePredicatesPassed := true
+	for _, prePredicate := range ssn.PrePredicateFnsByPlugin() {
+		predicateResult := newPredicateResult(prePredicate.Plugin, prePredicate.Fn(task, job))
+		prePredicatesPassed = prePredicatesPassed && predicateResult.Passed
+		explanation.PrePredicates = append(explanation.PrePredicates, predicateResult)
+	}
+
+	for _, node := range nodes {
+		nodeExplanation := NodeExplanation{
+			Name:       node.Name,
+			Feasible:   prePredicatesPassed,
+			Predicates: []PredicateResult{},
+		}
+		if err := ssn.NodeResourcesFitError(task, job, node); err != nil {
+			nodeExplanation.Feasible = false
+			nodeExplanation.Resources = err.Error()
+		}
+		for _, predicate := range ssn.PredicateFnsByPlugin() {
+			predicateResult := newPredicateResult(predicate.Plugin, predicate.Fn(task, job, node))
+			nodeExplanation.Feasible = nodeExplanation.Feasible && predicateResult.Passed
+			nodeExplanation.Predicates = append(nodeExplanation.Predicates, predicateResult)
+		}
+		explanation.Nodes = append(explanation.Nodes, nodeExplanation)
+	}
+	return explanation
+}
+
+// subGroupRepresentatives returns the first pod to allocate of each sub-group, pods of the same sub-group share their
+// spec and are filtered alike.
+func subGroupRepresentatives(tasksToAllocate []*pod_info.PodInfo) []*pod_info.PodInfo {
+	var representatives []*pod_info.PodInfo
+	seenSubGroups := map[string]bool{}
+	for _, task := range tasksToAllocate {
+		if seenSubGroups[task.SubGroupName] {
+			continue
+		}
+		seenSubGroups[task.SubGroupName] = true
+		representatives = append(representatives, task)
+	}
+	return representatives
+}
+
+func sortedNodes(ssn *framework.Session) []*node_info.NodeInfo {
+	nodeNames := maps.Keys(ssn.ClusterInfo.Nodes)
+	sort.Strings(nodeNames)
+	nodes := make([]*node_info.NodeInfo, 0, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		nodes = append(nodes, ssn.ClusterInfo.Nodes[nodeName])
+	}
+	return nodes
+}
+
+func newPredicateResult(plugin string, err error) PredicateResult {
+	if err == nil {
+		return PredicateResult{Plugin: plugin, Passed: true}
+	}
+	return PredicateResult{Plugin: plugin, Passed: false, Reason: err.Error()}
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package explain
+
+import (
+	"sort"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+// ScenarioExplanation is a reclaim or preempt scenario that was simulated successfully for the PodGroup and passed
+// to the scenario validators. Scenarios that were filtered out or could not fit the PodGroup are not reported.
+type ScenarioExplanation struct {
+	Action  framework.ActionType `json:"action"`
+	Victims []ScenarioVictim     `json:"victims"`
+	Valid   bool                 `json:"valid"`
+	// RejectedBy is the plugin whose scenario validator rejected the scenario.
+	RejectedBy string `json:"rejectedBy,omitempty"`
+}
+
+type ScenarioVictim struct {
+	PodGroup string   `json:"podGroup"`
+	Queue    string   `json:"queue"`
+	Pods     []string `json:"pods"`
+}
+
+type scenarioRecorder struct {
+	job       *podgroup_info.PodGroupInfo
+	scenarios []ScenarioExplanation
+}
+
+func newScenarioRecorder(job *podgroup_info.PodGroupInfo) *scenarioRecorder {
+	return &scenarioRecorder{job: job, scenarios: []ScenarioExplanation{}}
+}
+
+// wrapScenarioValidators replaces the session's reclaim and preempt scenario validators with validators that record
+// the verdicts on the explained PodGroup's scenarios.
+func (sr *scenarioRecorder) wrapScenarioValidators(ssn *framework.Session) {
+	ssn.ReclaimScenarioValidatorFns = sr.recordingValidators(framework.Reclaim,
+		ssn.ReclaimScenarioValidatorFnsByPlugin())
+	ssn.PreemptScenarioValidatorFns = sr.recordingValidators(framework.Preempt,
+		ssn.PreemptScenarioValidatorFnsByPlugin())
+}
+
+func (sr *scenarioRecorder) recordingValidators(action framework.ActionType,
+	validators []framework.PluginFn[api.ScenarioValidatorFn]) []api.ScenarioValidatorFn {
+	recordingValidators := make([]api.ScenarioValidatorFn, 0, len(validators))
+	for index, validator := range validators {
+		recordingValidators = append(recordingValidators, func(scenario api.ScenarioInfo) bool {
+			valid := validator.Fn(scenario)
+			if scenario.GetPreemptor().UID != sr.job.UID {
+				return valid
+			}
+
+			// The session runs the validators of a scenario in order and stops at the first rejection
+			if index == 0 {
+				sr.scenarios = append(sr.scenarios, ScenarioExplanation{
+					Action:  action,
+					Victims: scenarioVictims(scenario),
+					Valid:   true,
+				})
+			}
+			if !valid {
+				explanation := &sr.scenarios[len(sr.scenarios)-1]
+				explanation.Valid = false
+				explanation.RejectedBy = validator.Plugin
+			}
+			return valid
+		})
+	}
+	return recordingValidators
+}
+
+func scenarioVictims(scenario api.ScenarioInfo) []ScenarioVictim {
+	victims := []ScenarioVictim{}
+	for _, victim := range scenario.GetVictims() {
+		scenarioVictim := ScenarioVictim{
+			PodGroup: victim.Job.NamespacedName,
+			Queue:    string(victim.Job.Queue),
+			Pods:     make([]string, 0, len(victim.Tasks)),
+		}
+		for _, task := range victim.Tasks {
+			scenarioVictim.Pods = append(scenarioVictim.Pods, task.Namespace+"/"+task.Name)
+		}
+		sort.Strings(scenarioVictim.Pods)
+		victims = append(victims, scenarioVictim)
+	}
+	sort.Slice(victims, func(i, j int) bool {
+		return victims[i].PodGroup < victims[j].PodGroup
+	})
+	return victims
+}
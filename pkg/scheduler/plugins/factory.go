@@ -23,6 +23,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/dynamicresources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/elastic"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/explain"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpupack"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpusharingorder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpuspread"
@@ -42,6 +43,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/subgrouporder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/taskorder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/topology"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/whatif"
 )
 
 func InitDefaultPlugins() {
@@ -70,6 +72,8 @@ func InitDefaultPlugins() {
 
 	// Other Plugins
 	framework.RegisterPluginBuilder("snapshot", snapshot.New)
+	framework.RegisterPluginBuilder("whatif", whatif.New)
+	framework.RegisterPluginBuilder("explain", explain.New)
 
 	// Always register the Job Order Plugin last.
 	framework.RegisterPluginBuilder("reflectjoborder", reflectjoborder.New)
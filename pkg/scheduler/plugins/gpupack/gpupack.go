@@ -6,6 +6,7 @@ package gpupack
 import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 )
@@ -23,7 +24,14 @@ func (gpp *gpuPackPlugin) Name() string {
 }
 
 func (gpp *gpuPackPlugin) OnSessionOpen(ssn *framework.Session) {
-	ssn.AddGPUOrderFn(gpuOrderFn)
+	ssn.AddGPUOrderFn(func(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (float64, error) {
+		score, err := gpuOrderFn(task, node, gpuIdx)
+		if err != nil || ssn.TaskPlacementStrategy(task, constants.GPUResource) != constants.SpreadStrategy {
+			return score, err
+		}
+		// The PodGroup or the queue of the task override the strategy, so the gpus are spread instead
+		return 1 - score, nil
+	})
 }
 
 func (gpp *gpuPackPlugin) OnSessionClose(_ *framework.Session) {}
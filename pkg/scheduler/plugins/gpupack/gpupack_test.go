@@ -12,8 +12,14 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 )
 
 const fakeNodeName = "node"
@@ -92,6 +98,31 @@ var _ = Describe("GpuPack scoring", func() {
 			}
 		})
 	}
+
+	It("Spreads the tasks of a pod group that overrides the strategy", func() {
+		spreadTask := &pod_info.PodInfo{UID: "task", Job: "job"}
+		job := podgroup_info.NewPodGroupInfo("job", spreadTask)
+		job.PodGroup = &enginev2alpha2.PodGroup{
+			Spec: enginev2alpha2.PodGroupSpec{
+				PlacementStrategy: &enginev2alpha2.PlacementStrategy{GPU: constants.SpreadStrategy},
+			},
+		}
+		ssn := &framework.Session{
+			ClusterInfo: &api.ClusterInfo{
+				PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job},
+			},
+		}
+		New(nil).OnSessionOpen(ssn)
+		nodeInfo := createFakeSingleGpuNodeInfo(1024, 256)
+
+		score, err := ssn.GpuOrderFns[0](spreadTask, nodeInfo, "0")
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(score).To(Equal(0.75))
+
+		score, err = ssn.GpuOrderFns[0](task, nodeInfo, "0")
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(score).To(Equal(0.25))
+	})
 })
 
 func createFakeSingleGpuNodeInfo(totalGpuMem int64, usedGpuMem int64) *node_info.NodeInfo {
@@ -29,7 +29,7 @@ func (g *gpuSharingOrderPlugin) OnSessionOpen(ssn *framework.Session) {
 func (g *gpuSharingOrderPlugin) nodeOrderFn(pod *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
 	score := 0.0
 	for gpuGroup := range node.UsedSharedGPUsMemory {
-		if !node.IsTaskFitOnGpuGroup(pod.ResReq, gpuGroup) {
+		if !node.IsTaskFitOnGpuGroup(pod, gpuGroup) {
 			continue
 		}
 
@@ -18,13 +18,17 @@ type allocationRange struct {
 	minAllocatable, maxAllocatable float64
 }
 
+type placementFns struct {
+	preOrderFn api.NodePreOrderFn
+	scoreFn    api.NodeOrderFn
+}
+
 type nodePlacementPlugin struct {
 	// Arguments given for the plugin
 	pluginArguments framework.PluginArguments
-	gpuPreOrderFn   api.NodePreOrderFn
-	cpuPreOrderFn   api.NodePreOrderFn
-	gpuTaskScoreFn  api.NodeOrderFn
-	cpuTaskScoreFn  api.NodeOrderFn
+	// placementFns holds the functions of every placement strategy, per job type
+	placementFns   map[string]map[string]placementFns
+	taskStrategyFn func(task *pod_info.PodInfo, resourceType string) string
 
 	podAllocatableRange map[string]allocationRange
 }
@@ -52,20 +56,23 @@ func (pp *nodePlacementPlugin) Name() string {
 
 func (pp *nodePlacementPlugin) OnSessionOpen(ssn *framework.Session) {
 	pp.podAllocatableRange = make(map[string]allocationRange)
-
-	// pack tasks by default
-	pp.gpuTaskScoreFn = pp.nodeResourcePack(resource_info.GPUResourceName)
-	pp.gpuPreOrderFn = pp.setBinpackPreOrder
-	if pp.pluginArguments[constants.GPUResource] == constants.SpreadStrategy {
-		pp.gpuPreOrderFn = noopPreOrderFn
-		pp.gpuTaskScoreFn = nodeResourceSpread(resource_info.GPUResourceName)
-	}
-
-	pp.cpuTaskScoreFn = pp.nodeResourcePack(v1.ResourceCPU)
-	pp.cpuPreOrderFn = pp.setBinpackPreOrder
-	if pp.pluginArguments[constants.CPUResource] == constants.SpreadStrategy {
-		pp.cpuPreOrderFn = noopPreOrderFn
-		pp.cpuTaskScoreFn = nodeResourceSpread(v1.ResourceCPU)
+	pp.taskStrategyFn = ssn.TaskPlacementStrategy
+
+	pp.placementFns = map[string]map[string]placementFns{}
+	for jobType, resourceName := range map[string]v1.ResourceName{
+		constants.GPUResource: resource_info.GPUResourceName,
+		constants.CPUResource: v1.ResourceCPU,
+	} {
+		pp.placementFns[jobType] = map[string]placementFns{
+			constants.BinpackStrategy: {
+				preOrderFn: pp.setBinpackPreOrder,
+				scoreFn:    pp.nodeResourcePack(resourceName),
+			},
+			constants.SpreadStrategy: {
+				preOrderFn: noopPreOrderFn,
+				scoreFn:    nodeResourceSpread(resourceName),
+			},
+		}
 	}
 
 	ssn.AddNodePreOrderFn(pp.nodePreOrderFn)
@@ -73,17 +80,25 @@ func (pp *nodePlacementPlugin) OnSessionOpen(ssn *framework.Session) {
 }
 
 func (pp *nodePlacementPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
-	if task != nil && task.IsCPUOnlyRequest() {
-		return pp.cpuTaskScoreFn(task, node)
-	}
-	return pp.gpuTaskScoreFn(task, node)
+	return pp.taskPlacementFns(task).scoreFn(task, node)
 }
 
 func (pp *nodePlacementPlugin) nodePreOrderFn(task *pod_info.PodInfo, fittingNodes []*node_info.NodeInfo) error {
-	if task != nil && task.IsCPUOnlyRequest() {
-		return pp.cpuPreOrderFn(task, fittingNodes)
+	return pp.taskPlacementFns(task).preOrderFn(task, fittingNodes)
+}
+
+// taskPlacementFns returns the functions of the placement strategy of the task. The strategy of the plugin arguments
+// is used unless the PodGroup or the queue of the task override it, and tasks are packed by default.
+func (pp *nodePlacementPlugin) taskPlacementFns(task *pod_info.PodInfo) placementFns {
+	jobType := jobTypeFromTask(task)
+	strategy := pp.taskStrategyFn(task, jobType)
+	if strategy == "" {
+		strategy = pp.pluginArguments[jobType]
+	}
+	if fns, found := pp.placementFns[jobType][strategy]; found {
+		return fns
 	}
-	return pp.gpuPreOrderFn(task, fittingNodes)
+	return pp.placementFns[jobType][constants.BinpackStrategy]
 }
 
 func noopPreOrderFn(_ *pod_info.PodInfo, _ []*node_info.NodeInfo) error {
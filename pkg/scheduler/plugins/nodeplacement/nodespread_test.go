@@ -10,8 +10,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/nodeplacement"
@@ -112,5 +115,43 @@ var _ = Describe("NodeSpread", func() {
 				Expect(actual).To(Equal(c.expected))
 			}
 		})
+
+		It("should spread tasks of a pod group that overrides the plugin strategy", func() {
+			task := &pod_info.PodInfo{
+				UID:    "task",
+				Job:    "job",
+				ResReq: resource_info.NewResourceRequirementsWithGpus(1),
+			}
+			job := podgroup_info.NewPodGroupInfo("job", task)
+			job.PodGroup = &enginev2alpha2.PodGroup{
+				Spec: enginev2alpha2.PodGroupSpec{
+					PlacementStrategy: &enginev2alpha2.PlacementStrategy{GPU: constants.SpreadStrategy},
+				},
+			}
+
+			node := &node_info.NodeInfo{
+				Node: &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							node_info.GpuCountLabel: "4",
+						},
+					},
+				},
+				Idle:      resource_info.NewResource(0, 0, 3),
+				Releasing: resource_info.EmptyResource(),
+			}
+
+			plugin := nodeplacement.New(map[string]string{
+				constants.GPUResource: constants.BinpackStrategy,
+				constants.CPUResource: constants.BinpackStrategy,
+			})
+			ssn := createFakeTestSession(map[string]*node_info.NodeInfo{node.Name: node})
+			ssn.ClusterInfo.PodGroupInfos = map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job}
+			plugin.OnSessionOpen(ssn)
+
+			actual, err := ssn.NodeOrderFn(task, node)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(actual).To(Equal(0.75))
+		})
 	})
 })
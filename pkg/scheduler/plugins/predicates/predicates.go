@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	ksf "k8s.io/kube-scheduler/framework"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
@@ -44,9 +45,10 @@ import (
 )
 
 const (
-	predicatePluginName       = "predicates"
-	prePredicateErrorFormat   = "%s: %v.%s\n"
-	prePredicateReasonsFormat = " Reasons: %s"
+	predicatePluginName          = "predicates"
+	gpuIsolationPrePredicateName = "GpuIsolation"
+	prePredicateErrorFormat      = "%s: %v.%s\n"
+	prePredicateReasonsFormat    = " Reasons: %s"
 )
 
 type prePredicateError struct {
@@ -124,6 +126,13 @@ func evaluateTaskOnPrePredicate(task *pod_info.PodInfo, k8sPredicates k8s_intern
 	skipPredicates SkipPredicates,
 ) error {
 	var allErrors []prePredicateError
+	// The binder refuses to bind shared GPU pods with an invalid isolation mode, so they are not scheduled at all
+	if task.IsSharedGPURequest() {
+		if _, err := resources.GetGpuIsolationMode(task.Pod); err != nil {
+			allErrors = append(allErrors, prePredicateError{name: gpuIsolationPrePredicateName, err: err})
+		}
+	}
+
 	var allowedNodes sets.Set[string] = nil
 	for name, predicate := range k8sPredicates {
 		if !predicate.IsPreFilterRequired(task.Pod) {
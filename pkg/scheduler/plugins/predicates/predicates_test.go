@@ -154,6 +154,30 @@ func Test_evaluateTaskOnPrePredicate(t *testing.T) {
 				"PodFitsHostPorts: failed pre-predicate PodFitsHostPorts. Reasons: reason1, reason2",
 			},
 		},
+		{
+			name: "Shared GPU pod with an invalid isolation mode",
+			args: args{
+				task: &pod_info.PodInfo{
+					Name:                "p1",
+					Namespace:           "ns1",
+					ResourceRequestType: pod_info.RequestTypeFraction,
+					Pod: &v1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{commonconstants.GpuIsolationAnnotation: "exclusive"},
+						},
+					},
+				},
+				k8sPredicates: k8s_internal.SessionPredicates{
+					predicates.PodFitsHostPorts: predicates_fake.EmptyPredicate(predicates.PodFitsHostPorts),
+				},
+			},
+			wantErr: true,
+			wantedErrorData: []string{
+				"Scheduling conditions were not met for pod ns1/p1:",
+				"GpuIsolation: invalid gpu-isolation annotation value \"exclusive\", " +
+					"supported values are none, mps and time-slicing.",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Logf("Running test: %s", tt.name)
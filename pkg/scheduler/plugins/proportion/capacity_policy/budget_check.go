@@ -0,0 +1,63 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity_policy
+
+import (
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/utils"
+)
+
+// resultsOverBudget rejects GPU allocations that would take a queue that exhausted its GPU-hours budget over its
+// deserved GPU quota, and, depending on the budget's enforcement, GPU allocations of preemptible jobs in such a queue.
+func (cp *CapacityPolicy) resultsOverBudget(requestedShare rs.ResourceQuantities,
+	job *podgroup_info.PodGroupInfo) *api.SchedulableResult {
+
+	requestedGPUs := requestedShare[rs.GpuResource]
+	if requestedGPUs == 0 {
+		return Schedulable()
+	}
+
+	for queueAttributes, ok := cp.queues[job.Queue]; ok; queueAttributes, ok = cp.queues[queueAttributes.ParentQueue] {
+		if !queueAttributes.BudgetExhausted {
+			continue
+		}
+
+		message := ""
+		gpuShare := queueAttributes.ResourceShare(rs.GpuResource)
+		if queueAttributes.BudgetEnforcement == v2.BudgetEnforcementNonPreemptibleOnly && job.IsPreemptibleJob() {
+			message = api.GetPreemptibleJobOverBudgetMessageForQueue(queueAttributes.Name)
+		} else if gpuShare.Deserved != commonconstants.UnlimitedResourceQuantity &&
+			gpuShare.Deserved < gpuShare.Allocated+requestedGPUs {
+			message = api.GetJobOverBudgetMessageForQueue(queueAttributes.Name, gpuShare.Deserved, gpuShare.Allocated,
+				requestedGPUs)
+		}
+		if len(message) == 0 {
+			continue
+		}
+
+		request := utils.ResourceRequirementsFromQuantities(requestedShare).ToResourceList()
+		return &api.SchedulableResult{
+			IsSchedulable: false,
+			Reason:        v2alpha2.OverBudget,
+			Message:       message,
+			Details: &v2alpha2.UnschedulableExplanationDetails{
+				QueueDetails: &v2alpha2.QuotaDetails{
+					Name:                       string(queueAttributes.UID),
+					QueueRequestedResources:    utils.ResourceRequirementsFromQuantities(queueAttributes.GetRequestShare()).ToResourceList(),
+					QueueDeservedResources:     utils.ResourceRequirementsFromQuantities(queueAttributes.GetDeservedShare()).ToResourceList(),
+					QueueAllocatedResources:    utils.ResourceRequirementsFromQuantities(queueAttributes.GetAllocatedShare()).ToResourceList(),
+					QueueResourceLimits:        utils.ResourceRequirementsFromQuantities(queueAttributes.GetMaxAllowedShare()).ToResourceList(),
+					PodGroupRequestedResources: request,
+				},
+			},
+		}
+	}
+
+	return Schedulable()
+}
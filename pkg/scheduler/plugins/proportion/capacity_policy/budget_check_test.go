@@ -0,0 +1,104 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity_policy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var _ = Describe("Budget Policy Check", func() {
+	Describe("resultsOverBudget", func() {
+		newQueues := func(exhausted bool, enforcement v2.BudgetEnforcement) map[common_info.QueueID]*rs.QueueAttributes {
+			return map[common_info.QueueID]*rs.QueueAttributes{
+				"department": {
+					UID:               "department",
+					Name:              "department",
+					ChildQueues:       []common_info.QueueID{"project"},
+					BudgetExhausted:   exhausted,
+					BudgetEnforcement: enforcement,
+					QueueResourceShare: rs.QueueResourceShare{
+						GPU: rs.ResourceShare{Deserved: 4, Allocated: 3},
+					},
+				},
+				"project": {
+					UID:         "project",
+					Name:        "project",
+					ParentQueue: "department",
+					QueueResourceShare: rs.QueueResourceShare{
+						GPU: rs.ResourceShare{Deserved: 4, Allocated: 3},
+					},
+				},
+			}
+		}
+
+		tests := map[string]struct {
+			queues         map[common_info.QueueID]*rs.QueueAttributes
+			preemptibility v2alpha2.Preemptibility
+			requestedShare rs.ResourceQuantities
+			expectedResult bool
+		}{
+			"budget not exhausted - over quota": {
+				queues:         newQueues(false, v2.BudgetEnforcementNoOverQuota),
+				preemptibility: v2alpha2.Preemptible,
+				requestedShare: rs.ResourceQuantities{rs.GpuResource: 2},
+				expectedResult: true,
+			},
+			"parent budget exhausted - within quota": {
+				queues:         newQueues(true, v2.BudgetEnforcementNoOverQuota),
+				preemptibility: v2alpha2.Preemptible,
+				requestedShare: rs.ResourceQuantities{rs.GpuResource: 1},
+				expectedResult: true,
+			},
+			"parent budget exhausted - over quota": {
+				queues:         newQueues(true, v2.BudgetEnforcementNoOverQuota),
+				preemptibility: v2alpha2.Preemptible,
+				requestedShare: rs.ResourceQuantities{rs.GpuResource: 2},
+				expectedResult: false,
+			},
+			"parent budget exhausted - cpu only job": {
+				queues:         newQueues(true, v2.BudgetEnforcementNonPreemptibleOnly),
+				preemptibility: v2alpha2.Preemptible,
+				requestedShare: rs.ResourceQuantities{rs.CpuResource: 1000},
+				expectedResult: true,
+			},
+			"non preemptible only - preemptible job within quota": {
+				queues:         newQueues(true, v2.BudgetEnforcementNonPreemptibleOnly),
+				preemptibility: v2alpha2.Preemptible,
+				requestedShare: rs.ResourceQuantities{rs.GpuResource: 1},
+				expectedResult: false,
+			},
+			"non preemptible only - non preemptible job within quota": {
+				queues:         newQueues(true, v2.BudgetEnforcementNonPreemptibleOnly),
+				preemptibility: v2alpha2.NonPreemptible,
+				requestedShare: rs.ResourceQuantities{rs.GpuResource: 1},
+				expectedResult: true,
+			},
+		}
+		for testName, testData := range tests {
+			testName := testName
+			testData := testData
+			It(testName, func() {
+				job := &podgroup_info.PodGroupInfo{
+					Name:           "job-a",
+					Namespace:      "team-a",
+					Queue:          "project",
+					Preemptibility: testData.preemptibility,
+				}
+				result := New(testData.queues).resultsOverBudget(testData.requestedShare, job)
+				Expect(result.IsSchedulable).To(Equal(testData.expectedResult))
+				if !testData.expectedResult {
+					Expect(result.Reason).To(Equal(v2alpha2.OverBudget))
+					Expect(result.Details.QueueDetails.Name).To(Equal("department"))
+				}
+			})
+		}
+	})
+})
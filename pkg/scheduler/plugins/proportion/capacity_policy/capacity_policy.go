@@ -11,6 +11,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/utils"
 )
 
 type capacityCheckFn func(requestedShare rs.ResourceQuantities, job *podgroup_info.PodGroupInfo) *api.SchedulableResult
@@ -30,8 +31,9 @@ func (cp *CapacityPolicy) IsJobOverQueueCapacity(job *podgroup_info.PodGroupInfo
 		requiredQuota.MilliCPU,
 		requiredQuota.Memory,
 		requiredQuota.GPU)
+	requestedShareQuantities.Add(getRequiredExtendedQuota(tasksToAllocate...))
 
-	checkFns := []capacityCheckFn{cp.resultsOverLimit, cp.resultsWithNonPreemptibleOverQuota}
+	checkFns := []capacityCheckFn{cp.resultsOverLimit, cp.resultsWithNonPreemptibleOverQuota, cp.resultsOverBudget}
 	return cp.isJobOverCapacity(requestedShareQuantities, job, checkFns)
 }
 
@@ -43,6 +45,7 @@ func (cp *CapacityPolicy) IsNonPreemptibleJobOverQuota(job *podgroup_info.PodGro
 		requiredQuota.MilliCPU,
 		requiredQuota.Memory,
 		requiredQuota.GPU)
+	requestedShareQuantities.Add(getRequiredExtendedQuota(tasksToAllocate...))
 
 	checkFns := []capacityCheckFn{cp.resultsWithNonPreemptibleOverQuota}
 	return cp.isJobOverCapacity(requestedShareQuantities, job, checkFns)
@@ -55,8 +58,9 @@ func (cp *CapacityPolicy) IsTaskAllocationOnNodeOverCapacity(task *pod_info.PodI
 		requiredInitQuota.MilliCPU,
 		requiredInitQuota.Memory,
 		requiredInitQuota.GPU)
+	requestedShare.Add(getRequiredExtendedQuota(task))
 
-	checkFns := []capacityCheckFn{cp.resultsOverLimit, cp.resultsWithNonPreemptibleOverQuota}
+	checkFns := []capacityCheckFn{cp.resultsOverLimit, cp.resultsWithNonPreemptibleOverQuota, cp.resultsOverBudget}
 	return cp.isJobOverCapacity(requestedShare, job, checkFns)
 }
 
@@ -82,3 +86,16 @@ func getRequiredQuota(tasksToAllocate []*pod_info.PodInfo) *podgroup_info.JobReq
 	}
 	return &quota
 }
+
+// getRequiredExtendedQuota returns the extended resources requested by the tasks, by their Kubernetes resource name.
+func getRequiredExtendedQuota(tasks ...*pod_info.PodInfo) rs.ResourceQuantities {
+	quota := rs.ResourceQuantities{}
+	for _, task := range tasks {
+		for resource, quantity := range utils.QuantifyResourceRequirements(task.ResReq) {
+			if rs.IsExtendedResource(resource) {
+				quota[resource] += quantity
+			}
+		}
+	}
+	return quota
+}
@@ -49,7 +49,7 @@ func (cp *CapacityPolicy) resultsOverLimit(requestedShare rs.ResourceQuantities,
 }
 
 func isOverLimit(queueAttributes *rs.QueueAttributes, requested rs.ResourceQuantities) (bool, rs.ResourceName) {
-	for _, resource := range rs.AllResources {
+	for _, resource := range queueAttributes.Resources() {
 		resourceShare := queueAttributes.ResourceShare(resource)
 		if resourceShare.MaxAllowed == constants.UnlimitedResourceQuantity {
 			continue
@@ -206,6 +206,24 @@ var _ = Describe("Max Allowed Policy Check", func() {
 				})
 			}
 		})
+
+		It("is over the limit of an extended resource", func() {
+			queueAttributes.SetQuotaResources("rdma/hca", 0, 4, 1)
+			queueAttributes.ResourceShare("rdma/hca").Allocated = 3
+			for _, resource := range rs.AllResources {
+				queueAttributes.ResourceShare(resource).MaxAllowed = commonconstants.UnlimitedResourceQuantity
+			}
+
+			requestedQuota := rs.NewResourceQuantities(1000, 1, 1)
+			requestedQuota["rdma/hca"] = 1
+			isOverMaxAllowed, _ := isOverLimit(queueAttributes, requestedQuota)
+			Expect(isOverMaxAllowed).To(BeFalse())
+
+			requestedQuota["rdma/hca"] = 2
+			isOverMaxAllowed, resourceName := isOverLimit(queueAttributes, requestedQuota)
+			Expect(isOverMaxAllowed).To(BeTrue())
+			Expect(resourceName).To(Equal(rs.ResourceName("rdma/hca")))
+		})
 	})
 
 	Describe("resultsOverLimit", func() {
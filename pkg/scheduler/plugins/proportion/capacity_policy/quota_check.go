@@ -60,7 +60,7 @@ func (cp *CapacityPolicy) resultsWithNonPreemptibleOverQuota(requestedShare rs.R
 func isAllocatedNonPreemptibleOverQuota(
 	queueAttributes *rs.QueueAttributes, requested rs.ResourceQuantities,
 ) (bool, rs.ResourceName) {
-	for _, resource := range rs.AllResources {
+	for _, resource := range queueAttributes.Resources() {
 		resourceShare := queueAttributes.ResourceShare(resource)
 		if resourceShare.Deserved == commonconstants.UnlimitedResourceQuantity {
 			continue
@@ -80,6 +80,10 @@ func getNonPreemptibleJobOverQuotaError(queueAttributes *rs.QueueAttributes, req
 	exceedingResourceName rs.ResourceName) string {
 	deserved := queueAttributes.GetDeservedShare()[exceedingResourceName]
 	allocatedNonPreemptible := queueAttributes.GetAllocatedNonPreemptible()[exceedingResourceName]
+	if rs.IsExtendedResource(exceedingResourceName) {
+		return api.GetExtendedResourceOverCapacityMessageForQueue(queueAttributes.Name, string(exceedingResourceName),
+			deserved, allocatedNonPreemptible, requestedQuota[exceedingResourceName])
+	}
 	jobReq := podgroup_info.JobRequirement{
 		GPU:      requestedQuota[rs.GpuResource],
 		MilliCPU: requestedQuota[rs.CpuResource],
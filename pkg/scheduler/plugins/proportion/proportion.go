@@ -21,6 +21,7 @@ package proportion
 
 import (
 	"math"
+	"time"
 
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
@@ -46,7 +47,8 @@ import (
 )
 
 const (
-	mebibytes = 1000 * 1000
+	mebibytes                              = 1000 * 1000
+	defaultExtendedResourceOverQuotaWeight = 1
 )
 
 type proportionPlugin struct {
@@ -291,6 +293,11 @@ func (pp *proportionPlugin) createQueueAttributes(ssn *framework.Session) {
 	pp.createQueueResourceAttrs(ssn)
 	pp.updateQueuesCurrentResourceUsage(ssn)
 	pp.setFairShare()
+	// Sessions that only evaluate scheduling decisions are opened without the usage data of the scheduling cycle,
+	// their division result is not published
+	if ssn.IsSchedulingCycle() {
+		pp.reportQueueMetrics()
+	}
 }
 
 func (pp *proportionPlugin) buildReclaimerInfo(reclaimer *podgroup_info.PodGroupInfo, minNodeGPUMemory int64) *rec.ReclaimerInfo {
@@ -305,6 +312,7 @@ func (pp *proportionPlugin) buildReclaimerInfo(reclaimer *podgroup_info.PodGroup
 }
 
 func (pp *proportionPlugin) createQueueResourceAttrs(ssn *framework.Session) {
+	now := time.Now()
 	for _, queue := range ssn.ClusterInfo.Queues {
 		queueAttributes := &rs.QueueAttributes{
 			UID:               queue.UID,
@@ -317,31 +325,68 @@ func (pp *proportionPlugin) createQueueResourceAttrs(ssn *framework.Session) {
 				CPU:    rs.ResourceShare{},
 				Memory: rs.ResourceShare{},
 			},
-			Priority: queue.Priority,
+			Priority:          queue.Priority,
+			BudgetExhausted:   queue.BudgetExhausted,
+			BudgetEnforcement: queue.BudgetEnforcement,
 		}
-		deserved := queue.Resources.CPU.Quota
-		limit := queue.Resources.CPU.Limit
-		overQuotaWeight := queue.Resources.CPU.OverQuotaWeight
+		resources := queue.EffectiveResources(now)
+		deserved := resources.CPU.Quota
+		limit := resources.CPU.Limit
+		overQuotaWeight := resources.CPU.OverQuotaWeight
 		queueAttributes.SetQuotaResources(rs.CpuResource, deserved, limit, overQuotaWeight)
 
-		deserved = math.Max(commonconstants.UnlimitedResourceQuantity, queue.Resources.Memory.Quota*mebibytes)
-		limit = math.Max(commonconstants.UnlimitedResourceQuantity, queue.Resources.Memory.Limit*mebibytes)
-		overQuotaWeight = queue.Resources.Memory.OverQuotaWeight
+		deserved = math.Max(commonconstants.UnlimitedResourceQuantity, resources.Memory.Quota*mebibytes)
+		limit = math.Max(commonconstants.UnlimitedResourceQuantity, resources.Memory.Limit*mebibytes)
+		overQuotaWeight = resources.Memory.OverQuotaWeight
 		queueAttributes.SetQuotaResources(rs.MemoryResource, deserved, limit, overQuotaWeight)
 
-		deserved = queue.Resources.GPU.Quota
-		limit = queue.Resources.GPU.Limit
-		overQuotaWeight = queue.Resources.GPU.OverQuotaWeight
+		deserved = resources.GPU.Quota
+		limit = resources.GPU.Limit
+		overQuotaWeight = resources.GPU.OverQuotaWeight
+		if queue.BudgetExhausted {
+			// A queue that exhausted its budget gets no over-quota GPUs, so that other queues can reclaim them
+			overQuotaWeight = 0
+		}
 		queueAttributes.SetQuotaResources(rs.GpuResource, deserved, limit, overQuotaWeight)
 
-		usage, found := ssn.ClusterInfo.QueueResourceUsage.Queues[queue.UID]
-		if found {
-			queueAttributes.SetResourceUsage(usage)
+		for resourceName, resource := range resources.Extended {
+			queueAttributes.SetQuotaResources(
+				rs.ResourceName(resourceName), resource.Quota, resource.Limit, resource.OverQuotaWeight)
 		}
 
 		pp.queues[queue.UID] = queueAttributes
 		log.InfraLogger.V(7).Infof("Added queue attributes for queue <%s>", queue.Name)
 	}
+	pp.setUnconfiguredExtendedResources()
+
+	for _, queueAttributes := range pp.queues {
+		usage, found := ssn.ClusterInfo.QueueResourceUsage.Queues[queueAttributes.UID]
+		if found {
+			queueAttributes.SetResourceUsage(usage)
+		}
+	}
+}
+
+// setUnconfiguredExtendedResources makes every queue track the extended resources that any queue has a quota for,
+// so that the resources can be divided between all the queues. A queue that doesn't configure such a resource has no
+// deserved quota and no limit for it.
+func (pp *proportionPlugin) setUnconfiguredExtendedResources() {
+	extendedResources := map[rs.ResourceName]bool{}
+	for _, queue := range pp.queues {
+		for resourceName := range queue.Extended {
+			extendedResources[resourceName] = true
+		}
+	}
+
+	for _, queue := range pp.queues {
+		for resourceName := range extendedResources {
+			if queue.ResourceShare(resourceName) != nil {
+				continue
+			}
+			queue.SetQuotaResources(resourceName, 0, commonconstants.UnlimitedResourceQuantity,
+				defaultExtendedResourceOverQuotaWeight)
+		}
+	}
 }
 
 func (pp *proportionPlugin) updateQueuesCurrentResourceUsage(ssn *framework.Session) {
@@ -375,7 +420,7 @@ func (pp *proportionPlugin) updateQueuesResourceUsageForAllocatedJob(queueId com
 	resourceQuantities rs.ResourceQuantities, preemptibleJob bool) {
 
 	for queueAttributes, ok := pp.queues[queueId]; ok; queueAttributes, ok = pp.queues[queueAttributes.ParentQueue] {
-		for _, resource := range rs.AllResources {
+		for _, resource := range queueAttributes.Resources() {
 			qResourceShare := queueAttributes.ResourceShare(resource)
 			resourceRequestedQuota := resourceQuantities[resource]
 
@@ -392,7 +437,7 @@ func (pp *proportionPlugin) updateQueuesResourceUsageForPendingJob(queueId commo
 	resourceQuantities rs.ResourceQuantities) {
 
 	for queueAttributes, ok := pp.queues[queueId]; ok; queueAttributes, ok = pp.queues[queueAttributes.ParentQueue] {
-		for _, resource := range rs.AllResources {
+		for _, resource := range queueAttributes.Resources() {
 			qResourceShare := queueAttributes.ResourceShare(resource)
 			resourceRequestedQuota := resourceQuantities[resource]
 			qResourceShare.Request += resourceRequestedQuota
@@ -402,9 +447,30 @@ func (pp *proportionPlugin) updateQueuesResourceUsageForPendingJob(queueId commo
 
 func (pp *proportionPlugin) setFairShare() {
 	topQueues := pp.getTopQueues()
+	pp.setFairShareForQueues(pp.totalResource, pp.kValue, topQueues)
+}
+
+func (pp *proportionPlugin) reportQueueMetrics() {
 	metrics.ResetQueueUsage()
 	metrics.ResetQueueFairShare()
-	pp.setFairShareForQueues(pp.totalResource, pp.kValue, topQueues)
+	for _, queue := range pp.queues {
+		cpuResourceShare := queue.ResourceShare(rs.CpuResource)
+		memoryResourceShare := queue.ResourceShare(rs.MemoryResource)
+		gpuResourceShare := queue.ResourceShare(rs.GpuResource)
+
+		metrics.UpdateQueueFairShare(
+			queue.Name,
+			cpuResourceShare.FairShare/resource_info.MilliCPUToCores,
+			memoryResourceShare.FairShare/resource_info.MemoryToGB,
+			gpuResourceShare.FairShare,
+		)
+		metrics.UpdateQueueUsage(
+			queue.Name,
+			cpuResourceShare.GetUsage(),
+			memoryResourceShare.GetUsage(),
+			gpuResourceShare.GetUsage(),
+		)
+	}
 }
 
 func (pp *proportionPlugin) setFairShareForQueues(totalResources rs.ResourceQuantities, kValue float64,
@@ -447,7 +513,7 @@ func (pp *proportionPlugin) allocateHandlerFn(ssn *framework.Session) func(event
 		taskResources := utils.QuantifyResourceRequirements(event.Task.AcceptedResource)
 
 		for queue, ok := pp.queues[job.Queue]; ok; queue, ok = pp.queues[queue.ParentQueue] {
-			for _, resource := range rs.AllResources {
+			for _, resource := range queue.Resources() {
 				resourceShare := queue.ResourceShare(resource)
 				resourceShare.Allocated += taskResources[resource]
 
@@ -471,7 +537,7 @@ func (pp *proportionPlugin) deallocateHandlerFn(ssn *framework.Session) func(eve
 		taskResources := utils.QuantifyResourceRequirements(event.Task.AcceptedResource)
 
 		for queue, ok := pp.queues[job.Queue]; ok; queue, ok = pp.queues[queue.ParentQueue] {
-			for _, resource := range rs.AllResources {
+			for _, resource := range queue.Resources() {
 				resourceShare := queue.ResourceShare(resource)
 				resourceShare.Allocated -= taskResources[resource]
 
@@ -583,9 +583,11 @@ var _ = Describe("Set Fair Share in Proportion", func() {
 					),
 				},
 				want: rs.ResourceQuantities{
-					rs.CpuResource:    8000,
-					rs.MemoryResource: 10000000000,
-					rs.GpuResource:    1,
+					rs.CpuResource:          8000,
+					rs.MemoryResource:       10000000000,
+					rs.GpuResource:          1,
+					"nvidia.com/mig-1g.5gb": 1,
+					"pods":                  110,
 				},
 			},
 			{
@@ -789,7 +791,7 @@ var _ = Describe("Set Fair Share in Proportion", func() {
 						RestrictSchedulingNodes: testData.isRestrictNode,
 						SchedulerName:           schedulerName,
 					},
-					"1", nil)
+					"1", nil, nil)
 				if got := getNodeResources(session, testData.node); !reflect.DeepEqual(got, testData.want) {
 					Fail(fmt.Sprintf("getNodeResources() = %v, want %v", got, testData.want))
 				}
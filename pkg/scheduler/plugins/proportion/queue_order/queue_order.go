@@ -143,7 +143,7 @@ func penalizeZeroShareWithJob(
 	rAllocatedWithJob.Add(rJobRequirements)
 
 	lQueueViolation := false
-	for _, resource := range rs.AllResources {
+	for _, resource := range lQueue.Resources() {
 		allocatableShare := lQueue.GetAllocatableShare()[resource]
 		if allocatableShare != 0 {
 			continue
@@ -156,7 +156,7 @@ func penalizeZeroShareWithJob(
 	}
 
 	rQueueViolation := false
-	for _, resource := range rs.AllResources {
+	for _, resource := range rQueue.Resources() {
 		allocatableShare := rQueue.GetAllocatableShare()[resource]
 		if allocatableShare != 0 {
 			continue
@@ -250,13 +250,13 @@ func calculateDominantResourceShareWithJob(
 	jobResources := podgroup_info.GetTasksToAllocateInitResource(jobInfo, subGroupOrderFn, taskOrderFn, false, minNodeGPUMemory)
 	initResQuantities := utils.QuantifyResource(jobResources)
 
-	for _, resource := range rs.AllResources {
+	for _, resource := range queueAttributes.Resources() {
 		resourceShare := queueAttributes.ResourceShare(resource)
 		resourceShare.Allocated += initResQuantities[resource]
 	}
 
 	for _, victim := range victims {
-		for _, resource := range rs.AllResources {
+		for _, resource := range queueAttributes.Resources() {
 			resourceShare := queueAttributes.ResourceShare(resource)
 			resourceShare.Allocated -= utils.QuantifyResource(victim.Allocated)[resource]
 		}
@@ -264,7 +264,7 @@ func calculateDominantResourceShareWithJob(
 
 	share := queueAttributes.GetDominantResourceShare(totalResources)
 
-	for _, resource := range rs.AllResources {
+	for _, resource := range queueAttributes.Resources() {
 		resourceShare := queueAttributes.ResourceShare(resource)
 		resourceShare.Allocated = allocatedShare[resource]
 	}
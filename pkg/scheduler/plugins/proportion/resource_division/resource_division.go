@@ -13,7 +13,6 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
-	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
 	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/scheduler_util"
 )
@@ -24,12 +23,41 @@ type remainingRequestedResource struct {
 }
 
 func SetResourcesShare(totalResource rs.ResourceQuantities, kValue float64, queues map[common_info.QueueID]*rs.QueueAttributes) {
-	for _, resource := range rs.AllResources {
-		setResourceShare(totalResource[resource], kValue, resource, queues)
+	for _, resource := range getQueuesResources(queues) {
+		setResourceShare(totalResource[resource], kValue, resource, getQueuesTrackingResource(queues, resource))
 	}
 	reportDivisionResult(queues)
 }
 
+// getQueuesResources returns the base resources followed by the sorted extended resources of the queues. Extended
+// resources are divided only between the queues that track them.
+func getQueuesResources(queues map[common_info.QueueID]*rs.QueueAttributes) []rs.ResourceName {
+	var extendedResources []rs.ResourceName
+	for _, queue := range queues {
+		for resource := range queue.Extended {
+			if !slices.Contains(extendedResources, resource) {
+				extendedResources = append(extendedResources, resource)
+			}
+		}
+	}
+	slices.Sort(extendedResources)
+	return append(slices.Clone(rs.AllResources), extendedResources...)
+}
+
+func getQueuesTrackingResource(queues map[common_info.QueueID]*rs.QueueAttributes,
+	resource rs.ResourceName) map[common_info.QueueID]*rs.QueueAttributes {
+	if !rs.IsExtendedResource(resource) {
+		return queues
+	}
+	trackingQueues := map[common_info.QueueID]*rs.QueueAttributes{}
+	for queueID, queue := range queues {
+		if queue.ResourceShare(resource) != nil {
+			trackingQueues[queueID] = queue
+		}
+	}
+	return trackingQueues
+}
+
 func setResourceShare(totalAmount, kValue float64, resourceName rs.ResourceName, queues map[common_info.QueueID]*rs.QueueAttributes) float64 {
 	log.InfraLogger.V(6).Infof("About to start calculating %v fairShare, totalAmount: <%v>", resourceName, totalAmount)
 	remainingAmount := setDeservedResource(totalAmount, queues, resourceName)
@@ -47,20 +75,6 @@ func reportDivisionResult(queues map[common_info.QueueID]*rs.QueueAttributes) {
 		memoryResourceShare := queue.ResourceShare(rs.MemoryResource)
 		gpuResourceShare := queue.ResourceShare(rs.GpuResource)
 
-		metrics.UpdateQueueFairShare(
-			queue.Name,
-			cpuResourceShare.FairShare/resource_info.MilliCPUToCores,
-			memoryResourceShare.FairShare/resource_info.MemoryToGB,
-			gpuResourceShare.FairShare,
-		)
-
-		metrics.UpdateQueueUsage(
-			queue.Name,
-			cpuResourceShare.GetUsage(),
-			memoryResourceShare.GetUsage(),
-			gpuResourceShare.GetUsage(),
-		)
-
 		log.InfraLogger.V(3).Infof("Resource division result for queue <%v>: "+
 			"Queue Priority: <%d>, "+
 			"GPU: deserved: <%v>, requested: <%v>, maxAllowed: <%v>, allocated: <%v>, historicalUsage: <%v>, fairShare: <%v> "+
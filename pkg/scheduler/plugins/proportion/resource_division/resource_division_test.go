@@ -2019,6 +2019,35 @@ var _ = Describe("Proportion", func() {
 	})
 })
 
+var _ = Describe("SetResourcesShare - extended resources", func() {
+	It("divides extended resources between the queues that track them", func() {
+		queues := map[common_info.QueueID]*rs.QueueAttributes{}
+		for _, queueID := range []common_info.QueueID{"1", "2", "3"} {
+			queues[queueID] = &rs.QueueAttributes{
+				UID:  queueID,
+				Name: string(queueID),
+				QueueResourceShare: rs.QueueResourceShare{
+					CPU:    rs.EmptyResource(),
+					Memory: rs.EmptyResource(),
+					GPU:    rs.EmptyResource(),
+				},
+			}
+		}
+		queues["1"].SetQuotaResources("rdma/hca", 2, commonconstants.UnlimitedResourceQuantity, 1)
+		queues["1"].ResourceShare("rdma/hca").Request = 6
+		queues["2"].SetQuotaResources("rdma/hca", 0, commonconstants.UnlimitedResourceQuantity, 1)
+		queues["2"].ResourceShare("rdma/hca").Request = 6
+
+		totalResources := rs.EmptyResourceQuantities()
+		totalResources["rdma/hca"] = 8
+		SetResourcesShare(totalResources, 1, queues)
+
+		Expect(queues["1"].GetFairShare()["rdma/hca"]).To(Equal(float64(5)))
+		Expect(queues["2"].GetFairShare()["rdma/hca"]).To(Equal(float64(3)))
+		Expect(queues["3"].ResourceShare("rdma/hca")).To(BeNil())
+	})
+})
+
 var _ = Describe("getQueuesByPriority", func() {
 	It("should return queues by priority", func() {
 		queues := map[common_info.QueueID]*rs.QueueAttributes{
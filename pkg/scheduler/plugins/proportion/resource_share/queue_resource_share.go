@@ -4,12 +4,14 @@
 package resource_share
 
 import (
+	"maps"
 	"math"
 	"slices"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
@@ -26,6 +28,9 @@ type QueueAttributes struct {
 	ChildQueues       []common_info.QueueID
 	CreationTimestamp metav1.Time
 	Priority          int
+	// BudgetExhausted is true when the queue consumed its whole GPU-hours budget for the current budget period
+	BudgetExhausted   bool
+	BudgetEnforcement v2.BudgetEnforcement
 	QueueResourceShare
 }
 
@@ -37,7 +42,9 @@ func (q *QueueAttributes) Clone() *QueueAttributes {
 		ChildQueues:        slices.Clone(q.ChildQueues),
 		CreationTimestamp:  q.CreationTimestamp,
 		Priority:           q.Priority,
-		QueueResourceShare: q.QueueResourceShare,
+		BudgetExhausted:    q.BudgetExhausted,
+		BudgetEnforcement:  q.BudgetEnforcement,
+		QueueResourceShare: q.QueueResourceShare.clone(),
 	}
 }
 
@@ -50,6 +57,9 @@ type QueueResourceShare struct {
 	Memory ResourceShare
 	GPU    ResourceShare
 
+	// Extended resources with a queue quota, keyed by their Kubernetes resource name
+	Extended map[ResourceName]*ResourceShare
+
 	// cache
 	lastDeservedShare ResourceQuantities
 	lastFairShare     ResourceQuantities
@@ -65,7 +75,29 @@ func (qrs *QueueResourceShare) ResourceShare(resource ResourceName) *ResourceSha
 	case GpuResource:
 		return &qrs.GPU
 	}
-	return nil
+	return qrs.Extended[resource]
+}
+
+// Resources returns the names of the base resources followed by the sorted names of the extended resources of the
+// queue.
+func (qrs *QueueResourceShare) Resources() []ResourceName {
+	if len(qrs.Extended) == 0 {
+		return AllResources
+	}
+	resources := slices.Clone(AllResources)
+	extendedResources := slices.Sorted(maps.Keys(qrs.Extended))
+	return append(resources, extendedResources...)
+}
+
+func (qrs *QueueResourceShare) clone() QueueResourceShare {
+	clone := *qrs
+	if qrs.Extended != nil {
+		clone.Extended = make(map[ResourceName]*ResourceShare, len(qrs.Extended))
+		for resource, resourceShare := range qrs.Extended {
+			clone.Extended[resource] = resourceShare.Clone()
+		}
+	}
+	return clone
 }
 
 func (qrs *QueueResourceShare) GetAllocatableShare() ResourceQuantities {
@@ -132,7 +164,7 @@ func (qrs *QueueResourceShare) GetRequestShare() ResourceQuantities {
 
 func (qrs *QueueResourceShare) buildResourceQuantities(f resourceShareMapFunc) ResourceQuantities {
 	quantities := ResourceQuantities{}
-	for _, resource := range AllResources {
+	for _, resource := range qrs.Resources() {
 		resourceShare := qrs.ResourceShare(resource)
 		quantities[resource] = f(resourceShare)
 	}
@@ -142,7 +174,7 @@ func (qrs *QueueResourceShare) buildResourceQuantities(f resourceShareMapFunc) R
 func (qrs *QueueResourceShare) GetDominantResourceShare(totalResources ResourceQuantities) float64 {
 	dominantResource := 0.0
 
-	for _, resource := range AllResources {
+	for _, resource := range qrs.Resources() {
 		var value float64
 
 		resourceShare := qrs.ResourceShare(resource)
@@ -176,6 +208,13 @@ func (qrs *QueueResourceShare) AddResourceShare(resource ResourceName, amount fl
 func (qrs *QueueResourceShare) SetQuotaResources(resource ResourceName, deserved float64, maxAllowed float64,
 	overQuotaWeight float64) {
 	resourceShare := qrs.ResourceShare(resource)
+	if resourceShare == nil {
+		if qrs.Extended == nil {
+			qrs.Extended = map[ResourceName]*ResourceShare{}
+		}
+		resourceShare = &ResourceShare{}
+		qrs.Extended[resource] = resourceShare
+	}
 	resourceShare.Deserved = deserved
 	resourceShare.MaxAllowed = maxAllowed
 	resourceShare.OverQuotaWeight = overQuotaWeight
@@ -185,15 +224,22 @@ func (qrs *QueueResourceShare) SetQuotaResources(resource ResourceName, deserved
 }
 
 func (qrs *QueueResourceShare) GetResourceUsage() queue_info.QueueUsage {
-	return queue_info.QueueUsage{
+	usage := queue_info.QueueUsage{
 		commonconstants.NvidiaGpuResource: qrs.GPU.Usage,
 		v1.ResourceCPU:                    qrs.CPU.Usage,
 		v1.ResourceMemory:                 qrs.Memory.Usage,
 	}
+	for resource, resourceShare := range qrs.Extended {
+		usage[v1.ResourceName(resource)] = resourceShare.Usage
+	}
+	return usage
 }
 
 func (qrs *QueueResourceShare) SetResourceUsage(usage queue_info.QueueUsage) {
 	qrs.GPU.Usage = usage[commonconstants.NvidiaGpuResource]
 	qrs.CPU.Usage = usage[v1.ResourceCPU]
 	qrs.Memory.Usage = usage[v1.ResourceMemory]
+	for resource, resourceShare := range qrs.Extended {
+		resourceShare.Usage = usage[v1.ResourceName(resource)]
+	}
 }
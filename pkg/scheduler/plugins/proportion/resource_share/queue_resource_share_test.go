@@ -118,6 +118,26 @@ func TestQueueResourceShare_SetQuotaResources(t *testing.T) {
 	assert.Equal(t, 1.1, qrs.CPU.OverQuotaWeight)
 }
 
+func TestQueueResourceShare_ExtendedResources(t *testing.T) {
+	qrs := createQueueResourceShare()
+	qrs.SetQuotaResources("rdma/hca", 4, 8, 2)
+	qrs.SetQuotaResources("example.com/fpga", 1, -1, 1)
+	assert.Equal(t, []ResourceName{CpuResource, MemoryResource, GpuResource, "example.com/fpga", "rdma/hca"},
+		qrs.Resources())
+
+	share := qrs.ResourceShare("rdma/hca")
+	assert.Equal(t, float64(4), share.Deserved)
+	assert.Equal(t, float64(8), share.MaxAllowed)
+	assert.Equal(t, float64(2), share.OverQuotaWeight)
+	assert.Equal(t, float64(4), qrs.GetDeservedShare()["rdma/hca"])
+
+	share.Allocated = 3
+	clone := qrs.clone()
+	clone.ResourceShare("rdma/hca").Allocated = 5
+	assert.Equal(t, float64(3), qrs.GetAllocatedShare()["rdma/hca"])
+	assert.Equal(t, float64(5), clone.GetAllocatedShare()["rdma/hca"])
+}
+
 func createQueueResourceShare() *QueueResourceShare {
 	return &QueueResourceShare{
 		CPU: ResourceShare{
@@ -4,6 +4,10 @@
 package resource_share
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 )
@@ -31,24 +35,45 @@ func EmptyResourceQuantities() ResourceQuantities {
 	return NewResourceQuantities(0, 0, 0)
 }
 
+// IsExtendedResource returns true for the resources that are tracked by their Kubernetes resource name, e.g.
+// rdma/hca, rather than by one of the base resource names.
+func IsExtendedResource(resource ResourceName) bool {
+	return !slices.Contains(AllResources, resource)
+}
+
 func (rq ResourceQuantities) Clone() ResourceQuantities {
-	return NewResourceQuantities(rq[CpuResource], rq[MemoryResource], rq[GpuResource])
+	clone := NewResourceQuantities(rq[CpuResource], rq[MemoryResource], rq[GpuResource])
+	for resource, quantity := range rq {
+		clone[resource] = quantity
+	}
+	return clone
 }
 
 func (rq ResourceQuantities) Add(other ResourceQuantities) {
 	for _, resource := range AllResources {
 		rq[resource] += other[resource]
 	}
+	for resource, quantity := range other {
+		if IsExtendedResource(resource) {
+			rq[resource] += quantity
+		}
+	}
 }
 
 func (rq ResourceQuantities) Sub(other ResourceQuantities) {
 	for _, resource := range AllResources {
 		rq[resource] -= other[resource]
 	}
+	for resource, quantity := range other {
+		if IsExtendedResource(resource) {
+			rq[resource] -= quantity
+		}
+	}
 }
 
+// Less and LessEqual compare the base resources, and the extended resources that appear in both quantities.
 func (rq ResourceQuantities) Less(other ResourceQuantities) bool {
-	for _, resource := range AllResources {
+	for _, resource := range rq.comparableResources(other) {
 		if rq[resource] >= other[resource] {
 			return false
 		}
@@ -57,7 +82,7 @@ func (rq ResourceQuantities) Less(other ResourceQuantities) bool {
 }
 
 func (rq ResourceQuantities) LessEqual(other ResourceQuantities) bool {
-	for _, resource := range AllResources {
+	for _, resource := range rq.comparableResources(other) {
 		if compareQuantities(rq[resource], other[resource]) > 0 {
 			return false
 		}
@@ -70,9 +95,40 @@ func (rq ResourceQuantities) LessInAtLeastOneResource(other ResourceQuantities)
 }
 
 func (rq ResourceQuantities) String() string {
-	return resource_info.NewResource(
+	messageBuilder := strings.Builder{}
+	messageBuilder.WriteString(resource_info.NewResource(
 		rq[CpuResource], rq[MemoryResource], rq[GpuResource],
-	).String()
+	).String())
+	for _, resource := range rq.extendedResources() {
+		messageBuilder.WriteString(fmt.Sprintf(", %s: %s", resource, resource_info.HumanizeResource(rq[resource], 1)))
+	}
+	return messageBuilder.String()
+}
+
+func (rq ResourceQuantities) comparableResources(other ResourceQuantities) []ResourceName {
+	extendedResources := rq.extendedResources()
+	if len(extendedResources) == 0 {
+		return AllResources
+	}
+
+	resources := slices.Clone(AllResources)
+	for _, resource := range extendedResources {
+		if _, found := other[resource]; found {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+func (rq ResourceQuantities) extendedResources() []ResourceName {
+	var resources []ResourceName
+	for resource := range rq {
+		if IsExtendedResource(resource) {
+			resources = append(resources, resource)
+		}
+	}
+	slices.Sort(resources)
+	return resources
 }
 
 func compareQuantities(quantity, other float64) int {
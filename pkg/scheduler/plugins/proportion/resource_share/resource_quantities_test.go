@@ -97,6 +97,35 @@ func TestResourceQuantitiesNotLessEqualInOneResource(t *testing.T) {
 	assert.False(t, rq.LessInAtLeastOneResource(rq2))
 }
 
+func TestResourceQuantitiesExtendedResources(t *testing.T) {
+	rq := NewResourceQuantities(cpu, memory, gpu)
+	rq["rdma/hca"] = 2
+	clone := rq.Clone()
+	clone.Add(ResourceQuantities{"rdma/hca": 1, "example.com/fpga": 1})
+	assert.Equal(t, float64(2), rq["rdma/hca"])
+	assert.Equal(t, float64(3), clone["rdma/hca"])
+	assert.Equal(t, float64(1), clone["example.com/fpga"])
+
+	clone.Sub(ResourceQuantities{"rdma/hca": 3})
+	assert.Equal(t, float64(0), clone["rdma/hca"])
+	assert.Equal(t, "CPU: 0.111 (cores), memory: 0 (GB), Gpus: 0.5, example.com/fpga: 1, rdma/hca: 0", clone.String())
+}
+
+func TestResourceQuantitiesLessEqualExtendedResources(t *testing.T) {
+	rq := NewResourceQuantities(cpu, memory, gpu)
+	rq["rdma/hca"] = 2
+
+	limit := NewResourceQuantities(cpu, memory, gpu)
+	assert.True(t, rq.LessEqual(limit), "extended resources missing from the other quantities are not compared")
+
+	limit["rdma/hca"] = 1
+	assert.False(t, rq.LessEqual(limit))
+	assert.True(t, limit.LessInAtLeastOneResource(rq))
+
+	limit["rdma/hca"] = commonconstants.UnlimitedResourceQuantity
+	assert.True(t, rq.LessEqual(limit))
+}
+
 func asssertResourceQuantity(t *testing.T, rq ResourceQuantities) {
 	assert.Equal(t, cpu, rq[CpuResource])
 	assert.Equal(t, memory, rq[MemoryResource])
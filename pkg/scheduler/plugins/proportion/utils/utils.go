@@ -4,16 +4,31 @@
 package utils
 
 import (
+	v1 "k8s.io/api/core/v1"
+
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
 )
 
 func QuantifyResource(resource *resource_info.Resource) rs.ResourceQuantities {
-	return rs.NewResourceQuantities(resource.Cpu(), resource.Memory(), resource.GetTotalGPURequest())
+	quantities := rs.NewResourceQuantities(resource.Cpu(), resource.Memory(), resource.GetTotalGPURequest())
+	addScalarResources(quantities, resource.ScalarResources())
+	return quantities
 }
 
 func QuantifyResourceRequirements(resource *resource_info.ResourceRequirements) rs.ResourceQuantities {
-	return rs.NewResourceQuantities(resource.Cpu(), resource.Memory(), resource.GetGpusQuota())
+	quantities := rs.NewResourceQuantities(resource.Cpu(), resource.Memory(), resource.GetGpusQuota())
+	addScalarResources(quantities, resource.ScalarResources())
+	addScalarResources(quantities, resource.MigResources())
+	return quantities
+}
+
+// addScalarResources adds the scalar resources to the quantities by their Kubernetes resource name, so that they
+// can be matched against the extended resources of queues.
+func addScalarResources(quantities rs.ResourceQuantities, scalarResources map[v1.ResourceName]int64) {
+	for rName, quantity := range scalarResources {
+		quantities[rs.ResourceName(rName)] += resource_info.ScalarResourceQuantity(rName, quantity)
+	}
 }
 
 func ResourceRequirementsFromQuantities(quantities rs.ResourceQuantities) *resource_info.ResourceRequirements {
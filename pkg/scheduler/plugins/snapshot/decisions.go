@@ -0,0 +1,257 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+type BindDecision struct {
+	Namespace string   `json:"namespace"`
+	Name      string   `json:"name"`
+	PodGroup  string   `json:"podGroup"`
+	Node      string   `json:"node"`
+	GPUGroups []string `json:"gpuGroups,omitempty"`
+}
+
+type EvictionDecision struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	PodGroup  string `json:"podGroup"`
+	Queue     string `json:"queue"`
+	Node      string `json:"node"`
+	Action    string `json:"action"`
+	Preemptor string `json:"preemptor,omitempty"`
+}
+
+type PipelineDecision struct {
+	Namespace string   `json:"namespace"`
+	Name      string   `json:"name"`
+	PodGroup  string   `json:"podGroup"`
+	Node      string   `json:"node"`
+	GPUGroups []string `json:"gpuGroups,omitempty"`
+}
+
+// DecisionRecord holds the decisions the actions of a session committed.
+type DecisionRecord struct {
+	BindRequests []BindDecision     `json:"bindRequests"`
+	Evictions    []EvictionDecision `json:"evictions"`
+	Pipelined    []PipelineDecision `json:"pipelined"`
+	// UnschedulableReasons maps a PodGroup's namespace/name to the reasons it could not be scheduled.
+	UnschedulableReasons map[string][]string `json:"unschedulableReasons"`
+}
+
+type boundTask struct {
+	task     *pod_info.PodInfo
+	hostname string
+}
+
+// RecordingCache records the bind requests, evictions and pipelined tasks committed by a session's statements
+// instead of applying them to the cluster.
+type RecordingCache struct {
+	cache.Cache
+
+	bound     []boundTask
+	evictions []EvictionDecision
+	pipelined []*pod_info.PodInfo
+}
+
+func NewRecordingCache(schedulerCache cache.Cache) *RecordingCache {
+	return &RecordingCache{Cache: schedulerCache}
+}
+
+func (rc *RecordingCache) Bind(podInfo *pod_info.PodInfo, hostname string, _ *schedulingv1alpha2.GangBinding,
+	_ map[string]string) error {
+	rc.bound = append(rc.bound, boundTask{task: podInfo, hostname: hostname})
+	return nil
+}
+
+func (rc *RecordingCache) Evict(ssnPod *v1.Pod, job *podgroup_info.PodGroupInfo,
+	evictionMetadata eviction_info.EvictionMetadata, _ string) error {
+	eviction := EvictionDecision{
+		Namespace: ssnPod.Namespace,
+		Name:      ssnPod.Name,
+		PodGroup:  job.Name,
+		Queue:     string(job.Queue),
+		Node:      ssnPod.Spec.NodeName,
+		Action:    evictionMetadata.Action,
+	}
+	if evictionMetadata.Preemptor != nil {
+		eviction.Preemptor = evictionMetadata.Preemptor.String()
+	}
+	rc.evictions = append(rc.evictions, eviction)
+	return nil
+}
+
+func (rc *RecordingCache) TaskPipelined(task *pod_info.PodInfo, _ string) {
+	rc.pipelined = append(rc.pipelined, task)
+}
+
+func (rc *RecordingCache) RecordJobStatusEvent(_ *podgroup_info.PodGroupInfo) error {
+	return nil
+}
+
+// Decisions returns the decisions recorded so far, and the unschedulable reasons of the session's PodGroups.
+// It must be called before the session is closed.
+func (rc *RecordingCache) Decisions(ssn *framework.Session) *DecisionRecord {
+	record := &DecisionRecord{
+		BindRequests:         []BindDecision{},
+		Evictions:            slices.Clone(rc.evictions),
+		Pipelined:            []PipelineDecision{},
+		UnschedulableReasons: map[string][]string{},
+	}
+	if record.Evictions == nil {
+		record.Evictions = []EvictionDecision{}
+	}
+
+	for _, bound := range rc.bound {
+		record.BindRequests = append(record.BindRequests, BindDecision{
+			Namespace: bound.task.Namespace,
+			Name:      bound.task.Name,
+			PodGroup:  podGroupName(ssn, bound.task),
+			Node:      bound.hostname,
+			GPUGroups: bound.task.GPUGroups,
+		})
+	}
+
+	pipelinedTasks := map[string]bool{}
+	for _, task := range rc.pipelined {
+		// A task that was pipelined by one statement may be allocated by a later one
+		key := task.Namespace + "/" + task.Name
+		if task.Status != pod_status.Pipelined || pipelinedTasks[key] {
+			continue
+		}
+		pipelinedTasks[key] = true
+		record.Pipelined = append(record.Pipelined, PipelineDecision{
+			Namespace: task.Namespace,
+			Name:      task.Name,
+			PodGroup:  podGroupName(ssn, task),
+			Node:      task.NodeName,
+			GPUGroups: task.GPUGroups,
+		})
+	}
+
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		reasons := common.UnschedulableReasons(job)
+		if len(reasons) > 0 {
+			record.UnschedulableReasons[job.Namespace+"/"+job.Name] = reasons
+		}
+	}
+
+	sortByKey(record.BindRequests, func(d BindDecision) string { return d.Namespace + "/" + d.Name })
+	sortByKey(record.Evictions, func(d EvictionDecision) string { return d.Namespace + "/" + d.Name })
+	sortByKey(record.Pipelined, func(d PipelineDecision) string { return d.Namespace + "/" + d.Name })
+
+	return record
+}
+
+func podGroupName(ssn *framework.Session, task *pod_info.PodInfo) string {
+	if job, found := ssn.ClusterInfo.PodGroupInfos[task.Job]; found {
+		return job.Name
+	}
+	return string(task.Job)
+}
+
+// EntriesDiff holds the entries that appear in only one of two decision records.
+type EntriesDiff[T any] struct {
+	OnlyInBase     []T `json:"onlyInBase,omitempty"`
+	OnlyInCompared []T `json:"onlyInCompared,omitempty"`
+}
+
+type ReasonsDiff struct {
+	Base     []string `json:"base,omitempty"`
+	Compared []string `json:"compared,omitempty"`
+}
+
+// DecisionsDiff describes how the decisions of two runs on the same snapshot differ.
+type DecisionsDiff struct {
+	BindRequests         EntriesDiff[BindDecision]     `json:"bindRequests"`
+	Evictions            EntriesDiff[EvictionDecision] `json:"evictions"`
+	Pipelined            EntriesDiff[PipelineDecision] `json:"pipelined"`
+	UnschedulableReasons map[string]ReasonsDiff        `json:"unschedulableReasons,omitempty"`
+}
+
+func (d *DecisionsDiff) IsEmpty() bool {
+	return d.BindRequests.isEmpty() && d.Evictions.isEmpty() && d.Pipelined.isEmpty() &&
+		len(d.UnschedulableReasons) == 0
+}
+
+func (ed *EntriesDiff[T]) isEmpty() bool {
+	return len(ed.OnlyInBase) == 0 && len(ed.OnlyInCompared) == 0
+}
+
+// DiffDecisions compares the decisions of a base run with the decisions of a compared run.
+func DiffDecisions(base, compared *DecisionRecord) *DecisionsDiff {
+	diff := &DecisionsDiff{
+		BindRequests:         diffEntries(base.BindRequests, compared.BindRequests),
+		Evictions:            diffEntries(base.Evictions, compared.Evictions),
+		Pipelined:            diffEntries(base.Pipelined, compared.Pipelined),
+		UnschedulableReasons: map[string]ReasonsDiff{},
+	}
+
+	for podGroup, baseReasons := range base.UnschedulableReasons {
+		comparedReasons := compared.UnschedulableReasons[podGroup]
+		if !slices.Equal(baseReasons, comparedReasons) {
+			diff.UnschedulableReasons[podGroup] = ReasonsDiff{Base: baseReasons, Compared: comparedReasons}
+		}
+	}
+	for podGroup, comparedReasons := range compared.UnschedulableReasons {
+		if _, found := base.UnschedulableReasons[podGroup]; !found {
+			diff.UnschedulableReasons[podGroup] = ReasonsDiff{Compared: comparedReasons}
+		}
+	}
+
+	return diff
+}
+
+func diffEntries[T any](base, compared []T) EntriesDiff[T] {
+	baseKeys := entryKeys(base)
+	comparedKeys := entryKeys(compared)
+
+	diff := EntriesDiff[T]{}
+	for i, entry := range base {
+		if !comparedKeys[entryKey(entry)] {
+			diff.OnlyInBase = append(diff.OnlyInBase, base[i])
+		}
+	}
+	for i, entry := range compared {
+		if !baseKeys[entryKey(entry)] {
+			diff.OnlyInCompared = append(diff.OnlyInCompared, compared[i])
+		}
+	}
+	return diff
+}
+
+func entryKeys[T any](entries []T) map[string]bool {
+	keys := map[string]bool{}
+	for _, entry := range entries {
+		keys[entryKey(entry)] = true
+	}
+	return keys
+}
+
+func entryKey[T any](entry T) string {
+	// The decision types only hold strings and string slices, so marshalling cannot fail
+	key, _ := json.Marshal(entry)
+	return string(key)
+}
+
+func sortByKey[T any](entries []T, key func(T) string) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return key(entries[i]) < key(entries[j])
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func TestRecordingCacheDecisions(t *testing.T) {
+	job := podgroup_info.NewPodGroupInfo("pg-1")
+	job.Name = "pg-1"
+	job.Namespace = "ns"
+	job.Queue = "queue-1"
+
+	boundTask := newTask("bound", job.UID, pod_status.Binding)
+	pipelinedTask := newTask("pipelined", job.UID, pod_status.Pipelined)
+	pipelinedTask.NodeName = "node-2"
+	reallocatedTask := newTask("reallocated", job.UID, pod_status.Allocated)
+
+	ssn := &framework.Session{
+		ClusterInfo: &api.ClusterInfo{
+			PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job},
+		},
+	}
+
+	recordingCache := NewRecordingCache(nil)
+	assert.NoError(t, recordingCache.Bind(boundTask, "node-1", nil, nil))
+	recordingCache.TaskPipelined(pipelinedTask, "")
+	recordingCache.TaskPipelined(reallocatedTask, "")
+	victim := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "victim", Namespace: "ns"},
+		Spec:       v1.PodSpec{NodeName: "node-3"},
+	}
+	assert.NoError(t, recordingCache.Evict(victim, job, eviction_info.EvictionMetadata{
+		Action:    "reclaim",
+		Preemptor: &types.NamespacedName{Namespace: "ns", Name: "preemptor"},
+	}, "evicted"))
+	job.AddSimpleJobFitError("reason", "job does not fit")
+
+	record := recordingCache.Decisions(ssn)
+	assert.Equal(t, []BindDecision{{Namespace: "ns", Name: "bound", PodGroup: "pg-1", Node: "node-1"}},
+		record.BindRequests)
+	assert.Equal(t, []PipelineDecision{{Namespace: "ns", Name: "pipelined", PodGroup: "pg-1", Node: "node-2"}},
+		record.Pipelined)
+	assert.Equal(t, []EvictionDecision{{
+		Namespace: "ns", Name: "victim", PodGroup: "pg-1", Queue: "queue-1", Node: "node-3",
+		Action: "reclaim", Preemptor: "ns/preemptor",
+	}}, record.Evictions)
+	assert.Equal(t, []string{"job does not fit"}, record.UnschedulableReasons["ns/pg-1"])
+}
+
+func TestDiffDecisions(t *testing.T) {
+	tests := []struct {
+		name          string
+		base          *DecisionRecord
+		compared      *DecisionRecord
+		expectedDiff  *DecisionsDiff
+		expectedEmpty bool
+	}{
+		{
+			name: "identical records",
+			base: &DecisionRecord{
+				BindRequests:         []BindDecision{{Namespace: "ns", Name: "p1", PodGroup: "pg", Node: "n1"}},
+				UnschedulableReasons: map[string][]string{"ns/pg2": {"reason"}},
+			},
+			compared: &DecisionRecord{
+				BindRequests:         []BindDecision{{Namespace: "ns", Name: "p1", PodGroup: "pg", Node: "n1"}},
+				UnschedulableReasons: map[string][]string{"ns/pg2": {"reason"}},
+			},
+			expectedDiff:  &DecisionsDiff{UnschedulableReasons: map[string]ReasonsDiff{}},
+			expectedEmpty: true,
+		},
+		{
+			name: "pod bound to a different node",
+			base: &DecisionRecord{
+				BindRequests: []BindDecision{{Namespace: "ns", Name: "p1", PodGroup: "pg", Node: "n1"}},
+			},
+			compared: &DecisionRecord{
+				BindRequests: []BindDecision{{Namespace: "ns", Name: "p1", PodGroup: "pg", Node: "n2"}},
+			},
+			expectedDiff: &DecisionsDiff{
+				BindRequests: EntriesDiff[BindDecision]{
+					OnlyInBase:     []BindDecision{{Namespace: "ns", Name: "p1", PodGroup: "pg", Node: "n1"}},
+					OnlyInCompared: []BindDecision{{Namespace: "ns", Name: "p1", PodGroup: "pg", Node: "n2"}},
+				},
+				UnschedulableReasons: map[string]ReasonsDiff{},
+			},
+		},
+		{
+			name: "eviction and unschedulable reasons only in compared",
+			base: &DecisionRecord{
+				UnschedulableReasons: map[string][]string{"ns/pg": {"base reason"}},
+			},
+			compared: &DecisionRecord{
+				Evictions:            []EvictionDecision{{Namespace: "ns", Name: "v1", PodGroup: "victim", Action: "preempt"}},
+				UnschedulableReasons: map[string][]string{"ns/pg": {"compared reason"}, "ns/pg2": {"reason"}},
+			},
+			expectedDiff: &DecisionsDiff{
+				Evictions: EntriesDiff[EvictionDecision]{
+					OnlyInCompared: []EvictionDecision{{Namespace: "ns", Name: "v1", PodGroup: "victim", Action: "preempt"}},
+				},
+				UnschedulableReasons: map[string]ReasonsDiff{
+					"ns/pg":  {Base: []string{"base reason"}, Compared: []string{"compared reason"}},
+					"ns/pg2": {Compared: []string{"reason"}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := DiffDecisions(tt.base, tt.compared)
+			assert.Equal(t, tt.expectedDiff, diff)
+			assert.Equal(t, tt.expectedEmpty, diff.IsEmpty())
+		})
+	}
+}
+
+func newTask(name string, jobID common_info.PodGroupID, status pod_status.PodStatus) *pod_info.PodInfo {
+	task := pod_info.NewTaskInfo(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID(name)},
+	})
+	task.Job = jobID
+	task.Status = status
+	return task
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"os"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	version "k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	kaischedulerfake "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// NewCache creates a scheduler cache backed by fake clients that hold the snapshot's objects,
+// and waits for it to sync. The cache informers stop when stopCh is closed.
+func NewCache(snapshot *Snapshot, stopCh <-chan struct{}) cache.Cache {
+	kubeClient, kaiClient := NewClients(snapshot.RawObjects, snapshot.Discovery)
+
+	schedulerCacheParams := &cache.SchedulerCacheParams{
+		KubeClient:                  kubeClient,
+		KAISchedulerClient:          kaiClient,
+		SchedulerName:               snapshot.SchedulerParams.SchedulerName,
+		NodePoolParams:              snapshot.SchedulerParams.PartitionParams,
+		RestrictNodeScheduling:      snapshot.SchedulerParams.RestrictSchedulingNodes,
+		DetailedFitErrors:           snapshot.SchedulerParams.DetailedFitErrors,
+		ScheduleCSIStorage:          snapshot.SchedulerParams.ScheduleCSIStorage,
+		FullHierarchyFairness:       snapshot.SchedulerParams.FullHierarchyFairness,
+		AllowConsolidatingReclaim:   snapshot.SchedulerParams.AllowConsolidatingReclaim,
+		NumOfStatusRecordingWorkers: snapshot.SchedulerParams.NumOfStatusRecordingWorkers,
+		DiscoveryClient:             kubeClient.Discovery(),
+	}
+
+	schedulerCache := cache.New(schedulerCacheParams)
+	schedulerCache.Run(stopCh)
+	schedulerCache.WaitForCacheSync(stopCh)
+
+	return schedulerCache
+}
+
+// LoadSnapshot reads a snapshot from a zip file as served by the get-snapshot endpoint.
+func LoadSnapshot(filename string) (*Snapshot, error) {
+	zipFile, err := zip.OpenReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer zipFile.Close()
+
+	for _, file := range zipFile.File {
+		if file.Name == SnapshotFileName {
+			jsonFile, err := file.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer jsonFile.Close()
+
+			var snapshot Snapshot
+			err = json.NewDecoder(jsonFile).Decode(&snapshot)
+			if err != nil {
+				return nil, err
+			}
+
+			return &snapshot, nil
+		}
+	}
+
+	return nil, os.ErrNotExist
+}
+
+// NewClients creates fake clients populated with the snapshot's raw objects.
+func NewClients(rawObjects *RawKubernetesObjects, discoverySnapshot *DiscoverySnapshot) (*fake.Clientset, *kaischedulerfake.Clientset) {
+	kubeClient := fake.NewSimpleClientset()
+	kaiClient := kaischedulerfake.NewSimpleClientset()
+	applyDiscoverySnapshot(kubeClient, discoverySnapshot)
+
+	for _, pod := range rawObjects.Pods {
+		_, err := kubeClient.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create pod: %v", err)
+		}
+	}
+
+	for _, node := range rawObjects.Nodes {
+		_, err := kubeClient.CoreV1().Nodes().Create(context.TODO(), node, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create node: %v", err)
+		}
+	}
+
+	for _, bindRequest := range rawObjects.BindRequests {
+		_, err := kaiClient.SchedulingV1alpha2().BindRequests(bindRequest.Namespace).Create(context.TODO(), bindRequest, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create bind request: %v", err)
+		}
+	}
+
+	for _, podGroup := range rawObjects.PodGroups {
+		_, err := kaiClient.SchedulingV2alpha2().PodGroups(podGroup.Namespace).Create(context.TODO(), podGroup, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create pod group: %v", err)
+		}
+	}
+
+	for _, queue := range rawObjects.Queues {
+		_, err := kaiClient.SchedulingV2().Queues(queue.Namespace).Create(context.TODO(), queue, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create queue: %v", err)
+		}
+	}
+
+	for _, priorityClass := range rawObjects.PriorityClasses {
+		_, err := kubeClient.SchedulingV1().PriorityClasses().Create(context.TODO(), priorityClass, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create priority class: %v", err)
+		}
+	}
+
+	for _, configMap := range rawObjects.ConfigMaps {
+		_, err := kubeClient.CoreV1().ConfigMaps(configMap.Namespace).Create(context.TODO(), configMap, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create config map: %v", err)
+		}
+	}
+
+	for _, persistentVolumeClaim := range rawObjects.PersistentVolumeClaims {
+		_, err := kubeClient.CoreV1().PersistentVolumeClaims(persistentVolumeClaim.Namespace).Create(context.TODO(), persistentVolumeClaim, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create persistent volume claim: %v", err)
+		}
+	}
+
+	for _, csiStorageCapacity := range rawObjects.CSIStorageCapacities {
+		_, err := kubeClient.StorageV1().CSIStorageCapacities(csiStorageCapacity.Namespace).Create(context.TODO(), csiStorageCapacity, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create CSI storage capacity: %v", err)
+		}
+	}
+
+	for _, storageClass := range rawObjects.StorageClasses {
+		_, err := kubeClient.StorageV1().StorageClasses().Create(context.TODO(), storageClass, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create storage class: %v", err)
+		}
+	}
+
+	for _, csiDriver := range rawObjects.CSIDrivers {
+		_, err := kubeClient.StorageV1().CSIDrivers().Create(context.TODO(), csiDriver, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create CSI driver: %v", err)
+		}
+	}
+
+	for _, topology := range rawObjects.Topologies {
+		_, err := kaiClient.KaiV1alpha1().Topologies().Create(context.TODO(), topology, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create topology: %v", err)
+		}
+	}
+
+	for _, resourceClaim := range rawObjects.ResourceClaims {
+		_, err := kubeClient.ResourceV1().ResourceClaims(resourceClaim.Namespace).Create(context.TODO(), resourceClaim, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create resource claim: %v", err)
+		}
+	}
+
+	for _, resourceSlice := range rawObjects.ResourceSlices {
+		_, err := kubeClient.ResourceV1().ResourceSlices().Create(context.TODO(), resourceSlice, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create resource slice: %v", err)
+		}
+	}
+
+	for _, deviceClass := range rawObjects.DeviceClasses {
+		_, err := kubeClient.ResourceV1().DeviceClasses().Create(context.TODO(), deviceClass, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create device class: %v", err)
+		}
+	}
+
+	return kubeClient, kaiClient
+}
+
+func applyDiscoverySnapshot(kubeClient *fake.Clientset, discoverySnapshot *DiscoverySnapshot) {
+	if kubeClient == nil || discoverySnapshot == nil {
+		return
+	}
+
+	fakeDiscoveryClient, ok := kubeClient.Discovery().(*fakediscovery.FakeDiscovery)
+	if !ok {
+		return
+	}
+
+	if discoverySnapshot.ServerVersion != nil {
+		fakeDiscoveryClient.FakedServerVersion = &version.Info{
+			Major: discoverySnapshot.ServerVersion.Major,
+			Minor: discoverySnapshot.ServerVersion.Minor,
+		}
+	}
+	if discoverySnapshot.Resources != nil {
+		kubeClient.Resources = discoverySnapshot.Resources
+	}
+}
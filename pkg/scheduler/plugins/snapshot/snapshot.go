@@ -82,10 +82,42 @@ func (sp *snapshotPlugin) OnSessionOpen(ssn *framework.Session) {
 func (sp *snapshotPlugin) OnSessionClose(ssn *framework.Session) {}
 
 func (sp *snapshotPlugin) serveSnapshot(writer http.ResponseWriter, request *http.Request) {
+	snapshotAndConfig := TakeSnapshot(request.Context(), sp.session)
+	jsonBytes, err := json.Marshal(snapshotAndConfig)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Disposition", "attachment; filename=snapshot.zip")
+	writer.Header().Set("Content-Type", "application/zip")
+
+	zipWriter := zip.NewWriter(writer)
+	jsonWriter, err := zipWriter.Create(SnapshotFileName)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	_, err = io.Copy(jsonWriter, strings.NewReader(string(jsonBytes)))
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = zipWriter.Close()
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// TakeSnapshot collects the scheduler configuration and the raw Kubernetes objects the session's cache was built from.
+func TakeSnapshot(ctx context.Context, ssn *framework.Session) *Snapshot {
 	rawObjects := &RawKubernetesObjects{}
 	var err error
 
-	dataLister := sp.session.Cache.GetDataLister()
+	dataLister := ssn.Cache.GetDataLister()
 
 	rawObjects.Pods, err = dataLister.ListPods()
 	if err != nil {
@@ -159,7 +191,7 @@ func (sp *snapshotPlugin) serveSnapshot(writer http.ResponseWriter, request *htt
 		rawObjects.Topologies = []*kaiv1alpha1.Topology{}
 	}
 
-	fwork := sp.session.InternalK8sPlugins().FrameworkHandle
+	fwork := ssn.InternalK8sPlugins().FrameworkHandle
 
 	rawObjects.ResourceClaims, err = fwork.SharedDRAManager().ResourceClaims().List()
 	if err != nil {
@@ -180,8 +212,8 @@ func (sp *snapshotPlugin) serveSnapshot(writer http.ResponseWriter, request *htt
 	}
 
 	discoverySnapshot := &DiscoverySnapshot{}
-	discoveryClient := sp.session.Cache.KubeClient().Discovery()
-	discoverySnapshot.ServerVersion, err = getServerVersion(request.Context(), discoveryClient)
+	discoveryClient := ssn.Cache.KubeClient().Discovery()
+	discoverySnapshot.ServerVersion, err = getServerVersion(ctx, discoveryClient)
 	if err != nil {
 		log.InfraLogger.V(2).Warnf("Failed to snapshot server version: %v", err)
 		discoverySnapshot.ServerVersion = nil
@@ -193,39 +225,12 @@ func (sp *snapshotPlugin) serveSnapshot(writer http.ResponseWriter, request *htt
 		discoverySnapshot.Resources = nil
 	}
 
-	snapshotAndConfig := Snapshot{
-		Config:          sp.session.Config,
-		SchedulerParams: &sp.session.SchedulerParams,
+	return &Snapshot{
+		Config:          ssn.Config,
+		SchedulerParams: &ssn.SchedulerParams,
 		RawObjects:      rawObjects,
 		Discovery:       discoverySnapshot,
 	}
-	jsonBytes, err := json.Marshal(snapshotAndConfig)
-	if err != nil {
-		http.Error(writer, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	writer.Header().Set("Content-Disposition", "attachment; filename=snapshot.zip")
-	writer.Header().Set("Content-Type", "application/zip")
-
-	zipWriter := zip.NewWriter(writer)
-	jsonWriter, err := zipWriter.Create(SnapshotFileName)
-	if err != nil {
-		http.Error(writer, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	_, err = io.Copy(jsonWriter, strings.NewReader(string(jsonBytes)))
-	if err != nil {
-		http.Error(writer, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	err = zipWriter.Close()
-	if err != nil {
-		http.Error(writer, err.Error(), http.StatusInternalServerError)
-		return
-	}
 }
 
 func New(_ framework.PluginArguments) framework.Plugin {
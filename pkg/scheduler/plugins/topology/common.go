@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	commontopology "github.com/NVIDIA/KAI-scheduler/pkg/common/topology"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/topology_info"
 )
@@ -66,12 +67,7 @@ func lowestCommonDomainID(nodeSet node_info.NodeSet, levels []kaiv1alpha1.Topolo
 	return DomainID(strings.Join(domainParts, ".")), DomainLevel(levels[len(domainParts)-1].NodeLabel), validNodes
 }
 
-// For a given node to be part of the topology correctly, it must have a label for each level of the topology. TODO make this common
+// For a given node to be part of the topology correctly, it must have a label for each level of the topology.
 func isNodePartOfTopology(nodeInfo *node_info.NodeInfo, levels []kaiv1alpha1.TopologyLevel) bool {
-	for _, level := range levels {
-		if _, found := nodeInfo.Node.Labels[level.NodeLabel]; !found {
-			return false
-		}
-	}
-	return true
+	return commontopology.IsNodePartOfTopology(levels, nodeInfo.Node.Labels)
 }
@@ -45,6 +45,10 @@ func (t *topologyPlugin) subSetNodesFn(
 	if topologyTree == nil || len(tasks) == 0 {
 		return []node_info.NodeSet{nodeSet}, nil
 	}
+	if err := validateRequiredLevelLadder(subGroup, topologyTree); err != nil {
+		job.AddSimpleJobFitError(podgroup_info.PodSchedulingErrors, err.Error())
+		return []node_info.NodeSet{}, nil
+	}
 
 	id, level, validNodes := lowestCommonDomainID(nodeSet, topologyTree.TopologyResource.Spec.Levels, subGroup.GetTopologyConstraint())
 	domain, ok := topologyTree.DomainsByLevel[level][id]
@@ -85,7 +89,15 @@ func (t *topologyPlugin) subSetNodesFn(
 		maxDepthLevel = requiredLevel
 	}
 	sortTreeFromRoot(tasks, domain, maxDepthLevel)
-	if preferredLevel != "" {
+	costs := getLevelCosts(topologyTree)
+	var nodeScores map[string]float64
+	hasPlacementCostScores := false
+	if costs != nil {
+		nodeScores, hasPlacementCostScores = calculatePlacementCostNodeScores(domain, tasksCount, costs)
+	}
+	if hasPlacementCostScores {
+		t.subGroupNodeScores[subGroup.GetName()] = nodeScores
+	} else if preferredLevel != "" {
 		t.subGroupNodeScores[subGroup.GetName()] = calculateNodeScores(domain, preferredLevel)
 	}
 
@@ -95,6 +107,10 @@ func (t *topologyPlugin) subSetNodesFn(
 	}
 
 	jobAllocatableDomains = sortDomainInfos(topologyTree, jobAllocatableDomains)
+	if costs != nil {
+		jobAllocatableDomains = sortDomainsByPlacementCost(jobAllocatableDomains, tasksCount, costs)
+	}
+	jobAllocatableDomains = sortDomainsByLadderLevel(jobAllocatableDomains, subGroup.GetTopologyConstraint().LadderLevels)
 
 	var domainNodeSets []node_info.NodeSet
 	for _, jobAllocatableDomain := range jobAllocatableDomains {
@@ -270,6 +286,7 @@ func (t *topologyPlugin) getJobAllocatableDomains(
 	if err != nil {
 		return nil, err
 	}
+	relevantLevels = filterLadderLevels(relevantLevels, subGroup.GetTopologyConstraint().LadderLevels)
 
 	// Validate that the domains do not clash with the chosen domain for active pods of the job
 	var relevantDomainsByLevel domainsByLevel
@@ -0,0 +1,179 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// applyRequiredLevelFallbacks widens the required level of every sub-group with a fallback ladder to the widest level
+// that its pod group has waited for. The allocatable domains of the sub-group are then limited to the levels of the
+// ladder up to that level, and tried level by level from the narrowest to the widest (see filterLadderLevels and
+// sortDomainsByLadderLevel).
+func applyRequiredLevelFallbacks(jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo, now time.Time) {
+	for _, job := range jobs {
+		pendingFor := now.Sub(job.GetPendingSince())
+		forEachSubGroup(job.RootSubGroupSet, func(subGroup *subgroup_info.SubGroupInfo, _ map[string]*subgroup_info.PodSet) {
+			topologyConstraint := subGroup.GetTopologyConstraint()
+			if len(topologyConstraint.GetRequiredLevelLadder()) < 2 {
+				return
+			}
+			fallbackConstraint := topologyConstraint.WithFallbacks(pendingFor)
+			if fallbackConstraint == topologyConstraint {
+				return
+			}
+			log.InfraLogger.V(4).Infof(
+				"Job <%s/%s>, sub-group %s has been pending for %s, falling back from required topology level %s to %s",
+				job.Namespace, job.Name, subGroup.GetName(), pendingFor, topologyConstraint.RequiredLevel,
+				fallbackConstraint.RequiredLevel)
+			subGroup.SetTopologyConstraint(fallbackConstraint)
+		})
+	}
+}
+
+// validateRequiredLevelLadder returns an error if a level of the sub-group's fallback ladder is not a level of the
+// topology, or is not wider than the level before it in the ladder.
+func validateRequiredLevelLadder(subGroup *subgroup_info.SubGroupInfo, topologyTree *Info) error {
+	ladder := subGroup.GetTopologyConstraint().GetRequiredLevelLadder()
+	if len(ladder) < 2 {
+		return nil
+	}
+
+	// The levels of the topology are listed from the widest to the narrowest
+	levelIndexes := map[string]int{}
+	for index, level := range topologyTree.TopologyResource.Spec.Levels {
+		levelIndexes[level.NodeLabel] = index
+	}
+	previousIndex, found := levelIndexes[ladder[0]]
+	if !found {
+		return newTopologyConstraintConfigError(subGroup, topologyTree, "required", DomainLevel(ladder[0]))
+	}
+	for i := 1; i < len(ladder); i++ {
+		index, found := levelIndexes[ladder[i]]
+		if !found {
+			return newTopologyConstraintConfigError(subGroup, topologyTree, "fallback", DomainLevel(ladder[i]))
+		}
+		if index >= previousIndex {
+			return fmt.Errorf("topology constraint error: the fallback topology level '%s' is not wider than the "+
+				"level '%s' before it in the topology tree '%s'", ladder[i], ladder[i-1], topologyTree.Name)
+		}
+		previousIndex = index
+	}
+	return nil
+}
+
+// recordPlacedTopologyLevels records, for the pod groups that were scheduled in the session, the narrowest level of
+// each fallback ladder that the sub-group's pods were placed within.
+func (t *topologyPlugin) recordPlacedTopologyLevels(jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo) {
+	for _, job := range jobs {
+		if len(job.PodStatusIndex[pod_status.Allocated]) == 0 || !job.IsGangSatisfied() {
+			continue
+		}
+		forEachSubGroup(job.RootSubGroupSet, func(
+			subGroup *subgroup_info.SubGroupInfo, podSets map[string]*subgroup_info.PodSet) {
+			topologyConstraint := subGroup.GetTopologyConstraint()
+			ladder := topologyConstraint.GetRequiredLevelLadder()
+			if len(ladder) < 2 {
+				return
+			}
+			topologyTree := t.TopologyTrees[topologyConstraint.Topology]
+			if topologyTree == nil {
+				return
+			}
+
+			nodeNames := getActiveAllocatedNodeNames(podSets)
+			for _, level := range ladder {
+				if !isPlacedWithinLevel(topologyTree, DomainLevel(level), nodeNames) {
+					continue
+				}
+				if job.PlacedTopologyLevels == nil {
+					job.PlacedTopologyLevels = map[string]string{}
+				}
+				job.PlacedTopologyLevels[subGroup.GetName()] = level
+				return
+			}
+		})
+	}
+}
+
+// filterLadderLevels keeps the relevant levels that are levels of the fallback ladder, skipping the levels of the
+// topology that lie between two levels of the ladder. The relevant levels are kept as is if no fallback was reached.
+func filterLadderLevels(relevantLevels []DomainLevel, ladderLevels []string) []DomainLevel {
+	if len(ladderLevels) == 0 {
+		return relevantLevels
+	}
+	var filteredLevels []DomainLevel
+	for _, level := range relevantLevels {
+		if slices.Contains(ladderLevels, string(level)) {
+			filteredLevels = append(filteredLevels, level)
+		}
+	}
+	return filteredLevels
+}
+
+// sortDomainsByLadderLevel orders the domains by their level's position in the fallback ladder, so that all the
+// domains of a level are tried before those of the next fallback level. The order of the domains within a level is
+// kept.
+func sortDomainsByLadderLevel(domains []*DomainInfo, ladderLevels []string) []*DomainInfo {
+	if len(ladderLevels) == 0 {
+		return domains
+	}
+	sortedDomains := slices.Clone(domains)
+	slices.SortStableFunc(sortedDomains, func(i, j *DomainInfo) int {
+		return cmp.Compare(slices.Index(ladderLevels, string(i.Level)), slices.Index(ladderLevels, string(j.Level)))
+	})
+	return sortedDomains
+}
+
+// forEachSubGroup calls visit for the sub-group set and all its descendant sub-groups, with the pod sets under them.
+func forEachSubGroup(subGroupSet *subgroup_info.SubGroupSet,
+	visit func(subGroup *subgroup_info.SubGroupInfo, podSets map[string]*subgroup_info.PodSet)) {
+	if subGroupSet == nil {
+		return
+	}
+	visit(&subGroupSet.SubGroupInfo, subGroupSet.GetAllPodSets())
+	for _, childGroup := range subGroupSet.GetChildGroups() {
+		forEachSubGroup(childGroup, visit)
+	}
+	for _, podSet := range subGroupSet.GetChildPodSets() {
+		visit(&podSet.SubGroupInfo, map[string]*subgroup_info.PodSet{podSet.GetName(): podSet})
+	}
+}
+
+func getActiveAllocatedNodeNames(podSets map[string]*subgroup_info.PodSet) map[string]bool {
+	nodeNames := map[string]bool{}
+	for _, podSet := range podSets {
+		for _, pod := range podSet.GetPodInfos() {
+			if pod_status.IsActiveAllocatedStatus(pod.Status) {
+				nodeNames[pod.NodeName] = true
+			}
+		}
+	}
+	return nodeNames
+}
+
+func isPlacedWithinLevel(topologyTree *Info, level DomainLevel, nodeNames map[string]bool) bool {
+	for _, domain := range topologyTree.DomainsByLevel[level] {
+		placedWithin := true
+		for nodeName := range nodeNames {
+			if _, found := domain.Nodes[nodeName]; !found {
+				placedWithin = false
+				break
+			}
+		}
+		if placedWithin {
+			return true
+		}
+	}
+	return false
+}
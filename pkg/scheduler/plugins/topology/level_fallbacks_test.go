@@ -0,0 +1,233 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/topology_info"
+)
+
+func TestApplyRequiredLevelFallbacks(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name                   string
+		jobAge                 time.Duration
+		expectedRequiredLevel  string
+		expectedPreferredLevel string
+	}{
+		{
+			name:                  "pending for less than the wait timeout",
+			jobAge:                5 * time.Minute,
+			expectedRequiredLevel: "rack",
+		},
+		{
+			name:                   "pending for longer than the first wait timeout",
+			jobAge:                 15 * time.Minute,
+			expectedRequiredLevel:  "block",
+			expectedPreferredLevel: "rack",
+		},
+		{
+			name:                   "pending for longer than both wait timeouts",
+			jobAge:                 25 * time.Minute,
+			expectedRequiredLevel:  "zone",
+			expectedPreferredLevel: "rack",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := podgroup_info.NewPodGroupInfo("job")
+			job.CreationTimestamp = metav1.NewTime(now.Add(-tt.jobAge))
+			job.RootSubGroupSet.SetTopologyConstraint(testFallbackConstraint())
+
+			applyRequiredLevelFallbacks(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job}, now)
+
+			constraint := job.RootSubGroupSet.GetTopologyConstraint()
+			assert.Equal(t, tt.expectedRequiredLevel, constraint.RequiredLevel)
+			assert.Equal(t, tt.expectedPreferredLevel, constraint.PreferredLevel)
+		})
+	}
+}
+
+func TestValidateRequiredLevelLadder(t *testing.T) {
+	tests := []struct {
+		name        string
+		fallbacks   []topology_info.RequiredLevelFallback
+		expectedErr string
+	}{
+		{
+			name:      "wider fallback levels",
+			fallbacks: testFallbackConstraint().RequiredLevelFallbacks,
+		},
+		{
+			name:        "unknown fallback level",
+			fallbacks:   []topology_info.RequiredLevelFallback{{Level: "datacenter"}},
+			expectedErr: "specified 'datacenter' as the fallback topology constraint level",
+		},
+		{
+			name:        "fallback level narrower than the required level",
+			fallbacks:   []topology_info.RequiredLevelFallback{{Level: "zone"}, {Level: "block"}},
+			expectedErr: "the fallback topology level 'block' is not wider than the level 'zone'",
+		},
+		{
+			name:        "fallback to the required level",
+			fallbacks:   []topology_info.RequiredLevelFallback{{Level: "rack"}},
+			expectedErr: "the fallback topology level 'rack' is not wider than the level 'rack'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint := testFallbackConstraint()
+			constraint.RequiredLevelFallbacks = tt.fallbacks
+			job := podgroup_info.NewPodGroupInfo("job")
+			job.RootSubGroupSet.SetTopologyConstraint(constraint)
+
+			err := validateRequiredLevelLadder(&job.RootSubGroupSet.SubGroupInfo, testFallbackTopologyTree())
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRecordPlacedTopologyLevels(t *testing.T) {
+	tests := []struct {
+		name                 string
+		taskStatus           pod_status.PodStatus
+		taskNodes            []string
+		expectedPlacedLevels map[string]string
+	}{
+		{
+			name:                 "placed within the required level",
+			taskStatus:           pod_status.Allocated,
+			taskNodes:            []string{"node0", "node1"},
+			expectedPlacedLevels: map[string]string{"": "rack"},
+		},
+		{
+			name:                 "placed within the intermediate fallback level",
+			taskStatus:           pod_status.Allocated,
+			taskNodes:            []string{"node0", "node2"},
+			expectedPlacedLevels: map[string]string{"": "block"},
+		},
+		{
+			name:                 "placed within the last fallback level",
+			taskStatus:           pod_status.Allocated,
+			taskNodes:            []string{"node0", "node3"},
+			expectedPlacedLevels: map[string]string{"": "zone"},
+		},
+		{
+			name:                 "not allocated in the session",
+			taskStatus:           pod_status.Running,
+			taskNodes:            []string{"node0", "node2"},
+			expectedPlacedLevels: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tasks []*pod_info.PodInfo
+			for _, nodeName := range tt.taskNodes {
+				tasks = append(tasks, &pod_info.PodInfo{
+					UID:      common_info.PodID(nodeName + "-pod"),
+					Job:      "job",
+					Name:     nodeName + "-pod",
+					NodeName: nodeName,
+					Status:   tt.taskStatus,
+					ResReq:   resource_info.EmptyResourceRequirements(),
+				})
+			}
+			job := podgroup_info.NewPodGroupInfo("job", tasks...)
+			job.RootSubGroupSet.SetTopologyConstraint(testFallbackConstraint().WithFallbacks(time.Hour))
+
+			plugin := &topologyPlugin{TopologyTrees: map[topologyName]*Info{"topology": testFallbackTopologyTree()}}
+			plugin.recordPlacedTopologyLevels(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job})
+
+			assert.Equal(t, tt.expectedPlacedLevels, job.PlacedTopologyLevels)
+		})
+	}
+}
+
+func TestFilterLadderLevels(t *testing.T) {
+	relevantLevels := []DomainLevel{"rack", "row", "block", "zone"}
+	assert.Equal(t, relevantLevels, filterLadderLevels(relevantLevels, nil))
+	assert.Equal(t, []DomainLevel{"rack", "block", "zone"},
+		filterLadderLevels(relevantLevels, []string{"rack", "block", "zone"}))
+}
+
+func TestSortDomainsByLadderLevel(t *testing.T) {
+	zone := NewDomainInfo("zone1", "zone")
+	block := NewDomainInfo("block1", "block")
+	rack1 := NewDomainInfo("rack1", "rack")
+	rack2 := NewDomainInfo("rack2", "rack")
+	domains := []*DomainInfo{zone, rack1, block, rack2}
+
+	assert.Equal(t, domains, sortDomainsByLadderLevel(domains, nil))
+	assert.Equal(t, []*DomainInfo{rack1, rack2, block, zone},
+		sortDomainsByLadderLevel(domains, []string{"rack", "block", "zone"}))
+}
+
+func testFallbackConstraint() *topology_info.TopologyConstraintInfo {
+	return &topology_info.TopologyConstraintInfo{
+		Topology:      "topology",
+		RequiredLevel: "rack",
+		RequiredLevelFallbacks: []topology_info.RequiredLevelFallback{
+			{Level: "block", WaitTimeout: 10 * time.Minute},
+			{Level: "zone", WaitTimeout: 10 * time.Minute},
+		},
+	}
+}
+
+// testFallbackTopologyTree builds a zone with two blocks. block1 holds rack1 (node0 and node1) and rack2 (node2), and
+// block2 holds rack3 (node3).
+func testFallbackTopologyTree() *Info {
+	zone := NewDomainInfo("zone1", "zone")
+	blocks := map[DomainID][]DomainID{"block1": {"rack1", "rack2"}, "block2": {"rack3"}}
+	racks := map[DomainID][]string{"rack1": {"node0", "node1"}, "rack2": {"node2"}, "rack3": {"node3"}}
+	blockDomains := LevelDomainInfos{}
+	rackDomains := LevelDomainInfos{}
+	for blockID, rackIDs := range blocks {
+		block := NewDomainInfo(blockID, "block")
+		for _, rackID := range rackIDs {
+			rack := NewDomainInfo(rackID, "rack")
+			for _, nodeName := range racks[rackID] {
+				node := &node_info.NodeInfo{Name: nodeName}
+				rack.AddNode(node)
+				block.AddNode(node)
+				zone.AddNode(node)
+			}
+			block.AddChild(rack)
+			rackDomains[rackID] = rack
+		}
+		zone.AddChild(block)
+		blockDomains[blockID] = block
+	}
+	return &Info{
+		Name: "topology",
+		TopologyResource: &kaiv1alpha1.Topology{
+			Spec: kaiv1alpha1.TopologySpec{
+				Levels: []kaiv1alpha1.TopologyLevel{{NodeLabel: "zone"}, {NodeLabel: "block"}, {NodeLabel: "rack"}},
+			},
+		},
+		DomainsByLevel: map[DomainLevel]LevelDomainInfos{
+			"zone":  {"zone1": zone},
+			"block": blockDomains,
+			"rack":  rackDomains,
+		},
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+const (
+	maxTopologyScore = 10
+)
+
+// levelCosts maps each topology level to the cost of communication between pods placed in different domains of that
+// level, within the same domain of the level above.
+type levelCosts map[DomainLevel]float64
+
+// placementPlan is the estimated lowest cost placement of a gang in a domain.
+type placementPlan struct {
+	cost float64
+	// leafDomains are the lowest level domains that hold the gang's pods.
+	leafDomains []*DomainInfo
+}
+
+func getLevelCosts(topologyTree *Info) levelCosts {
+	costs := levelCosts{}
+	for _, level := range topologyTree.TopologyResource.Spec.Levels {
+		if level.Cost > 0 {
+			costs[DomainLevel(level.NodeLabel)] = float64(level.Cost)
+		}
+	}
+	if len(costs) == 0 {
+		return nil
+	}
+	return costs
+}
+
+// planPlacement estimates the lowest communication cost placement of tasksCount pods in the domain. At every level the
+// pods are spread over as few child domains as possible, each extra child domain adds the child level's cost (the gang's
+// ring crosses one more boundary of that level). Among the child domains that can hold all the remaining pods, the one
+// with the least allocatable pods is preferred to keep larger domains free.
+// Returns false if the domain cannot hold the pods or its allocatable pods were not calculated.
+func planPlacement(domain *DomainInfo, tasksCount int, costs levelCosts) (*placementPlan, bool) {
+	if domain.AllocatablePods == allocatablePodsNotSet || domain.AllocatablePods < tasksCount {
+		return nil, false
+	}
+	if len(domain.Children) == 0 {
+		return &placementPlan{leafDomains: []*DomainInfo{domain}}, true
+	}
+
+	candidates := slices.Clone(domain.Children)
+	slices.SortStableFunc(candidates, func(i, j *DomainInfo) int {
+		return cmp.Compare(j.AllocatablePods, i.AllocatablePods)
+	})
+
+	plan := &placementPlan{}
+	usedChildren := 0
+	for remainingTasks := tasksCount; remainingTasks > 0; {
+		if len(candidates) == 0 {
+			return nil, false
+		}
+		childIndex := bestFitChild(candidates, remainingTasks)
+		child := candidates[childIndex]
+		if child.AllocatablePods <= 0 {
+			return nil, false
+		}
+		candidates = slices.Delete(candidates, childIndex, childIndex+1)
+
+		childTasks := min(child.AllocatablePods, remainingTasks)
+		childPlan, found := planPlacement(child, childTasks, costs)
+		if !found {
+			return nil, false
+		}
+		plan.cost += childPlan.cost
+		plan.leafDomains = append(plan.leafDomains, childPlan.leafDomains...)
+		remainingTasks -= childTasks
+		usedChildren++
+	}
+	if usedChildren > 1 {
+		plan.cost += float64(usedChildren-1) * costs[domain.Children[0].Level]
+	}
+	return plan, true
+}
+
+// bestFitChild returns the index of the first smallest candidate that can hold all the remaining tasks, or of the
+// largest candidate if none can. Candidates are sorted by allocatable pods in descending order.
+func bestFitChild(candidates []*DomainInfo, remainingTasks int) int {
+	bestFit := 0
+	for index, candidate := range candidates {
+		if candidate.AllocatablePods < remainingTasks {
+			break
+		}
+		if candidate.AllocatablePods < candidates[bestFit].AllocatablePods {
+			bestFit = index
+		}
+	}
+	return bestFit
+}
+
+// sortDomainsByPlacementCost stably orders the domains by the cost of their lowest cost placement of the tasks.
+// Domains whose placement cannot be estimated keep their order after the estimated ones.
+func sortDomainsByPlacementCost(domains []*DomainInfo, tasksCount int, costs levelCosts) []*DomainInfo {
+	domainCosts := make(map[*DomainInfo]float64, len(domains))
+	for _, domain := range domains {
+		if plan, found := planPlacement(domain, tasksCount, costs); found {
+			domainCosts[domain] = plan.cost
+		}
+	}
+
+	sortedDomains := slices.Clone(domains)
+	slices.SortStableFunc(sortedDomains, func(i, j *DomainInfo) int {
+		iCost, iFound := domainCosts[i]
+		jCost, jFound := domainCosts[j]
+		if iFound != jFound {
+			if iFound {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(iCost, jCost)
+	})
+	return sortedDomains
+}
+
+// calculatePlacementCostNodeScores scores the nodes of the domain's lowest cost placement above all other nodes, so that
+// the gang's pods are allocated on them first.
+func calculatePlacementCostNodeScores(domain *DomainInfo, tasksCount int, costs levelCosts) (map[string]float64, bool) {
+	plan, found := planPlacement(domain, tasksCount, costs)
+	if !found {
+		return nil, false
+	}
+
+	nodeScores := make(map[string]float64, len(domain.Nodes))
+	for nodeName := range domain.Nodes {
+		nodeScores[nodeName] = 0
+	}
+	for _, leafDomain := range plan.leafDomains {
+		for nodeName := range leafDomain.Nodes {
+			nodeScores[nodeName] = maxTopologyScore * scores.Topology
+		}
+	}
+	return nodeScores, true
+}
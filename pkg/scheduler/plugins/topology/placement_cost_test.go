@@ -0,0 +1,193 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+func TestGetLevelCosts(t *testing.T) {
+	tests := []struct {
+		name     string
+		levels   []kaiv1alpha1.TopologyLevel
+		expected levelCosts
+	}{
+		{
+			name: "no costs",
+			levels: []kaiv1alpha1.TopologyLevel{
+				{NodeLabel: "zone"},
+				{NodeLabel: "rack"},
+			},
+			expected: nil,
+		},
+		{
+			name: "partial costs",
+			levels: []kaiv1alpha1.TopologyLevel{
+				{NodeLabel: "zone", Cost: 100},
+				{NodeLabel: "rack"},
+				{NodeLabel: "hostname", Cost: 1},
+			},
+			expected: levelCosts{"zone": 100, "hostname": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topologyTree := &Info{
+				TopologyResource: &kaiv1alpha1.Topology{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-topology"},
+					Spec:       kaiv1alpha1.TopologySpec{Levels: tt.levels},
+				},
+			}
+			assert.Equal(t, tt.expected, getLevelCosts(topologyTree))
+		})
+	}
+}
+
+func TestPlanPlacement(t *testing.T) {
+	costs := levelCosts{"zone": 100, "rack": 10}
+	tests := []struct {
+		name               string
+		tasksCount         int
+		domain             *DomainInfo
+		expectedFound      bool
+		expectedCost       float64
+		expectedLeafDomain []DomainID
+	}{
+		{
+			name:               "fits in a single rack - prefer the smallest fitting rack",
+			tasksCount:         2,
+			domain:             testCostZone("zone1", 4, 2, 2),
+			expectedFound:      true,
+			expectedCost:       0,
+			expectedLeafDomain: []DomainID{"zone1-rack1"},
+		},
+		{
+			name:               "fits exactly in the largest rack",
+			tasksCount:         4,
+			domain:             testCostZone("zone1", 4, 2, 2),
+			expectedFound:      true,
+			expectedCost:       0,
+			expectedLeafDomain: []DomainID{"zone1-rack0"},
+		},
+		{
+			name:               "spread over two racks",
+			tasksCount:         6,
+			domain:             testCostZone("zone1", 4, 2, 2),
+			expectedFound:      true,
+			expectedCost:       10,
+			expectedLeafDomain: []DomainID{"zone1-rack0", "zone1-rack1"},
+		},
+		{
+			name:               "spread over three racks",
+			tasksCount:         7,
+			domain:             testCostZone("zone1", 4, 2, 2),
+			expectedFound:      true,
+			expectedCost:       20,
+			expectedLeafDomain: []DomainID{"zone1-rack0", "zone1-rack1", "zone1-rack2"},
+		},
+		{
+			name:       "spread over zones and racks",
+			tasksCount: 6,
+			domain: func() *DomainInfo {
+				root := NewDomainInfo(rootDomainId, rootLevel)
+				root.AllocatablePods = 0
+				for _, zone := range []*DomainInfo{testCostZone("zone1", 3, 1), testCostZone("zone2", 2)} {
+					root.AddChild(zone)
+					root.AllocatablePods += zone.AllocatablePods
+				}
+				return root
+			}(),
+			expectedFound:      true,
+			expectedCost:       110,
+			expectedLeafDomain: []DomainID{"zone1-rack0", "zone1-rack1", "zone2-rack0"},
+		},
+		{
+			name:          "not enough allocatable pods",
+			tasksCount:    9,
+			domain:        testCostZone("zone1", 4, 2, 2),
+			expectedFound: false,
+		},
+		{
+			name:          "allocatable pods not calculated",
+			tasksCount:    1,
+			domain:        NewDomainInfo("zone1", "zone"),
+			expectedFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, found := planPlacement(tt.domain, tt.tasksCount, costs)
+			assert.Equal(t, tt.expectedFound, found)
+			if !tt.expectedFound {
+				return
+			}
+			assert.Equal(t, tt.expectedCost, plan.cost)
+			var leafDomainIDs []DomainID
+			for _, leafDomain := range plan.leafDomains {
+				leafDomainIDs = append(leafDomainIDs, leafDomain.ID)
+			}
+			assert.ElementsMatch(t, tt.expectedLeafDomain, leafDomainIDs)
+		})
+	}
+}
+
+func TestSortDomainsByPlacementCost(t *testing.T) {
+	costs := levelCosts{"rack": 10}
+	spreadZone := testCostZone("zone1", 2, 2)
+	packedZone := testCostZone("zone2", 4)
+	notCalculatedZone := NewDomainInfo("zone3", "zone")
+	smallZone := testCostZone("zone4", 1)
+
+	sortedDomains := sortDomainsByPlacementCost(
+		[]*DomainInfo{notCalculatedZone, spreadZone, smallZone, packedZone}, 3, costs)
+
+	var sortedIDs []DomainID
+	for _, domain := range sortedDomains {
+		sortedIDs = append(sortedIDs, domain.ID)
+	}
+	assert.Equal(t, []DomainID{"zone2", "zone1", "zone3", "zone4"}, sortedIDs)
+}
+
+func TestCalculatePlacementCostNodeScores(t *testing.T) {
+	costs := levelCosts{"rack": 10}
+	zone := testCostZone("zone1", 4, 2, 2)
+
+	nodeScores, found := calculatePlacementCostNodeScores(zone, 6, costs)
+	assert.True(t, found)
+	assert.Equal(t, map[string]float64{
+		"zone1-rack0-node": maxTopologyScore * scores.Topology,
+		"zone1-rack1-node": maxTopologyScore * scores.Topology,
+		"zone1-rack2-node": 0,
+	}, nodeScores)
+
+	_, found = calculatePlacementCostNodeScores(zone, 9, costs)
+	assert.False(t, found)
+}
+
+// testCostZone builds a zone with a rack per allocatable pods count, each rack holding a single node.
+func testCostZone(zoneID DomainID, racksAllocatablePods ...int) *DomainInfo {
+	zone := NewDomainInfo(zoneID, "zone")
+	zone.AllocatablePods = 0
+	for index, allocatablePods := range racksAllocatablePods {
+		rackID := DomainID(fmt.Sprintf("%s-rack%d", zoneID, index))
+		rack := NewDomainInfo(rackID, "rack")
+		rack.AllocatablePods = allocatablePods
+		node := &node_info.NodeInfo{Name: string(rackID) + "-node"}
+		rack.AddNode(node)
+		zone.AddNode(node)
+		zone.AddChild(rack)
+		zone.AllocatablePods += allocatablePods
+	}
+	return zone
+}
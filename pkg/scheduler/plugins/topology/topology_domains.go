@@ -0,0 +1,48 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+// topologyDomainsFn returns the nodes of every domain at the job's required topology level, ordered by domain ID.
+// If some of the job's pods are already placed, only the domains holding them are returned.
+func (t *topologyPlugin) topologyDomainsFn(job *podgroup_info.PodGroupInfo) []node_info.NodeSet {
+	if job.RootSubGroupSet == nil {
+		return nil
+	}
+	subGroup := &job.RootSubGroupSet.SubGroupInfo
+	if subGroup.GetTopologyConstraint() == nil || !hasTopologyRequiredConstraint(subGroup) {
+		return nil
+	}
+	topologyTree, found := t.getJobTopology(subGroup)
+	if !found || topologyTree == nil {
+		return nil
+	}
+
+	podSets := job.RootSubGroupSet.GetAllPodSets()
+	jobHasAllocatedTasks := hasActiveAllocatedTasks(podSets)
+	requiredLevel := DomainLevel(subGroup.GetTopologyConstraint().RequiredLevel)
+	domains := slices.SortedFunc(maps.Values(topologyTree.DomainsByLevel[requiredLevel]), func(i, j *DomainInfo) int {
+		return cmp.Compare(i.ID, j.ID)
+	})
+
+	domainsNodes := []node_info.NodeSet{}
+	for _, domain := range domains {
+		if jobHasAllocatedTasks && !hasActiveJobPodInDomain(podSets, domain) {
+			continue
+		}
+		domainNodes := slices.SortedFunc(maps.Values(domain.Nodes), func(i, j *node_info.NodeInfo) int {
+			return cmp.Compare(i.Name, j.Name)
+		})
+		domainsNodes = append(domainsNodes, domainNodes)
+	}
+	return domainsNodes
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/topology_info"
+)
+
+func TestTopologyPlugin_topologyDomainsFn(t *testing.T) {
+	tests := []struct {
+		name                string
+		topologyConstraint  *topology_info.TopologyConstraintInfo
+		runningTaskNode     string
+		expectedDomainNodes [][]string
+	}{
+		{
+			name:                "no topology constraint",
+			topologyConstraint:  nil,
+			expectedDomainNodes: nil,
+		},
+		{
+			name: "preferred level only",
+			topologyConstraint: &topology_info.TopologyConstraintInfo{
+				Topology:       "topology",
+				PreferredLevel: "rack",
+			},
+			expectedDomainNodes: nil,
+		},
+		{
+			name: "unknown topology",
+			topologyConstraint: &topology_info.TopologyConstraintInfo{
+				Topology:      "other-topology",
+				RequiredLevel: "rack",
+			},
+			expectedDomainNodes: nil,
+		},
+		{
+			name: "all domains of the required level",
+			topologyConstraint: &topology_info.TopologyConstraintInfo{
+				Topology:      "topology",
+				RequiredLevel: "rack",
+			},
+			expectedDomainNodes: [][]string{{"node0", "node1"}, {"node2"}, {"node3"}},
+		},
+		{
+			name: "only the domain of the running task",
+			topologyConstraint: &topology_info.TopologyConstraintInfo{
+				Topology:      "topology",
+				RequiredLevel: "rack",
+			},
+			runningTaskNode:     "node2",
+			expectedDomainNodes: [][]string{{"node2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := []*pod_info.PodInfo{
+				{
+					UID:    "pending-pod",
+					Job:    "job",
+					Name:   "pending-pod",
+					Status: pod_status.Pending,
+					ResReq: resource_info.EmptyResourceRequirements(),
+				},
+			}
+			if tt.runningTaskNode != "" {
+				tasks = append(tasks, &pod_info.PodInfo{
+					UID:      "running-pod",
+					Job:      "job",
+					Name:     "running-pod",
+					NodeName: tt.runningTaskNode,
+					Status:   pod_status.Running,
+					ResReq:   resource_info.EmptyResourceRequirements(),
+				})
+			}
+			job := podgroup_info.NewPodGroupInfo("job", tasks...)
+			job.RootSubGroupSet.SetTopologyConstraint(tt.topologyConstraint)
+
+			plugin := &topologyPlugin{TopologyTrees: map[topologyName]*Info{"topology": testFallbackTopologyTree()}}
+			domains := plugin.topologyDomainsFn(job)
+
+			var domainNodes [][]string
+			for _, domain := range domains {
+				var nodeNames []string
+				for _, node := range domain {
+					nodeNames = append(nodeNames, node.Name)
+				}
+				domainNodes = append(domainNodes, nodeNames)
+			}
+			assert.Equal(t, tt.expectedDomainNodes, domainNodes)
+		})
+	}
+}
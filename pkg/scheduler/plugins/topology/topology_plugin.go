@@ -4,6 +4,8 @@
 package topology
 
 import (
+	"time"
+
 	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
@@ -43,8 +45,10 @@ func (t *topologyPlugin) Name() string {
 func (t *topologyPlugin) OnSessionOpen(ssn *framework.Session) {
 	t.session = ssn
 	t.initializeTopologyTree(ssn.ClusterInfo.Topologies, ssn.ClusterInfo.Nodes)
+	applyRequiredLevelFallbacks(ssn.ClusterInfo.PodGroupInfos, time.Now())
 
 	ssn.AddSubsetNodesFn(t.subSetNodesFn)
+	ssn.AddTopologyDomainsFn(t.topologyDomainsFn)
 	ssn.AddNodeOrderFn(t.nodeOrderFn)
 	ssn.AddPreJobAllocationFn(t.preJobAllocationFn)
 }
@@ -109,4 +113,6 @@ func (*topologyPlugin) addNodeDataToTopology(topologyTree *Info, topology *kaiv1
 	topologyTree.DomainsByLevel[rootLevel][rootDomainId].AddNode(nodeInfo)
 }
 
-func (t *topologyPlugin) OnSessionClose(_ *framework.Session) {}
+func (t *topologyPlugin) OnSessionClose(ssn *framework.Session) {
+	t.recordPlacedTopologyLevels(ssn.ClusterInfo.PodGroupInfos)
+}
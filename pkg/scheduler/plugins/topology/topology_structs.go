@@ -4,9 +4,8 @@
 package topology
 
 import (
-	"strings"
-
 	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	commontopology "github.com/NVIDIA/KAI-scheduler/pkg/common/topology"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
@@ -92,10 +91,5 @@ func (t *DomainInfo) AddChild(child *DomainInfo) {
 }
 
 func calcDomainId(leafLevelIndex int, levels []kaiv1alpha1.TopologyLevel, nodeLabels map[string]string) DomainID {
-	domainsNames := make([]string, leafLevelIndex+1)
-	for levelIndex := leafLevelIndex; levelIndex >= 0; levelIndex-- {
-		levelLabel := levels[levelIndex].NodeLabel
-		domainsNames[levelIndex] = nodeLabels[levelLabel]
-	}
-	return DomainID(strings.Join(domainsNames, "."))
+	return DomainID(commontopology.DomainID(levels, leafLevelIndex, nodeLabels))
 }
@@ -0,0 +1,247 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package whatif
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/snapshot"
+)
+
+const (
+	whatIfPath      = "/what-if"
+	whatIfSessionID = "what-if"
+)
+
+// supportedActions are the actions that may attempt to schedule the hypothetical PodGroup. Stale gang eviction is left
+// out since it does not place it.
+var supportedActions = []framework.ActionType{
+	framework.Allocate,
+	framework.Consolidation,
+	framework.Reclaim,
+	framework.Preempt,
+}
+
+// PodTemplate describes a number of identical pods of the hypothetical PodGroup.
+type PodTemplate struct {
+	Replicas int32              `json:"replicas"`
+	Template v1.PodTemplateSpec `json:"template"`
+}
+
+// Request is the body of a what-if request.
+type Request struct {
+	PodGroup     *enginev2alpha2.PodGroup `json:"podGroup"`
+	PodTemplates []PodTemplate            `json:"podTemplates"`
+	// Actions limits the actions that attempt to schedule the PodGroup. Defaults to the configured allocate,
+	// consolidation, reclaim and preempt actions, in their configured order.
+	Actions []string `json:"actions,omitempty"`
+}
+
+// Result describes what the scheduler would do if the PodGroup were submitted.
+type Result struct {
+	PodGroup string `json:"podGroup"`
+	common.JobEvaluation
+	// UnschedulableReasons are the reasons the PodGroup could not be scheduled, if any.
+	UnschedulableReasons []string `json:"unschedulableReasons,omitempty"`
+}
+
+type whatIfPlugin struct {
+	session *framework.Session
+	// evaluationMutex allows a single what-if evaluation at a time, each evaluation builds its own cache.
+	evaluationMutex sync.Mutex
+}
+
+func New(_ framework.PluginArguments) framework.Plugin {
+	return &whatIfPlugin{}
+}
+
+func (wp *whatIfPlugin) Name() string {
+	return "whatif"
+}
+
+func (wp *whatIfPlugin) OnSessionOpen(ssn *framework.Session) {
+	wp.session = ssn
+	log.InfraLogger.V(3).Info("What-if plugin registering what-if")
+	ssn.AddHttpHandler(whatIfPath, wp.serveWhatIf)
+}
+
+func (wp *whatIfPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (wp *whatIfPlugin) serveWhatIf(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "what-if requests must use POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	whatIfRequest := &Request{}
+	if err := json.NewDecoder(request.Body).Decode(whatIfRequest); err != nil {
+		http.Error(writer, fmt.Sprintf("failed to decode what-if request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	actions, err := actionsToRun(wp.session, whatIfRequest.Actions)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	clusterSnapshot := snapshot.TakeSnapshot(request.Context(), wp.session)
+	if err = addHypotheticalPodGroup(clusterSnapshot, whatIfRequest); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	wp.evaluationMutex.Lock()
+	result, err := evaluate(clusterSnapshot, whatIfRequest.PodGroup, actions)
+	wp.evaluationMutex.Unlock()
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(writer)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(result); err != nil {
+		http.Error(writer, "Failed to encode what-if result", http.StatusInternalServerError)
+	}
+}
+
+func actionsToRun(ssn *framework.Session, requestedActions []string) ([]framework.JobAction, error) {
+	var actionNames []string
+	if len(requestedActions) > 0 {
+		actionNames = requestedActions
+	} else {
+		actionNames = strings.Split(ssn.Config.Actions, ",")
+	}
+
+	var actions []framework.JobAction
+	for _, actionName := range actionNames {
+		actionType := framework.ActionType(strings.TrimSpace(actionName))
+		if !slices.Contains(supportedActions, actionType) {
+			if len(requestedActions) > 0 {
+				return nil, fmt.Errorf("action %s is not supported in what-if requests", actionType)
+			}
+			continue
+		}
+
+		action, found := framework.GetAction(string(actionType))
+		if !found {
+			return nil, fmt.Errorf("failed to find action %s", actionType)
+		}
+		jobAction, ok := action.(framework.JobAction)
+		if !ok {
+			return nil, fmt.Errorf("action %s cannot evaluate a single podGroup", actionType)
+		}
+		actions = append(actions, jobAction)
+	}
+	return actions, nil
+}
+
+func addHypotheticalPodGroup(clusterSnapshot *snapshot.Snapshot, whatIfRequest *Request) error {
+	podGroup := whatIfRequest.PodGroup
+	if podGroup == nil || len(podGroup.Name) == 0 {
+		return fmt.Errorf("what-if request must contain a named podGroup")
+	}
+	if len(whatIfRequest.PodTemplates) == 0 {
+		return fmt.Errorf("what-if request must contain at least one pod template")
+	}
+	for _, existingPodGroup := range clusterSnapshot.RawObjects.PodGroups {
+		if existingPodGroup.Name == podGroup.Name {
+			return fmt.Errorf("podGroup %s already exists", podGroup.Name)
+		}
+	}
+
+	podGroup = podGroup.DeepCopy()
+	if len(podGroup.Namespace) == 0 {
+		podGroup.Namespace = metav1.NamespaceDefault
+	}
+	podGroup.UID = types.UID(whatIfSessionID + "-" + podGroup.Name)
+	podGroup.Status = enginev2alpha2.PodGroupStatus{}
+	setNodePoolLabel(&podGroup.ObjectMeta, clusterSnapshot)
+	whatIfRequest.PodGroup = podGroup
+	clusterSnapshot.RawObjects.PodGroups = append(clusterSnapshot.RawObjects.PodGroups, podGroup)
+
+	for templateIndex, podTemplate := range whatIfRequest.PodTemplates {
+		for replica := range podTemplate.Replicas {
+			pod := &v1.Pod{
+				ObjectMeta: *podTemplate.Template.ObjectMeta.DeepCopy(),
+				Spec:       *podTemplate.Template.Spec.DeepCopy(),
+			}
+			pod.Name = fmt.Sprintf("%s-%d-%d", podGroup.Name, templateIndex, replica)
+			pod.Namespace = podGroup.Namespace
+			pod.UID = types.UID(whatIfSessionID + "-" + pod.Namespace + "-" + pod.Name)
+			pod.CreationTimestamp = metav1.Now()
+			if pod.Annotations == nil {
+				pod.Annotations = map[string]string{}
+			}
+			pod.Annotations[commonconstants.PodGroupAnnotationForPod] = podGroup.Name
+			pod.Spec.SchedulerName = clusterSnapshot.SchedulerParams.SchedulerName
+			pod.Spec.NodeName = ""
+			pod.Status = v1.PodStatus{Phase: v1.PodPending}
+			setNodePoolLabel(&pod.ObjectMeta, clusterSnapshot)
+
+			clusterSnapshot.RawObjects.Pods = append(clusterSnapshot.RawObjects.Pods, pod)
+		}
+	}
+
+	return nil
+}
+
+func setNodePoolLabel(objectMeta *metav1.ObjectMeta, clusterSnapshot *snapshot.Snapshot) {
+	partitionParams := clusterSnapshot.SchedulerParams.PartitionParams
+	if partitionParams == nil || len(partitionParams.NodePoolLabelKey) == 0 {
+		return
+	}
+	if objectMeta.Labels == nil {
+		objectMeta.Labels = map[string]string{}
+	}
+	if _, found := objectMeta.Labels[partitionParams.NodePoolLabelKey]; !found {
+		objectMeta.Labels[partitionParams.NodePoolLabelKey] = partitionParams.NodePoolLabelValue
+	}
+}
+
+// evaluate opens a session on a cache built from the snapshot, so the evaluation does not race with the scheduling
+// cycle, and attempts to schedule the hypothetical PodGroup with the actions in a statement that is discarded.
+func evaluate(clusterSnapshot *snapshot.Snapshot, podGroup *enginev2alpha2.PodGroup,
+	actions []framework.JobAction) (*Result, error) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	ssn, err := framework.OpenSession(snapshot.NewCache(clusterSnapshot, stopCh), clusterSnapshot.Config,
+		clusterSnapshot.SchedulerParams, whatIfSessionID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open what-if session: %w", err)
+	}
+	defer framework.CloseSession(ssn)
+
+	job, found := ssn.ClusterInfo.PodGroupInfos[common_info.PodGroupID(podGroup.Name)]
+	if !found {
+		return nil, fmt.Errorf("podGroup %s was not loaded into the what-if session", podGroup.Name)
+	}
+
+	result := &Result{
+		PodGroup:      job.Name,
+		JobEvaluation: *common.EvaluateJob(ssn, job, actions),
+	}
+	if len(result.Action) == 0 {
+		result.UnschedulableReasons = common.UnschedulableReasons(job)
+	}
+	return result, nil
+}
@@ -0,0 +1,303 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package whatif
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	kubeaischedulerver "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/fake"
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/allocate"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/preempt"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/predicates"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/priority"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion"
+)
+
+const (
+	schedulerName     = "test-scheduler"
+	lowPriorityClass  = "low"
+	highPriorityClass = "high"
+)
+
+func TestWhatIf(t *testing.T) {
+	framework.RegisterAction(allocate.New())
+	framework.RegisterAction(preempt.New())
+	framework.RegisterPluginBuilder("predicates", predicates.New)
+	framework.RegisterPluginBuilder("priority", priority.New)
+	framework.RegisterPluginBuilder("proportion", proportion.New)
+	framework.RegisterPluginBuilder("whatif", New)
+
+	session := newTestSession(t)
+	plugin := New(nil).(*whatIfPlugin)
+	plugin.OnSessionOpen(session)
+
+	tests := []struct {
+		name               string
+		method             string
+		request            *Request
+		expectedStatusCode int
+		expectedScheduled  bool
+		expectedPipelined  bool
+		expectedPlacements int
+		expectedVictims    []string
+	}{
+		{
+			name:   "podgroup fits",
+			method: http.MethodPost,
+			request: &Request{
+				PodGroup:     newPodGroup("fits", 2),
+				PodTemplates: []PodTemplate{newPodTemplate(2, 1)},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedScheduled:  true,
+			expectedPlacements: 2,
+		},
+		{
+			name:   "podgroup does not fit",
+			method: http.MethodPost,
+			request: &Request{
+				PodGroup:     newPodGroup("too-big", 2),
+				PodTemplates: []PodTemplate{newPodTemplate(2, 4)},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedScheduled:  false,
+			expectedPlacements: 0,
+		},
+		{
+			name:   "podgroup preempts a lower priority podgroup",
+			method: http.MethodPost,
+			request: &Request{
+				PodGroup:     newPodGroupWithPriority("preemptor", 1, highPriorityClass),
+				PodTemplates: []PodTemplate{newPodTemplate(1, 4)},
+				Actions:      []string{"allocate", "preempt"},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedPipelined:  true,
+			expectedPlacements: 1,
+			expectedVictims:    []string{"existing-0"},
+		},
+		{
+			name:   "existing podgroup",
+			method: http.MethodPost,
+			request: &Request{
+				PodGroup:     newPodGroup("existing", 1),
+				PodTemplates: []PodTemplate{newPodTemplate(1, 1)},
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "unsupported action",
+			method: http.MethodPost,
+			request: &Request{
+				PodGroup:     newPodGroup("stale", 1),
+				PodTemplates: []PodTemplate{newPodTemplate(1, 1)},
+				Actions:      []string{"stalegangeviction"},
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "get request",
+			method:             http.MethodGet,
+			request:            &Request{},
+			expectedStatusCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.request)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(tt.method, whatIfPath, bytes.NewReader(body))
+			w := httptest.NewRecorder()
+			plugin.serveWhatIf(w, req)
+
+			require.Equal(t, tt.expectedStatusCode, w.Code, w.Body.String())
+			if tt.expectedStatusCode != http.StatusOK {
+				return
+			}
+
+			result := &Result{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), result))
+			assert.Equal(t, tt.request.PodGroup.Name, result.PodGroup)
+			assert.Equal(t, tt.expectedScheduled, result.Scheduled)
+			assert.Equal(t, tt.expectedPipelined, result.Pipelined, w.Body.String())
+			assert.Len(t, result.Placements, tt.expectedPlacements)
+			for _, placement := range result.Placements {
+				assert.Equal(t, "node-0", placement.Node)
+			}
+			var victims []string
+			for _, victim := range result.Victims {
+				victims = append(victims, victim.Name)
+			}
+			assert.Equal(t, tt.expectedVictims, victims)
+			if !tt.expectedScheduled && !tt.expectedPipelined {
+				assert.NotEmpty(t, result.UnschedulableReasons)
+			}
+		})
+	}
+
+	podGroups, err := session.Cache.GetDataLister().ListPodGroups()
+	require.NoError(t, err)
+	assert.Len(t, podGroups, 1, "what-if requests must not create podgroups in the cluster")
+	pod, err := session.Cache.KubeClient().CoreV1().Pods(metav1.NamespaceDefault).Get(
+		context.Background(), "existing-0", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, pod.DeletionTimestamp, "what-if requests must not evict pods in the cluster")
+}
+
+func newTestSession(t *testing.T) *framework.Session {
+	kubeClient := fake.NewSimpleClientset()
+	kaiClient := kubeaischedulerver.NewSimpleClientset()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-0"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:              resource.MustParse("8"),
+				v1.ResourceMemory:           resource.MustParse("32Gi"),
+				v1.ResourcePods:             resource.MustParse("110"),
+				constants.NvidiaGpuResource: resource.MustParse("4"),
+			},
+		},
+	}
+	node.Status.Capacity = node.Status.Allocatable
+	_, err := kubeClient.CoreV1().Nodes().Create(ctx, node, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	queue := &enginev2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "queue-0"},
+		Spec: enginev2.QueueSpec{
+			Resources: &enginev2.QueueResources{
+				GPU:    enginev2.QueueResource{Quota: -1, Limit: -1, OverQuotaWeight: 1},
+				CPU:    enginev2.QueueResource{Quota: -1, Limit: -1, OverQuotaWeight: 1},
+				Memory: enginev2.QueueResource{Quota: -1, Limit: -1, OverQuotaWeight: 1},
+			},
+		},
+	}
+	_, err = kaiClient.SchedulingV2().Queues("").Create(ctx, queue, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	for name, value := range map[string]int32{lowPriorityClass: 10, highPriorityClass: 50} {
+		_, err = kubeClient.SchedulingV1().PriorityClasses().Create(ctx, &schedulingv1.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Value:      value,
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	existingPodGroup := newPodGroupWithPriority("existing", 1, lowPriorityClass)
+	existingPodGroup.Spec.Preemptibility = enginev2alpha2.Preemptible
+	_, err = kaiClient.SchedulingV2alpha2().PodGroups("default").Create(ctx, existingPodGroup, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	existingPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "existing-0",
+			Namespace:   metav1.NamespaceDefault,
+			UID:         "existing-0-uid",
+			Annotations: map[string]string{constants.PodGroupAnnotationForPod: "existing"},
+		},
+		Spec:   newPodTemplate(1, 2).Template.Spec,
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+	existingPod.Spec.SchedulerName = schedulerName
+	existingPod.Spec.NodeName = "node-0"
+	_, err = kubeClient.CoreV1().Pods(metav1.NamespaceDefault).Create(ctx, existingPod, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	schedulerCache := cache.New(&cache.SchedulerCacheParams{
+		KubeClient:                  kubeClient,
+		KAISchedulerClient:          kaiClient,
+		SchedulerName:               schedulerName,
+		NodePoolParams:              &conf.SchedulingNodePoolParams{},
+		FullHierarchyFairness:       true,
+		NumOfStatusRecordingWorkers: 1,
+		DiscoveryClient:             kubeClient.Discovery(),
+	})
+	schedulerCache.Run(ctx.Done())
+	schedulerCache.WaitForCacheSync(ctx.Done())
+
+	return &framework.Session{
+		Config: &conf.SchedulerConfiguration{
+			Actions: "allocate",
+			Tiers: []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{Name: "predicates"},
+						{Name: "priority"},
+						{Name: "proportion"},
+						{Name: "whatif"},
+					},
+				},
+			},
+		},
+		SchedulerParams: conf.SchedulerParams{
+			SchedulerName:               schedulerName,
+			PartitionParams:             &conf.SchedulingNodePoolParams{},
+			FullHierarchyFairness:       true,
+			NumOfStatusRecordingWorkers: 1,
+		},
+		Cache: schedulerCache,
+	}
+}
+
+func newPodGroup(name string, minMember int32) *enginev2alpha2.PodGroup {
+	return newPodGroupWithPriority(name, minMember, "")
+}
+
+func newPodGroupWithPriority(name string, minMember int32, priorityClassName string) *enginev2alpha2.PodGroup {
+	return &enginev2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: enginev2alpha2.PodGroupSpec{
+			MinMember:         minMember,
+			Queue:             "queue-0",
+			PriorityClassName: priorityClassName,
+		},
+	}
+}
+
+func newPodTemplate(replicas int32, gpus int64) PodTemplate {
+	return PodTemplate{
+		Replicas: replicas,
+		Template: v1.PodTemplateSpec{
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{
+					{
+						Name: "worker",
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{
+								constants.NvidiaGpuResource: *resource.NewQuantity(gpus, resource.DecimalSI),
+							},
+							Limits: v1.ResourceList{
+								constants.NvidiaGpuResource: *resource.NewQuantity(gpus, resource.DecimalSI),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
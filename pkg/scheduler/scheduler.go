@@ -35,6 +35,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb"
 	api "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb/api"
 	usagedbapi "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb/local"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf_util"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
@@ -48,6 +49,7 @@ type Scheduler struct {
 	schedulerParams *conf.SchedulerParams
 	schedulePeriod  time.Duration
 	mux             *http.ServeMux
+	schedulingCycle *framework.SchedulingCycleState
 }
 
 func NewScheduler(
@@ -63,7 +65,7 @@ func NewScheduler(
 		return nil, fmt.Errorf("Failed to create discovery client: %v", err)
 	}
 
-	usageDBClient, err := getUsageDBClient(schedulerConf.UsageDBConfig)
+	usageDBClient, err := getUsageDBClient(schedulerConf.UsageDBConfig, kubeClient, kubeAiSchedulerClient)
 	if err != nil {
 		return nil, fmt.Errorf("error getting usage db client: %v", err)
 	}
@@ -95,6 +97,7 @@ func NewScheduler(
 		cache:           schedcache.New(schedulerCacheParams),
 		schedulePeriod:  schedulerParams.SchedulePeriod,
 		mux:             mux,
+		schedulingCycle: &framework.SchedulingCycleState{},
 	}
 
 	return scheduler, nil
@@ -119,7 +122,8 @@ func (s *Scheduler) runOnce() {
 
 	defer metrics.UpdateE2eDuration(scheduleStartTime)
 
-	ssn, err := framework.OpenSession(s.cache, s.config, s.schedulerParams, sessionId, s.mux)
+	ssn, err := framework.OpenSession(s.cache, s.config, s.schedulerParams, sessionId, s.mux,
+		s.schedulingCycle)
 	if err != nil {
 		log.InfraLogger.Errorf("Error while opening session, will try again next cycle. \nCause: %+v", err)
 		return
@@ -146,8 +150,11 @@ func newClients(config *rest.Config) (kubernetes.Interface, kubeaischedulerver.I
 	return kubernetes.NewForConfigOrDie(k8cClientConfig), kubeaischedulerver.NewForConfigOrDie(config)
 }
 
-func getUsageDBClient(dbConfig *usagedbapi.UsageDBConfig) (usagedbapi.Interface, error) {
-	resolver := usagedb.NewClientResolver(nil)
+func getUsageDBClient(dbConfig *usagedbapi.UsageDBConfig, kubeClient kubernetes.Interface,
+	kubeAiSchedulerClient kubeaischedulerver.Interface) (usagedbapi.Interface, error) {
+	resolver := usagedb.NewClientResolver(map[string]usagedb.GetClientFn{
+		"local": local.NewLocalClientFn(kubeClient, kubeAiSchedulerClient),
+	})
 	return resolver.GetClient(dbConfig)
 }
 
@@ -72,6 +72,7 @@ func CreateFakeSession(schedulerConfig *TestSessionConfig,
 		SchedulerParams: conf.SchedulerParams{
 			QueueLabelKey: constants.DefaultQueueLabel,
 		},
+		SchedulingCycle: &framework.SchedulingCycleState{},
 	}
 	ssn.OverrideMaxNumberConsolidationPreemptees(-1)
 	ssn.OverrideAllowConsolidatingReclaim(true)